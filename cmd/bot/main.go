@@ -4,8 +4,12 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
+	"time"
 
 	"github.com/companyzero/bisonrelay/clientrpc/types"
 	"github.com/companyzero/bisonrelay/zkidentity"
@@ -13,7 +17,9 @@ import (
 	_ "github.com/mattn/go-sqlite3" // SQLite3 driver
 	kit "github.com/vctt94/bisonbotkit"
 	"github.com/vctt94/pokerbisonrelay/pkg/bot"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
 	"github.com/vctt94/pokerbisonrelay/pkg/server"
+	"google.golang.org/grpc"
 )
 
 var (
@@ -27,6 +33,10 @@ var (
 	grpcHost           = flag.String("grpchost", "", "gRPC host address")
 	grpcPort           = flag.String("grpcport", "", "gRPC port")
 	debugLevel         = flag.String("debuglevel", "", "Debug level")
+	dbDSN              = flag.String("dbdsn", "", "PostgreSQL DSN (overrides the SQLite database in datadir)")
+	adminSocket        = flag.String("adminsocket", "", "Unix socket to serve the admin service on")
+	drainTimeout       = flag.Duration("draintimeout", 0, "On SIGTERM or interrupt, longest wait for running hands to finish")
+	metricsAddr        = flag.String("metricsaddr", "", "host:port to serve /metrics, /healthz and /readyz on over HTTP")
 )
 
 func realMain() error {
@@ -52,6 +62,18 @@ func realMain() error {
 	if *keyFile != "" {
 		cfg.KeyFile = *keyFile
 	}
+	if *dbDSN != "" {
+		cfg.DatabaseDSN = *dbDSN
+	}
+	if *adminSocket != "" {
+		cfg.AdminSocket = *adminSocket
+	}
+	if *drainTimeout > 0 {
+		cfg.DrainTimeout = *drainTimeout
+	}
+	if *metricsAddr != "" {
+		cfg.MetricsAddress = *metricsAddr
+	}
 
 	// Rebuild server address if gRPC host/port were overridden
 	if *grpcHost != "" || *grpcPort != "" {
@@ -88,20 +110,86 @@ func realMain() error {
 	defer botInstance.Close()
 
 	// Initialize database
-	db, err := server.NewDatabase(filepath.Join(cfg.DataDir, "poker.db"))
+	db, err := server.OpenDatabase(filepath.Join(cfg.DataDir, "poker.db"), cfg.DatabaseDSN)
 	if err != nil {
 		return fmt.Errorf("failed to initialize database: %v", err)
 	}
 	defer db.Close()
 
-	// Initialize and start the gRPC poker server
-	grpcServer, grpcLis, err := bot.SetupGRPCServer(cfg.DataDir, cfg.CertFile, cfg.KeyFile, cfg.ServerAddress, db, botInstance.LogBackend)
+	// Withdrawals are paid back to players as Bison Relay tips, or to
+	// their payout address when the bot has a wallet
+	var wallet *bot.WalletPayer
+	if cfg.Wallet != nil {
+		if wallet, err = bot.NewWalletPayer(*cfg.Wallet); err != nil {
+			return fmt.Errorf("failed to set up the payout wallet: %v", err)
+		}
+	}
+	withdrawals := server.NewWithdrawals(db, bot.NewBotPayoutSender(botInstance, wallet),
+		cfg.WithdrawDailyLimit, botInstance.LogBackend.Logger("WDRW"))
+
+	// The poker server serves both gRPC clients and the PM commands, so
+	// tables are shared between the TUI client and Bison Relay chat
+	pokerServer := server.NewServer(db, botInstance.LogBackend)
+	pokerServer.SetWithdrawals(withdrawals)
+	defer pokerServer.Stop()
+
+	// Initialize and start the gRPC poker server. With an admin token the
+	// admin service is served alongside, to callers presenting it.
+	adminServer := server.NewAdminServer(pokerServer)
+	var grpcOpts []grpc.ServerOption
+	if cfg.AdminToken != "" {
+		grpcOpts = append(grpcOpts, grpc.UnaryInterceptor(server.AdminTokenInterceptor(cfg.AdminToken)))
+	}
+	grpcServer, grpcLis, err := bot.SetupGRPCServer(cfg.DataDir, cfg.CertFile, cfg.KeyFile, cfg.ServerAddress, pokerServer, grpcOpts...)
 	if err != nil {
 		return fmt.Errorf("failed to setup gRPC server: %v", err)
 	}
+	if cfg.AdminToken != "" {
+		pokerrpc.RegisterAdminServiceServer(grpcServer, adminServer)
+	}
+
+	// Local admin socket
+	if cfg.AdminSocket != "" {
+		adminLis, err := server.ListenAdminSocket(cfg.AdminSocket)
+		if err != nil {
+			return fmt.Errorf("failed to listen on admin socket: %v", err)
+		}
+		adminGRPC := grpc.NewServer()
+		pokerrpc.RegisterAdminServiceServer(adminGRPC, adminServer)
+		go func() {
+			log.Infof("Serving admin service on %s", cfg.AdminSocket)
+			if err := adminGRPC.Serve(adminLis); err != nil {
+				log.Errorf("admin socket error: %v", err)
+			}
+		}()
+		defer adminGRPC.Stop()
+	}
+
+	// Monitoring endpoints
+	if cfg.MetricsAddress != "" {
+		httpSrv := &http.Server{Addr: cfg.MetricsAddress, Handler: pokerServer.MonitorHandler(), ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			log.Infof("Serving metrics on %s", cfg.MetricsAddress)
+			if err := httpSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Errorf("metrics server error: %v", err)
+			}
+		}()
+		defer httpSrv.Close()
+	}
 
-	// Initialize bot state
-	state := bot.NewState(db)
+	// Initialize bot state; players without a client notification stream
+	// get table notifications as PMs
+	state := bot.NewState(pokerServer, db, withdrawals)
+	state.SetLogger(botInstance.LogBackend.Logger("RLAY"))
+	pokerServer.SetNotificationRelay(state)
+	go state.RelayNotifications(ctx, botInstance)
+
+	// Tables bound to group chats get their public events posted there
+	if err := state.LoadGCTables(ctx); err != nil {
+		return err
+	}
+	pokerServer.SetTableRelay(state)
+	go state.RunGCRelay(ctx, botInstance, cfg.GCMessageInterval)
 	go func() {
 		log.Infof("Starting gRPC poker server on %s", cfg.ServerAddress)
 		if err := grpcServer.Serve(grpcLis); err != nil {
@@ -127,8 +215,8 @@ func realMain() error {
 				userID.String())
 
 			// Update player balance
-			err := db.UpdatePlayerBalance(userID.String(), int64(tip.AmountMatoms/1e3),
-				"tip", "Received tip from user")
+			err := pokerServer.Deposit(userID.String(), int64(tip.AmountMatoms/1e3),
+				"Received tip from user")
 			if err != nil {
 				log.Errorf("Failed to update player balance: %v", err)
 				botInstance.SendPM(ctx, userID.String(),
@@ -147,16 +235,59 @@ func realMain() error {
 	go func() {
 		for progress := range tipProgressChan {
 			log.Infof("Tip progress event (sequence ID: %d)", progress.SequenceId)
-			err := botInstance.AckTipProgress(ctx, progress.SequenceId)
+
+			// Settle the withdrawal this tip pays out, if any
+			var userID zkidentity.ShortID
+			userID.FromBytes(progress.Uid)
+			withdrawal, err := withdrawals.HandleTipProgress(userID.String(), progress.AmountMatoms/1e3,
+				progress.Completed, progress.WillRetry, progress.AttemptErr)
+			if err != nil {
+				log.Errorf("Failed to update withdrawal for tip progress: %v", err)
+			} else if withdrawal != nil {
+				switch withdrawal.Status {
+				case server.WithdrawalConfirmed:
+					botInstance.SendPM(ctx, userID.String(), fmt.Sprintf("Withdrawal %d of %.8f DCR completed.",
+						withdrawal.ID, dcrutil.Amount(withdrawal.Amount).ToCoin()))
+				case server.WithdrawalReversed:
+					botInstance.SendPM(ctx, userID.String(), fmt.Sprintf("Withdrawal %d of %.8f DCR failed and "+
+						"was credited back to your balance.", withdrawal.ID, dcrutil.Amount(withdrawal.Amount).ToCoin()))
+				}
+			}
+
+			err = botInstance.AckTipProgress(ctx, progress.SequenceId)
 			if err != nil {
 				log.Errorf("Failed to acknowledge tip progress: %v", err)
 			}
 		}
 	}()
 
+	// The first signal drains the poker server and stops the bot once the
+	// running hands finished; a second one stops it right away. A drain
+	// started through the admin service also stops the bot.
+	go func() {
+		sigs := make(chan os.Signal, 2)
+		signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+		select {
+		case <-sigs:
+			log.Infof("Draining, waiting up to %v for hands to finish (signal again to stop now)", cfg.DrainTimeout)
+			pokerServer.StartDrain(cfg.DrainTimeout)
+			select {
+			case <-pokerServer.Drained():
+			case <-sigs:
+			}
+		case <-pokerServer.Drained():
+		case <-ctx.Done():
+			return
+		}
+		cancel()
+	}()
+
 	// Run the bot
 	err = botInstance.Run(ctx)
 	log.Infof("Bot exited: %v", err)
+	if ctx.Err() != nil {
+		return nil
+	}
 	return err
 }
 
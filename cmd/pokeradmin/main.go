@@ -0,0 +1,289 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"github.com/vctt94/pokerbisonrelay/pkg/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// Connection flags
+var (
+	socketPath = flag.String("socket", "", "Unix socket the admin service is served on")
+	addr       = flag.String("addr", "", "host:port of a server serving the admin service behind a token")
+	token      = flag.String("token", "", "Admin token, for -addr (or set POKER_ADMIN_TOKEN)")
+	serverCert = flag.String("grpcservercert", "", "Server certificate for TLS, for -addr; plaintext when unset")
+	timeout    = flag.Duration("timeout", 30*time.Second, "Timeout of each call")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s (-socket PATH | -addr HOST:PORT -token T) <command> [args]\n", os.Args[0])
+		fmt.Fprintln(os.Stderr, "Commands:")
+		fmt.Fprintln(os.Stderr, "  tables                              List all tables with their live state (JSON)")
+		fmt.Fprintln(os.Stderr, "  end-game --table-id ID [--reason R]  End the game at a table, voiding the hand in progress")
+		fmt.Fprintln(os.Stderr, "  delete-table --table-id ID [--reason R]  Delete a table, cashing out its players")
+		fmt.Fprintln(os.Stderr, "  adjust --player ID --amount N --reason R  Add N atoms (negative to debit) to a balance")
+		fmt.Fprintln(os.Stderr, "  ledger [opts]                       List ledger transactions, newest first (JSON)")
+		fmt.Fprintln(os.Stderr, "  broadcast MESSAGE                   Send a message to every connected client")
+		fmt.Fprintln(os.Stderr, "  drain [--message M] [--wait D]      Let running hands finish, then stop the server (JSON)")
+		fmt.Fprintln(os.Stderr, "\nFlags:")
+		flag.PrintDefaults()
+	}
+
+	flag.CommandLine.SetOutput(io.Discard)
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	conn, err := dial()
+	if err != nil {
+		fatalErr(err)
+	}
+	defer conn.Close()
+	admin := pokerrpc.NewAdminServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	if *addr != "" {
+		adminToken := *token
+		if adminToken == "" {
+			adminToken = os.Getenv("POKER_ADMIN_TOKEN")
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, server.AdminTokenMetadataKey, adminToken)
+	}
+
+	args := flag.Args()[1:]
+	switch flag.Arg(0) {
+	case "tables":
+		err = handleTables(ctx, admin)
+	case "end-game":
+		err = handleEndGame(ctx, admin, args)
+	case "delete-table":
+		err = handleDeleteTable(ctx, admin, args)
+	case "adjust":
+		err = handleAdjust(ctx, admin, args)
+	case "ledger":
+		err = handleLedger(ctx, admin, args)
+	case "broadcast":
+		err = handleBroadcast(ctx, admin, args)
+	case "drain":
+		err = handleDrain(ctx, admin, args)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fatalErr(err)
+	}
+}
+
+// dial connects to the admin service on the socket or address given.
+func dial() (*grpc.ClientConn, error) {
+	switch {
+	case *socketPath != "" && *addr != "":
+		return nil, errors.New("use either -socket or -addr")
+	case *socketPath != "":
+		return grpc.Dial("unix://"+*socketPath, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	case *addr != "":
+		creds := insecure.NewCredentials()
+		if *serverCert != "" {
+			var err error
+			if creds, err = credentials.NewClientTLSFromFile(*serverCert, ""); err != nil {
+				return nil, fmt.Errorf("failed to load server certificate: %v", err)
+			}
+		}
+		return grpc.Dial(*addr, grpc.WithTransportCredentials(creds))
+	default:
+		return nil, errors.New("either -socket or -addr is required")
+	}
+}
+
+func fatalErr(err error) {
+	fmt.Fprintln(os.Stderr, err.Error())
+	os.Exit(1)
+}
+
+func printJSON(v interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func handleTables(ctx context.Context, admin pokerrpc.AdminServiceClient) error {
+	resp, err := admin.ListTables(ctx, &pokerrpc.AdminListTablesRequest{})
+	if err != nil {
+		return err
+	}
+	return printJSON(resp)
+}
+
+func handleEndGame(ctx context.Context, admin pokerrpc.AdminServiceClient, args []string) error {
+	fs := flag.NewFlagSet("end-game", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	tableID := fs.String("table-id", "", "Table ID")
+	reason := fs.String("reason", "", "Reason shown to the players")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("end-game: %w", err)
+	}
+	if *tableID == "" {
+		return errors.New("end-game requires --table-id")
+	}
+	resp, err := admin.EndGame(ctx, &pokerrpc.AdminEndGameRequest{TableId: *tableID, Reason: *reason})
+	if err != nil {
+		return err
+	}
+	fmt.Println(resp.Message)
+	return nil
+}
+
+func handleDeleteTable(ctx context.Context, admin pokerrpc.AdminServiceClient, args []string) error {
+	fs := flag.NewFlagSet("delete-table", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	tableID := fs.String("table-id", "", "Table ID")
+	reason := fs.String("reason", "", "Reason shown to the players")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("delete-table: %w", err)
+	}
+	if *tableID == "" {
+		return errors.New("delete-table requires --table-id")
+	}
+	resp, err := admin.DeleteTable(ctx, &pokerrpc.AdminDeleteTableRequest{TableId: *tableID, Reason: *reason})
+	if err != nil {
+		return err
+	}
+	fmt.Println(resp.Message)
+	return nil
+}
+
+func handleAdjust(ctx context.Context, admin pokerrpc.AdminServiceClient, args []string) error {
+	fs := flag.NewFlagSet("adjust", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	playerID := fs.String("player", "", "Player ID")
+	amount := fs.Int64("amount", 0, "Atoms to add; negative to debit")
+	reason := fs.String("reason", "", "Reason recorded in the ledger")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("adjust: %w", err)
+	}
+	if *playerID == "" || *amount == 0 || *reason == "" {
+		return errors.New("adjust requires --player, a non-zero --amount and --reason")
+	}
+	resp, err := admin.AdjustBalance(ctx, &pokerrpc.AdjustBalanceRequest{
+		PlayerId: *playerID,
+		Amount:   *amount,
+		Reason:   *reason,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println(resp.NewBalance)
+	return nil
+}
+
+func handleLedger(ctx context.Context, admin pokerrpc.AdminServiceClient, args []string) error {
+	fs := flag.NewFlagSet("ledger", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	playerID := fs.String("player", "", "Only this player's transactions")
+	limit := fs.Int("limit", 0, "Transactions per page (0 = server default)")
+	pageToken := fs.String("page-token", "", "Page token returned by a previous call")
+	all := fs.Bool("all", false, "Follow page tokens and print every matching transaction")
+	types := fs.String("types", "", "Comma-separated transaction types to include")
+	since := fs.String("since", "", "Only transactions at or after this time (RFC3339 or YYYY-MM-DD)")
+	until := fs.String("until", "", "Only transactions before this time (RFC3339 or YYYY-MM-DD)")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("ledger: %w", err)
+	}
+
+	req := &pokerrpc.GetTransactionsRequest{PlayerId: *playerID, PageSize: int32(*limit), PageToken: *pageToken}
+	for _, t := range strings.Split(*types, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			req.Types = append(req.Types, t)
+		}
+	}
+	var err error
+	if req.Since, err = parseTimeArg(*since); err != nil {
+		return fmt.Errorf("ledger: --since: %w", err)
+	}
+	if req.Until, err = parseTimeArg(*until); err != nil {
+		return fmt.Errorf("ledger: --until: %w", err)
+	}
+
+	resp, err := admin.GetLedger(ctx, req)
+	if err != nil {
+		return err
+	}
+	for *all && resp.NextPageToken != "" {
+		req.PageToken = resp.NextPageToken
+		next, err := admin.GetLedger(ctx, req)
+		if err != nil {
+			return err
+		}
+		resp.Transactions = append(resp.Transactions, next.Transactions...)
+		resp.NextPageToken = next.NextPageToken
+	}
+	return printJSON(resp)
+}
+
+func handleBroadcast(ctx context.Context, admin pokerrpc.AdminServiceClient, args []string) error {
+	message := strings.Join(args, " ")
+	if message == "" {
+		return errors.New("broadcast requires a message")
+	}
+	resp, err := admin.Broadcast(ctx, &pokerrpc.BroadcastRequest{Message: message})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Sent to %d clients\n", resp.Recipients)
+	return nil
+}
+
+func handleDrain(ctx context.Context, admin pokerrpc.AdminServiceClient, args []string) error {
+	fs := flag.NewFlagSet("drain", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	message := fs.String("message", "", "Message announced to every connected client")
+	wait := fs.Duration("wait", 0, "Longest wait for running hands to finish (0 = server default)")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("drain: %w", err)
+	}
+	if *wait < 0 {
+		return errors.New("drain: --wait must not be negative")
+	}
+	resp, err := admin.Drain(ctx, &pokerrpc.DrainRequest{
+		Message:        *message,
+		TimeoutSeconds: int64(wait.Seconds()),
+	})
+	if err != nil {
+		return err
+	}
+	return printJSON(resp)
+}
+
+// parseTimeArg parses an RFC3339 time or a YYYY-MM-DD date (UTC midnight)
+// into Unix seconds. An empty string yields 0.
+func parseTimeArg(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Unix(), nil
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	return t.Unix(), nil
+}
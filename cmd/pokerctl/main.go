@@ -8,13 +8,17 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/vctt94/pokerbisonrelay/pkg/client"
+	"github.com/vctt94/pokerbisonrelay/pkg/client/agent"
 	"github.com/vctt94/pokerbisonrelay/pkg/poker"
 	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"github.com/vctt94/pokerbisonrelay/pkg/strategy"
 )
 
 // Common flags
@@ -45,17 +49,34 @@ func main() {
 		fmt.Fprintln(os.Stderr, "Commands:")
 		fmt.Fprintln(os.Stderr, "  id                               Show player ID")
 		fmt.Fprintln(os.Stderr, "  balance [--add N]                Show or add to balance")
+		fmt.Fprintln(os.Stderr, "  transactions [opts]              List balance transactions, newest first (JSON)")
+		fmt.Fprintln(os.Stderr, "  withdraw N [--to-address]        Withdraw N atoms by tip, or to the payout address (JSON)")
+		fmt.Fprintln(os.Stderr, "  withdrawals [--limit N]          List recent withdrawals (JSON)")
 		fmt.Fprintln(os.Stderr, "  tables                           List tables (JSON)")
 		fmt.Fprintln(os.Stderr, "  create-table [opts]              Create table; prints table ID")
-		fmt.Fprintln(os.Stderr, "  join --table-id ID               Join a table")
+		fmt.Fprintln(os.Stderr, "  join --table-id ID [--seat N] [--password P] [--invite CODE]  Join a table")
+		fmt.Fprintln(os.Stderr, "  reserve --table-id ID [--seat N] [--ttl D] [--password P] [--invite CODE]  Hold a seat while topping up (JSON)")
+		fmt.Fprintln(os.Stderr, "  waitlist --table-id ID [--password P] [--invite CODE]  Wait for a seat at a full table (JSON)")
+		fmt.Fprintln(os.Stderr, "  unwaitlist --table-id ID         Leave a table's waitlist (JSON)")
+		fmt.Fprintln(os.Stderr, "  invite --table-id ID [--player ID] [--ttl D]  Create a table invite code (JSON)")
 		fmt.Fprintln(os.Stderr, "  leave                            Leave current table")
+		fmt.Fprintln(os.Stderr, "  kick|ban --player ID [--reason R] [--table-id ID]  Remove or ban a player from your table (JSON)")
+		fmt.Fprintln(os.Stderr, "  mute|unmute --player ID [--table-id ID]  Silence a player in your table's chat, or let them talk again (JSON)")
+		fmt.Fprintln(os.Stderr, "  pause|resume [--table-id ID]     Pause or resume the game at your table (JSON)")
+		fmt.Fprintln(os.Stderr, "  close [--reason R] [--table-id ID]  Close your table after the current hand (JSON)")
+		fmt.Fprintln(os.Stderr, "  addbot --strategy S [--think D] [--table-id ID]  Seat a bot (random, tag, equity) at your table, paying its buy-in (JSON)")
 		fmt.Fprintln(os.Stderr, "  ready set|unset [--table-id ID]  Set or unset ready state")
+		fmt.Fprintln(os.Stderr, "  sitout|sitin [--table-id ID]     Keep your seat without being dealt in, or be dealt in again (JSON)")
 		fmt.Fprintln(os.Stderr, "  state [--table-id ID]            Print game state (JSON)")
 		fmt.Fprintln(os.Stderr, "  stream [--table-id ID]           Stream game updates (JSON)")
+		fmt.Fprintln(os.Stderr, "  watch --table-id ID              Stream the delayed public updates of a table you are not seated at (JSON)")
 		fmt.Fprintln(os.Stderr, "  events [--table-id ID] [--types T1,T2]  Stream server events (notifications) as JSON")
 		fmt.Fprintln(os.Stderr, "  wait --type T [--table-id ID] [--timeout D]  Block until event arrives; print it as JSON")
 		fmt.Fprintln(os.Stderr, "  act check|call|bet N|raise N|fold [--table-id ID]  Perform an action")
+		fmt.Fprintln(os.Stderr, "  chat [--table-id ID] TEXT        Send a message to the chat of your table (JSON)")
+		fmt.Fprintln(os.Stderr, "  timebank [--table-id ID]         Add your time bank to the clock of your turn (JSON)")
 		fmt.Fprintln(os.Stderr, "  last-winners [--table-id ID]     Print last hand winners (JSON)")
+		fmt.Fprintln(os.Stderr, "  autoplay --strategy S [--table-id ID] [--seed N]  Play with a strategy (random, tag, equity) until interrupted; prints each action (JSON)")
 		fmt.Fprintln(os.Stderr, "\nGlobal flags:")
 		flag.PrintDefaults()
 	}
@@ -114,6 +135,24 @@ func main() {
 		}
 		return
 
+	case "transactions":
+		if err := handleTransactions(ctx, pcli, flag.Args()[1:]); err != nil {
+			fatalErr(err)
+		}
+		return
+
+	case "withdraw":
+		if err := handleWithdraw(ctx, pcli, flag.Args()[1:]); err != nil {
+			fatalErr(err)
+		}
+		return
+
+	case "withdrawals":
+		if err := handleWithdrawals(ctx, pcli, flag.Args()[1:]); err != nil {
+			fatalErr(err)
+		}
+		return
+
 	case "tables":
 		if err := handleTables(ctx, pcli); err != nil {
 			fatalErr(err)
@@ -132,12 +171,60 @@ func main() {
 		}
 		return
 
+	case "reserve":
+		if err := handleReserve(ctx, pcli, flag.Args()[1:]); err != nil {
+			fatalErr(err)
+		}
+		return
+
+	case "waitlist":
+		if err := handleWaitlist(ctx, pcli, flag.Args()[1:]); err != nil {
+			fatalErr(err)
+		}
+		return
+
+	case "unwaitlist":
+		if err := handleUnwaitlist(ctx, pcli, flag.Args()[1:]); err != nil {
+			fatalErr(err)
+		}
+		return
+
+	case "invite":
+		if err := handleInvite(ctx, pcli, flag.Args()[1:]); err != nil {
+			fatalErr(err)
+		}
+		return
+
 	case "leave":
 		if err := pcli.LeaveTable(ctx); err != nil {
 			fatalErr(err)
 		}
 		return
 
+	case "kick", "ban", "mute", "unmute", "pause", "resume", "close", "addbot":
+		if err := handleHostAction(ctx, pcli, cmd, flag.Args()[1:]); err != nil {
+			fatalErr(err)
+		}
+		return
+
+	case "sitout", "sitin":
+		if err := handleSitOut(ctx, pcli, cmd, flag.Args()[1:]); err != nil {
+			fatalErr(err)
+		}
+		return
+
+	case "chat":
+		if err := handleChat(ctx, pcli, flag.Args()[1:]); err != nil {
+			fatalErr(err)
+		}
+		return
+
+	case "timebank":
+		if err := handleTimeBank(ctx, pcli, flag.Args()[1:]); err != nil {
+			fatalErr(err)
+		}
+		return
+
 	case "ready":
 		if err := handleReady(ctx, pcli, flag.Args()[1:]); err != nil {
 			fatalErr(err)
@@ -156,6 +243,12 @@ func main() {
 		}
 		return
 
+	case "watch":
+		if err := handleWatch(ctx, pcli, flag.Args()[1:]); err != nil {
+			fatalErr(err)
+		}
+		return
+
 	case "events":
 		if err := handleEvents(ctx, pcli, flag.Args()[1:]); err != nil {
 			fatalErr(err)
@@ -180,6 +273,12 @@ func main() {
 		}
 		return
 
+	case "autoplay":
+		if err := handleAutoplay(ctx, pcli, flag.Args()[1:]); err != nil {
+			fatalErr(err)
+		}
+		return
+
 	default:
 		flag.Usage()
 		os.Exit(2)
@@ -246,6 +345,91 @@ func handleBalance(ctx context.Context, pcli *client.PokerClient, args []string)
 	return nil
 }
 
+func handleTransactions(ctx context.Context, pcli *client.PokerClient, args []string) error {
+	fs := flag.NewFlagSet("transactions", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	limit := fs.Int("limit", 0, "Transactions per page (0 = server default)")
+	pageToken := fs.String("page-token", "", "Page token returned by a previous call")
+	all := fs.Bool("all", false, "Follow page tokens and print every matching transaction")
+	types := fs.String("types", "", "Comma-separated transaction types to include")
+	since := fs.String("since", "", "Only transactions at or after this time (RFC3339 or YYYY-MM-DD)")
+	until := fs.String("until", "", "Only transactions before this time (RFC3339 or YYYY-MM-DD)")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("transactions: %w", err)
+	}
+
+	req := &pokerrpc.GetTransactionsRequest{PageSize: int32(*limit), PageToken: *pageToken}
+	for t := range parseTypes(*types) {
+		req.Types = append(req.Types, t)
+	}
+	var err error
+	if req.Since, err = parseTimeArg(*since); err != nil {
+		return fmt.Errorf("transactions: --since: %w", err)
+	}
+	if req.Until, err = parseTimeArg(*until); err != nil {
+		return fmt.Errorf("transactions: --until: %w", err)
+	}
+
+	resp, err := pcli.GetTransactions(ctx, req)
+	if err != nil {
+		return err
+	}
+	for *all && resp.NextPageToken != "" {
+		req.PageToken = resp.NextPageToken
+		next, err := pcli.GetTransactions(ctx, req)
+		if err != nil {
+			return err
+		}
+		resp.Transactions = append(resp.Transactions, next.Transactions...)
+		resp.NextPageToken = next.NextPageToken
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(resp)
+}
+
+func handleWithdraw(ctx context.Context, pcli *client.PokerClient, args []string) error {
+	fs := flag.NewFlagSet("withdraw", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	toAddress := fs.Bool("to-address", false, "Pay to the configured payout address instead of by tip")
+	if len(args) < 1 {
+		return errors.New("withdraw: amount required")
+	}
+	amount, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("withdraw: invalid amount: %w", err)
+	}
+	if err := fs.Parse(args[1:]); err != nil {
+		return fmt.Errorf("withdraw: %w", err)
+	}
+
+	resp, err := pcli.RequestWithdrawal(ctx, amount, *toAddress)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(resp)
+}
+
+func handleWithdrawals(ctx context.Context, pcli *client.PokerClient, args []string) error {
+	fs := flag.NewFlagSet("withdrawals", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	limit := fs.Int("limit", 0, "Maximum withdrawals to list (0 = server default)")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("withdrawals: %w", err)
+	}
+
+	resp, err := pcli.GetWithdrawals(ctx, int32(*limit))
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(resp)
+}
+
 func handleTables(ctx context.Context, pcli *client.PokerClient) error {
 	tables, err := pcli.GetTables(ctx)
 	if err != nil {
@@ -268,6 +452,13 @@ func handleCreateTable(ctx context.Context, pcli *client.PokerClient, args []str
 	startingChips := fs.Int64("starting-chips", 1000, "Starting chips")
 	timeBank := fs.Int("time-bank-seconds", 0, "Player timebank in seconds (0=default)")
 	autoStartMs := fs.Int("auto-start-ms", 0, "Auto-start delay between hands in ms (0=disabled)")
+	private := fs.Bool("private", false, "Hide the table from the lobby; players join by invite")
+	password := fs.String("password", "", "Password required to join the table")
+	sitOutAfter := fs.Int("sit-out-after", 0, "Missed actions in a row before a player is sat out (0=default)")
+	sitOutLimit := fs.Duration("sit-out-limit", 0, "How long a player may sit out before being removed (0=default)")
+	timeBankReserve := fs.Duration("time-bank-reserve", 0, "Most time bank a player holds on top of the time-bank-seconds of every action (0=default)")
+	maxSpectators := fs.Int("max-spectators", 0, "Most spectators watching the table at once (0=unlimited)")
+	spectatorDelay := fs.Duration("spectator-delay", 0, "How far behind the game spectators watch (0=default)")
 	if err := fs.Parse(args); err != nil {
 		return fmt.Errorf("create-table: %w", err)
 	}
@@ -282,9 +473,15 @@ func handleCreateTable(ctx context.Context, pcli *client.PokerClient, args []str
 		StartingChips:  *startingChips,
 		TimeBank:       time.Duration(*timeBank) * time.Second,
 		AutoStartDelay: time.Duration(*autoStartMs) * time.Millisecond,
+
+		SitOutAfterTimeouts: *sitOutAfter,
+		SitOutLimit:         *sitOutLimit,
+		TimeBankReserve:     *timeBankReserve,
+		MaxSpectators:       *maxSpectators,
+		SpectatorDelay:      *spectatorDelay,
 	}
 
-	id, err := pcli.CreateTable(ctx, cfg)
+	id, err := pcli.CreateRestrictedTable(ctx, cfg, *private, *password)
 	if err != nil {
 		return err
 	}
@@ -296,13 +493,249 @@ func handleJoin(ctx context.Context, pcli *client.PokerClient, args []string) er
 	fs := flag.NewFlagSet("join", flag.ContinueOnError)
 	fs.SetOutput(io.Discard)
 	tableID := fs.String("table-id", "", "Table ID")
+	password := fs.String("password", "", "Table password")
+	invite := fs.String("invite", "", "Invite code")
+	seat := fs.Int("seat", 0, "Seat to sit in, from 1 (0 = reserved or first free seat)")
 	if err := fs.Parse(args); err != nil {
 		return fmt.Errorf("join: %w", err)
 	}
 	if *tableID == "" {
 		return errors.New("join: --table-id is required")
 	}
-	return pcli.JoinTable(ctx, *tableID)
+	return pcli.JoinTableSeat(ctx, *tableID, *seat, *password, *invite)
+}
+
+func handleReserve(ctx context.Context, pcli *client.PokerClient, args []string) error {
+	fs := flag.NewFlagSet("reserve", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	tableID := fs.String("table-id", "", "Table ID")
+	password := fs.String("password", "", "Table password")
+	invite := fs.String("invite", "", "Invite code")
+	seat := fs.Int("seat", 0, "Seat to hold, from 1 (0 = first free seat)")
+	ttl := fs.Duration("ttl", 0, "Reservation lifetime (0 = server default)")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("reserve: %w", err)
+	}
+	if *tableID == "" {
+		return errors.New("reserve: --table-id is required")
+	}
+
+	resp, err := pcli.ReserveSeat(ctx, *tableID, *seat, *password, *invite, *ttl)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(resp)
+}
+
+func handleWaitlist(ctx context.Context, pcli *client.PokerClient, args []string) error {
+	fs := flag.NewFlagSet("waitlist", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	tableID := fs.String("table-id", "", "Table ID")
+	password := fs.String("password", "", "Table password")
+	invite := fs.String("invite", "", "Invite code")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("waitlist: %w", err)
+	}
+	if *tableID == "" {
+		return errors.New("waitlist: --table-id is required")
+	}
+
+	resp, err := pcli.JoinWaitlist(ctx, *tableID, *password, *invite)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(resp)
+}
+
+func handleUnwaitlist(ctx context.Context, pcli *client.PokerClient, args []string) error {
+	fs := flag.NewFlagSet("unwaitlist", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	tableID := fs.String("table-id", "", "Table ID")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("unwaitlist: %w", err)
+	}
+	if *tableID == "" {
+		return errors.New("unwaitlist: --table-id is required")
+	}
+
+	resp, err := pcli.LeaveWaitlist(ctx, *tableID)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(resp)
+}
+
+func handleInvite(ctx context.Context, pcli *client.PokerClient, args []string) error {
+	fs := flag.NewFlagSet("invite", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	tableID := fs.String("table-id", "", "Table ID")
+	playerID := fs.String("player", "", "Player the invite is for (empty = anyone with the code)")
+	ttl := fs.Duration("ttl", 0, "Invite lifetime (0 = server default)")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("invite: %w", err)
+	}
+	if *tableID == "" {
+		return errors.New("invite: --table-id is required")
+	}
+
+	resp, err := pcli.CreateTableInvite(ctx, *tableID, *playerID, *ttl)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(resp)
+}
+
+// handleHostAction runs one of the table host's moderation commands and
+// prints the server response.
+func handleHostAction(ctx context.Context, pcli *client.PokerClient, cmd string, args []string) error {
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	tableID := fs.String("table-id", "", "Table ID")
+	var playerID, reason, strategyName *string
+	var think *time.Duration
+	switch cmd {
+	case "addbot":
+		strategyName = fs.String("strategy", "", "Bot strategy: random, tag or equity")
+		think = fs.Duration("think", 0, "Delay before each bot action (0 for the server default)")
+	case "kick", "ban":
+		playerID = fs.String("player", "", "Player to remove")
+		reason = fs.String("reason", "", "Reason shown to the players")
+	case "mute", "unmute":
+		playerID = fs.String("player", "", "Player to mute or unmute")
+	case "close":
+		reason = fs.String("reason", "", "Reason shown to the players")
+	}
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%s: %w", cmd, err)
+	}
+	id := *tableID
+	if id == "" {
+		id = pcli.GetCurrentTableID()
+		if id == "" {
+			return fmt.Errorf("%s: no table-id provided and not joined to a table", cmd)
+		}
+	}
+	if playerID != nil && *playerID == "" {
+		return fmt.Errorf("%s: --player is required", cmd)
+	}
+	if strategyName != nil && *strategyName == "" {
+		return fmt.Errorf("%s: --strategy is required", cmd)
+	}
+
+	var resp interface{}
+	var err error
+	switch cmd {
+	case "kick":
+		resp, err = pcli.KickPlayer(ctx, id, *playerID, *reason)
+	case "ban":
+		resp, err = pcli.BanPlayer(ctx, id, *playerID, *reason)
+	case "mute", "unmute":
+		resp, err = pcli.MuteChatPlayer(ctx, id, *playerID, cmd == "mute")
+	case "pause":
+		resp, err = pcli.PauseTable(ctx, id)
+	case "resume":
+		resp, err = pcli.ResumeTable(ctx, id)
+	case "close":
+		resp, err = pcli.CloseTable(ctx, id, *reason)
+	case "addbot":
+		resp, err = pcli.AddBot(ctx, id, *strategyName, *think)
+	}
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(resp)
+}
+
+func handleSitOut(ctx context.Context, pcli *client.PokerClient, cmd string, args []string) error {
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	tableID := fs.String("table-id", "", "Table ID")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%s: %w", cmd, err)
+	}
+	id := *tableID
+	if id == "" {
+		id = pcli.GetCurrentTableID()
+		if id == "" {
+			return fmt.Errorf("%s: no table-id provided and not joined to a table", cmd)
+		}
+	}
+
+	var resp interface{}
+	var err error
+	if cmd == "sitout" {
+		resp, err = pcli.SitOut(ctx, id)
+	} else {
+		resp, err = pcli.SitIn(ctx, id)
+	}
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(resp)
+}
+
+func handleChat(ctx context.Context, pcli *client.PokerClient, args []string) error {
+	fs := flag.NewFlagSet("chat", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	tableID := fs.String("table-id", "", "Table ID")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("chat: %w", err)
+	}
+	text := strings.Join(fs.Args(), " ")
+	if text == "" {
+		return errors.New("chat: message text is required")
+	}
+	id := *tableID
+	if id == "" {
+		id = pcli.GetCurrentTableID()
+		if id == "" {
+			return fmt.Errorf("chat: no table-id provided and not joined to a table")
+		}
+	}
+
+	resp, err := pcli.SendChatMessage(ctx, id, text)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(resp)
+}
+
+func handleTimeBank(ctx context.Context, pcli *client.PokerClient, args []string) error {
+	fs := flag.NewFlagSet("timebank", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	tableID := fs.String("table-id", "", "Table ID")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("timebank: %w", err)
+	}
+	id := *tableID
+	if id == "" {
+		id = pcli.GetCurrentTableID()
+		if id == "" {
+			return fmt.Errorf("timebank: no table-id provided and not joined to a table")
+		}
+	}
+
+	resp, err := pcli.UseTimeBank(ctx, id)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(resp)
 }
 
 func handleState(ctx context.Context, pcli *client.PokerClient, args []string) error {
@@ -320,7 +753,7 @@ func handleState(ctx context.Context, pcli *client.PokerClient, args []string) e
 			return errors.New("state: no table-id provided and not joined to a table")
 		}
 	}
-	resp, err := pcli.PokerService.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: id})
+	resp, err := pcli.PokerService.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: id, PlayerId: pcli.ID})
 	if err != nil {
 		return err
 	}
@@ -397,6 +830,38 @@ func handleStream(ctx context.Context, pcli *client.PokerClient, args []string)
 	}
 }
 
+func handleWatch(ctx context.Context, pcli *client.PokerClient, args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	tableID := fs.String("table-id", "", "Table ID")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("watch: %w", err)
+	}
+	if *tableID == "" {
+		return errors.New("watch: --table-id is required")
+	}
+	if err := pcli.WatchTable(ctx, *tableID); err != nil {
+		return err
+	}
+	defer pcli.StopWatching()
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	for {
+		select {
+		case msg := <-pcli.UpdatesCh:
+			if gu, ok := (any)(msg).(client.GameUpdateMsg); ok {
+				if err := enc.Encode((*pokerrpc.GameUpdate)(gu)); err != nil {
+					return err
+				}
+			}
+		case err := <-pcli.ErrorsCh:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 // --- Events (Notifications) ---
 
 func handleEvents(ctx context.Context, pcli *client.PokerClient, args []string) error {
@@ -440,7 +905,7 @@ func handleEvents(ctx context.Context, pcli *client.PokerClient, args []string)
 }
 
 func gameStarted(ctx context.Context, pcli *client.PokerClient, tableID string) (bool, *pokerrpc.GameUpdate, error) {
-	resp, err := pcli.PokerService.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID})
+	resp, err := pcli.PokerService.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID, PlayerId: pcli.ID})
 	if err != nil {
 		return false, nil, err
 	}
@@ -592,6 +1057,77 @@ func handleAct(ctx context.Context, pcli *client.PokerClient, args []string) err
 	}
 }
 
+// --- Autoplay ---
+
+// autoplayAction is the line autoplay prints for each action it takes.
+type autoplayAction struct {
+	Phase  string `json:"phase"`
+	Pot    int64  `json:"pot"`
+	Action string `json:"action"`
+	Amount int64  `json:"amount,omitempty"`
+}
+
+func handleAutoplay(ctx context.Context, pcli *client.PokerClient, args []string) error {
+	fs := flag.NewFlagSet("autoplay", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	tableID := fs.String("table-id", "", "Table ID (joined if not seated there yet)")
+	strategyName := fs.String("strategy", "", "Strategy: "+strings.Join(strategy.Names(), ", "))
+	seed := fs.Int64("seed", 0, "Random seed of the strategy (0 = time based)")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("autoplay: %w", err)
+	}
+	if *strategyName == "" {
+		return errors.New("autoplay: --strategy is required")
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	strat, err := strategy.New(*strategyName, *seed)
+	if err != nil {
+		return fmt.Errorf("autoplay: %w", err)
+	}
+
+	if *tableID == "" {
+		if *tableID, err = pcli.GetPlayerCurrentTable(ctx); err != nil {
+			return err
+		}
+		if *tableID == "" {
+			return errors.New("join a table first or pass --table-id")
+		}
+	} else if cur, err := pcli.GetPlayerCurrentTable(ctx); err != nil {
+		return err
+	} else if cur != *tableID {
+		if err := pcli.JoinTable(ctx, *tableID); err != nil {
+			return err
+		}
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	a, err := agent.New(agent.Config{
+		PlayerID: pcli.ID,
+		TableID:  *tableID,
+		Lobby:    pcli.LobbyService,
+		Poker:    pcli.PokerService,
+		Strategy: strat,
+		OnAction: func(update *pokerrpc.GameUpdate, action strategy.Action) {
+			enc.Encode(autoplayAction{
+				Phase:  update.Phase.String(),
+				Pot:    update.Pot,
+				Action: action.Kind.String(),
+				Amount: action.Amount,
+			})
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Stop cleanly on interrupt so the player is left unready.
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return a.Run(ctx)
+}
+
 func handleLastWinners(ctx context.Context, pcli *client.PokerClient, args []string) error {
 	fs := flag.NewFlagSet("last-winners", flag.ContinueOnError)
 	fs.SetOutput(io.Discard)
@@ -649,6 +1185,22 @@ func parseTypes(csv string) map[string]bool {
 	return set
 }
 
+// parseTimeArg parses an RFC3339 time or a YYYY-MM-DD date (UTC midnight)
+// into Unix seconds. An empty string yields 0.
+func parseTimeArg(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Unix(), nil
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	return t.Unix(), nil
+}
+
 func parseNotificationTypeRelaxed(s string) pokerrpc.NotificationType {
 	s = strings.TrimSpace(strings.ToUpper(strings.ReplaceAll(s, " ", "_")))
 	for k, v := range pokerrpc.NotificationType_value {
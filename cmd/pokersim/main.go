@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/poker/sim"
+	"github.com/vctt94/pokerbisonrelay/pkg/strategy"
+)
+
+var (
+	strategies = flag.String("strategies", "tag,random", "Comma separated strategy of each seat ("+strings.Join(strategy.Names(), ", ")+")")
+	seeds      = flag.Int("seeds", 100, "Number of seeds, each played on its own table")
+	firstSeed  = flag.Int64("first-seed", 1, "First seed")
+	hands      = flag.Int("hands", 1000, "Hands played per seed")
+	smallBlind = flag.Int64("small-blind", 10, "Small blind")
+	bigBlind   = flag.Int64("big-blind", 20, "Big blind")
+	chips      = flag.Int64("chips", 0, "Starting chips of each seat (0 = 100 big blinds)")
+	workers    = flag.Int("workers", 0, "Tables played at once (0 = one per CPU)")
+	fuzz       = flag.Bool("fuzz", false, "Play random table setups with random legal actions instead")
+)
+
+func main() {
+	flag.Parse()
+
+	cfg := sim.Config{
+		Strategies:    strings.Split(*strategies, ","),
+		Hands:         *hands,
+		SmallBlind:    *smallBlind,
+		BigBlind:      *bigBlind,
+		StartingChips: *chips,
+		Workers:       *workers,
+		Fuzz:          *fuzz,
+	}
+	for i := 0; i < *seeds; i++ {
+		cfg.Seeds = append(cfg.Seeds, *firstSeed+int64(i))
+	}
+
+	start := time.Now()
+	report, err := sim.Run(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(2)
+	}
+
+	fmt.Print(report)
+	fmt.Printf("took %v\n", time.Since(start).Round(time.Millisecond))
+	if report.ViolationCount > 0 || report.DisagreementCount > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/server"
+)
+
+// runVerifyAudit implements the `verify-audit` subcommand. It walks the audit
+// log chain and reports the first record that breaks it.
+func runVerifyAudit(dbPath, dsn string, args []string) error {
+	fs := flag.NewFlagSet("verify-audit", flag.ContinueOnError)
+	fs.StringVar(&dbPath, "db", dbPath, "Path to SQLite database file")
+	fs.StringVar(&dsn, "dsn", dsn, "PostgreSQL DSN; when set it is used instead of the SQLite -db file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	db, err := server.OpenDatabase(dbPath, dsn)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	n, head, err := server.VerifyAuditLog(db)
+	if err != nil {
+		return fmt.Errorf("audit log broken after %d valid records: %v", n, err)
+	}
+	fmt.Printf("Audit log intact: %d records\n", n)
+	if n > 0 {
+		// Records cut from the end leave a valid chain; only a head noted
+		// earlier reveals them.
+		fmt.Printf("Head hash: %s\n", head)
+	}
+	return nil
+}
@@ -4,34 +4,59 @@ import (
 	"flag"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strconv"
+	"syscall"
+	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/vctt94/bisonbotkit/logging"
 	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
 	"github.com/vctt94/pokerbisonrelay/pkg/server"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 func main() {
 	var (
 		dbPath      string
+		dsn         string
+		memDB       bool
 		host        string
 		port        int
 		portFile    string
 		seed        int64
 		autoStartMs int
 		debugLevel  string
+		adminSocket string
+		adminToken  string
+		tlsCert     string
+		tlsKey      string
+		drainTime   time.Duration
+		metricsAddr string
+		invariants  bool
+		seatOffer   time.Duration
 	)
 	flag.StringVar(&dbPath, "db", "", "Path to SQLite database file (created if missing)")
+	flag.StringVar(&dsn, "dsn", "", "PostgreSQL DSN; when set it is used instead of the SQLite -db file")
+	flag.BoolVar(&memDB, "memdb", false, "Keep all state in memory; nothing survives a restart")
 	flag.StringVar(&host, "host", "127.0.0.1", "Host to listen on")
 	flag.IntVar(&port, "port", 0, "Port to listen on (0 for random free port)")
 	flag.StringVar(&portFile, "portfile", "", "If set, write selected port to this file")
 	flag.Int64Var(&seed, "seed", 0, "Deterministic RNG seed for decks (0 = random)")
 	flag.IntVar(&autoStartMs, "autostartms", 0, "Auto-start delay between hands in milliseconds (0 = server default)")
 	flag.StringVar(&debugLevel, "debuglevel", "info", "Logging level: trace, debug, info, warn, error")
+	flag.StringVar(&adminSocket, "adminsocket", "", "If set, serve the admin service on this unix socket")
+	flag.StringVar(&adminToken, "admintoken", "", "If set, also serve the admin service on the main listener to callers presenting this token; requires -grpcservercert and -grpcserverkey")
+	flag.StringVar(&tlsCert, "grpcservercert", "", "Path to the TLS certificate of the main listener; plaintext when unset")
+	flag.StringVar(&tlsKey, "grpcserverkey", "", "Path to the TLS key of the main listener")
+	flag.DurationVar(&drainTime, "draintimeout", server.DefaultDrainTimeout, "On SIGTERM or interrupt, longest wait for running hands to finish before exiting")
+	flag.StringVar(&metricsAddr, "metricsaddr", "", "If set, serve /metrics, /healthz and /readyz over HTTP on this host:port")
+	flag.BoolVar(&invariants, "checkinvariants", false, "Debug mode: check the game invariants after every action, logging violations")
+	flag.DurationVar(&seatOffer, "seatoffer", server.DefaultSeatOfferWindow, "How long a waitlisted player has to take a seat offered to them")
 	flag.Parse()
 
 	if dbPath == "" {
@@ -40,8 +65,39 @@ func main() {
 		dbPath = filepath.Join(tmp, "poker_e2e.sqlite")
 	}
 
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(dbPath, dsn, flag.Args()[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+	if flag.Arg(0) == "verify-audit" {
+		if err := runVerifyAudit(dbPath, dsn, flag.Args()[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// The admin token would travel in cleartext on a plaintext listener.
+	if (tlsCert == "") != (tlsKey == "") {
+		fmt.Fprintln(os.Stderr, "-grpcservercert and -grpcserverkey must be set together")
+		os.Exit(2)
+	}
+	if adminToken != "" && tlsCert == "" {
+		fmt.Fprintln(os.Stderr, "-admintoken requires TLS (-grpcservercert and -grpcserverkey); use -adminsocket for local administration")
+		os.Exit(2)
+	}
+
 	// Init DB
-	db, err := server.NewDatabase(dbPath)
+	var db server.Database
+	var err error
+	if memDB {
+		db = server.NewMemoryDatabase()
+	} else {
+		db, err = server.OpenDatabase(dbPath, dsn)
+	}
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to init db: %v\n", err)
 		os.Exit(1)
@@ -53,6 +109,8 @@ func main() {
 
 	// Create server
 	pokerSrv := server.NewServer(db, logBackend)
+	pokerSrv.SetCheckInvariants(invariants)
+	pokerSrv.SetSeatOfferWindow(seatOffer)
 	if seed == 0 {
 		// Allow env override for convenience
 		if env := os.Getenv("POKER_SEED"); env != "" {
@@ -62,16 +120,60 @@ func main() {
 		}
 	}
 
-	// Insecure gRPC for local testing
+	// Plaintext gRPC unless a certificate is given, for local testing
 	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%d", host, port))
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to listen: %v\n", err)
 		os.Exit(1)
 	}
 
-	grpcSrv := grpc.NewServer()
+	adminSrv := server.NewAdminServer(pokerSrv)
+	var opts []grpc.ServerOption
+	if tlsCert != "" {
+		creds, err := credentials.NewServerTLSFromFile(tlsCert, tlsKey)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to load TLS certificate: %v\n", err)
+			os.Exit(1)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	if adminToken != "" {
+		opts = append(opts, grpc.UnaryInterceptor(server.AdminTokenInterceptor(adminToken)))
+	}
+	grpcSrv := grpc.NewServer(opts...)
 	pokerrpc.RegisterLobbyServiceServer(grpcSrv, pokerSrv)
 	pokerrpc.RegisterPokerServiceServer(grpcSrv, pokerSrv)
+	if adminToken != "" {
+		pokerrpc.RegisterAdminServiceServer(grpcSrv, adminSrv)
+	}
+
+	// Local admin socket
+	if adminSocket != "" {
+		adminLis, err := server.ListenAdminSocket(adminSocket)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to listen on admin socket: %v\n", err)
+			os.Exit(1)
+		}
+		adminGRPC := grpc.NewServer()
+		pokerrpc.RegisterAdminServiceServer(adminGRPC, adminSrv)
+		go func() {
+			if err := adminGRPC.Serve(adminLis); err != nil {
+				fmt.Fprintf(os.Stderr, "admin socket serve error: %v\n", err)
+			}
+		}()
+		defer adminGRPC.Stop()
+	}
+
+	// Monitoring endpoints
+	if metricsAddr != "" {
+		httpSrv := &http.Server{Addr: metricsAddr, Handler: pokerSrv.MonitorHandler(), ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			if err := httpSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				fmt.Fprintf(os.Stderr, "metrics serve error: %v\n", err)
+			}
+		}()
+		defer httpSrv.Close()
+	}
 
 	// Optionally write chosen port
 	if portFile != "" {
@@ -79,9 +181,29 @@ func main() {
 		_ = os.WriteFile(portFile, []byte(p), 0600)
 	}
 
-	// Serve (blocking)
-	if err := grpcSrv.Serve(lis); err != nil {
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- grpcSrv.Serve(lis)
+	}()
+
+	// The first signal drains the server; a second one exits right away.
+	// A drain started through the admin service also ends the process.
+	sigs := make(chan os.Signal, 2)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	select {
+	case err := <-serveErr:
 		fmt.Fprintf(os.Stderr, "grpc serve error: %v\n", err)
 		os.Exit(1)
+	case <-sigs:
+		fmt.Fprintf(os.Stderr, "draining, waiting up to %v for hands to finish (signal again to exit now)\n", drainTime)
+		pokerSrv.StartDrain(drainTime)
+		select {
+		case <-pokerSrv.Drained():
+		case <-sigs:
+		}
+	case <-pokerSrv.Drained():
 	}
+
+	grpcSrv.Stop()
+	pokerSrv.Stop()
 }
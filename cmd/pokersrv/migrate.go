@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/server"
+)
+
+// runMigrate implements the `migrate` subcommand. With --dry-run it only
+// lists the pending migrations; otherwise it upgrades the database.
+func runMigrate(dbPath, dsn string, args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "Print pending migrations without applying them")
+	fs.StringVar(&dbPath, "db", dbPath, "Path to SQLite database file")
+	fs.StringVar(&dsn, "dsn", dsn, "PostgreSQL DSN; when set it is used instead of the SQLite -db file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	// Never echo the DSN, it may carry credentials.
+	target := dbPath
+	if dsn != "" {
+		target = "postgres"
+	}
+
+	if *dryRun {
+		pendingFn := server.PendingMigrations
+		source := dbPath
+		if dsn != "" {
+			pendingFn, source = server.PendingPostgresMigrations, dsn
+		}
+		current, pending, err := pendingFn(source)
+		if err != nil {
+			return fmt.Errorf("failed to inspect database: %v", err)
+		}
+		fmt.Printf("Database: %s\n", target)
+		fmt.Printf("Current schema version: %d\n", current)
+		if len(pending) == 0 {
+			fmt.Println("Schema is up to date.")
+			return nil
+		}
+		fmt.Printf("Pending migrations (%d):\n", len(pending))
+		for _, m := range pending {
+			fmt.Printf("  %04d %s\n", m.Version, m.Name)
+		}
+		return nil
+	}
+
+	migrateFn, source := server.MigrateDatabase, dbPath
+	if dsn != "" {
+		migrateFn, source = server.MigratePostgresDatabase, dsn
+	}
+	result, err := migrateFn(source)
+	if err != nil {
+		return fmt.Errorf("migration failed: %v", err)
+	}
+	fmt.Printf("Database: %s\n", target)
+	if result.BackupPath != "" {
+		fmt.Printf("Backup written to %s\n", result.BackupPath)
+	}
+	for _, m := range result.Applied {
+		fmt.Printf("Applied %04d %s\n", m.Version, m.Name)
+	}
+	fmt.Printf("Schema version: %d -> %d\n", result.FromVersion, result.ToVersion)
+	return nil
+}
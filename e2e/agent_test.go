@@ -0,0 +1,111 @@
+package e2e
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/client/agent"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"github.com/vctt94/pokerbisonrelay/pkg/strategy"
+)
+
+// newAgent returns an agent playing strategyName for playerID over the
+// environment's connection.
+func (e *testEnv) newAgent(playerID, tableID, strategyName string, onAction func(*pokerrpc.GameUpdate, strategy.Action)) *agent.Agent {
+	e.t.Helper()
+	s, err := strategy.New(strategyName, 1)
+	require.NoError(e.t, err)
+	a, err := agent.New(agent.Config{
+		PlayerID:     playerID,
+		TableID:      tableID,
+		Lobby:        e.lobbyClient,
+		Poker:        e.pokerClient,
+		Strategy:     s,
+		PollInterval: 100 * time.Millisecond,
+		OnAction:     onAction,
+	})
+	require.NoError(e.t, err)
+	return a
+}
+
+func TestAgentsPlayHands(t *testing.T) {
+	t.Parallel()
+	env := newTestEnv(t)
+	defer env.Close()
+	ctx := context.Background()
+
+	players := []string{"agent-a", "agent-b"}
+	for _, p := range players {
+		env.setBalance(ctx, p, 10_000)
+	}
+	createResp, err := env.lobbyClient.CreateTable(ctx, &pokerrpc.CreateTableRequest{
+		PlayerId: players[0], SmallBlind: 10, BigBlind: 20, MinPlayers: 2, MaxPlayers: 2,
+		BuyIn: 1_000, StartingChips: 1_000, TimeBankSeconds: 30, AutoStartMs: 10,
+	})
+	require.NoError(t, err)
+	tableID := createResp.TableId
+	_, err = env.lobbyClient.JoinTable(ctx, &pokerrpc.JoinTableRequest{PlayerId: players[1], TableId: tableID})
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	actions := make(map[string]int)
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errs := make(chan error, len(players))
+	for i, p := range players {
+		p := p
+		a := env.newAgent(p, tableID, []string{"tag", "random"}[i], func(update *pokerrpc.GameUpdate, action strategy.Action) {
+			mu.Lock()
+			actions[p]++
+			mu.Unlock()
+		})
+		go func() { errs <- a.Run(runCtx) }()
+	}
+
+	// The agents get ready on their own and keep acting on their turns.
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return actions[players[0]] >= 5 && actions[players[1]] >= 5
+	}, 30*time.Second, 10*time.Millisecond)
+
+	cancel()
+	for range players {
+		select {
+		case err := <-errs:
+			assert.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("agent did not stop")
+		}
+	}
+
+	// Stopped agents no longer act.
+	mu.Lock()
+	before := actions[players[0]] + actions[players[1]]
+	mu.Unlock()
+	time.Sleep(300 * time.Millisecond)
+	mu.Lock()
+	assert.Equal(t, before, actions[players[0]]+actions[players[1]])
+	mu.Unlock()
+}
+
+func TestAgentNotSeated(t *testing.T) {
+	t.Parallel()
+	env := newTestEnv(t)
+	defer env.Close()
+	ctx := context.Background()
+
+	env.setBalance(ctx, "host", 10_000)
+	tableID := env.createStandardTable(ctx, "host", 2, 2)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	err := env.newAgent("stranger", tableID, "random", nil).Run(ctx)
+	assert.ErrorIs(t, err, agent.ErrNotSeated)
+	err = env.newAgent("host", "no-such-table", "random", nil).Run(ctx)
+	assert.ErrorIs(t, err, agent.ErrNotSeated)
+}
@@ -0,0 +1,236 @@
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// findPlayer returns the player with the given id from a game update.
+func findPlayer(state *pokerrpc.GameUpdate, playerID string) *pokerrpc.Player {
+	for _, p := range state.Players {
+		if p.Id == playerID {
+			return p
+		}
+	}
+	return nil
+}
+
+// toPokerCards converts RPC cards back into poker cards.
+func toPokerCards(cards []*pokerrpc.Card) []poker.Card {
+	out := make([]poker.Card, 0, len(cards))
+	for _, c := range cards {
+		out = append(out, poker.NewCardFromSuitValue(poker.Suit(c.Suit), poker.Value(c.Value)))
+	}
+	return out
+}
+
+// -----------------------------------------------------------------------------
+//
+//	SCENARIO: Server restart in the middle of an all-in hand with a side pot
+//
+// -----------------------------------------------------------------------------
+func TestRestartMidAllInPreservesSidePots(t *testing.T) {
+	t.Parallel()
+	env := newTestEnv(t)
+	defer func() { env.Close() }()
+
+	ctx := context.Background()
+
+	players := []string{"r1", "r2", "r3"}
+	for _, p := range players {
+		env.setBalance(ctx, p, 10_000)
+	}
+
+	createResp, err := env.lobbyClient.CreateTable(ctx, &pokerrpc.CreateTableRequest{
+		PlayerId:      players[0],
+		SmallBlind:    10,
+		BigBlind:      20,
+		MinPlayers:    3,
+		MaxPlayers:    3,
+		BuyIn:         1_000,
+		MinBalance:    1_000,
+		StartingChips: 1_000,
+		AutoStartMs:   300,
+	})
+	require.NoError(t, err)
+	tableID := createResp.TableId
+
+	for _, p := range players[1:] {
+		_, err := env.lobbyClient.JoinTable(ctx, &pokerrpc.JoinTableRequest{PlayerId: p, TableId: tableID})
+		require.NoError(t, err)
+	}
+	for _, p := range players {
+		_, err := env.lobbyClient.SetPlayerReady(ctx, &pokerrpc.SetPlayerReadyRequest{PlayerId: p, TableId: tableID})
+		require.NoError(t, err)
+	}
+	env.waitForGameStart(ctx, tableID, 3*time.Second)
+	env.waitForGamePhase(ctx, tableID, pokerrpc.GamePhase_PRE_FLOP, 3*time.Second)
+
+	// Hand 1: build unequal stacks. The first actor raises to 100 and the
+	// second calls while the third folds; on the flop the caller checks, the
+	// raiser bets 100 and the caller folds.
+	state := env.getGameState(ctx, tableID)
+	raiser := state.CurrentPlayer
+	_, err = env.pokerClient.MakeBet(ctx, &pokerrpc.MakeBetRequest{PlayerId: raiser, TableId: tableID, Amount: 100})
+	require.NoError(t, err)
+	caller := env.getGameState(ctx, tableID).CurrentPlayer
+	_, err = env.pokerClient.CallBet(ctx, &pokerrpc.CallBetRequest{PlayerId: caller, TableId: tableID})
+	require.NoError(t, err)
+	folder := env.getGameState(ctx, tableID).CurrentPlayer
+	_, err = env.pokerClient.FoldBet(ctx, &pokerrpc.FoldBetRequest{PlayerId: folder, TableId: tableID})
+	require.NoError(t, err)
+
+	state = env.getGameState(ctx, tableID)
+	require.Equal(t, pokerrpc.GamePhase_FLOP, state.Phase)
+	require.Equal(t, caller, state.CurrentPlayer)
+	_, err = env.pokerClient.CheckBet(ctx, &pokerrpc.CheckBetRequest{PlayerId: caller, TableId: tableID})
+	require.NoError(t, err)
+	require.Equal(t, raiser, env.getGameState(ctx, tableID).CurrentPlayer)
+	_, err = env.pokerClient.MakeBet(ctx, &pokerrpc.MakeBetRequest{PlayerId: raiser, TableId: tableID, Amount: 100})
+	require.NoError(t, err)
+	_, err = env.pokerClient.FoldBet(ctx, &pokerrpc.FoldBetRequest{PlayerId: caller, TableId: tableID})
+	require.NoError(t, err)
+
+	// Hand 2 auto-starts.
+	env.waitForGamePhase(ctx, tableID, pokerrpc.GamePhase_PRE_FLOP, 3*time.Second)
+	state = env.getGameState(ctx, tableID)
+
+	stacks := make(map[string]int64, len(players))
+	var totalChips int64
+	for _, p := range state.Players {
+		stacks[p.Id] = p.Balance + p.CurrentBet
+		totalChips += p.Balance + p.CurrentBet
+	}
+	require.Equal(t, int64(3_000), totalChips)
+	shortStack := players[0]
+	for _, p := range players {
+		if stacks[p] < stacks[shortStack] {
+			shortStack = p
+		}
+	}
+
+	// Pre-flop: the short stack shoves and everyone else calls.
+	deadline := time.Now().Add(10 * time.Second)
+	for state.Phase == pokerrpc.GamePhase_PRE_FLOP {
+		require.True(t, time.Now().Before(deadline), "pre-flop did not complete")
+		cur := findPlayer(state, state.CurrentPlayer)
+		require.NotNil(t, cur)
+		switch {
+		case cur.Id == shortStack:
+			_, err = env.pokerClient.MakeBet(ctx, &pokerrpc.MakeBetRequest{
+				PlayerId: cur.Id, TableId: tableID, Amount: cur.Balance + cur.CurrentBet,
+			})
+		case cur.CurrentBet < state.CurrentBet:
+			_, err = env.pokerClient.CallBet(ctx, &pokerrpc.CallBetRequest{PlayerId: cur.Id, TableId: tableID})
+		default:
+			_, err = env.pokerClient.CheckBet(ctx, &pokerrpc.CheckBetRequest{PlayerId: cur.Id, TableId: tableID})
+		}
+		require.NoError(t, err)
+		state = env.getGameState(ctx, tableID)
+	}
+	require.Equal(t, pokerrpc.GamePhase_FLOP, state.Phase)
+	require.Zero(t, findPlayer(state, shortStack).Balance, "short stack should be all-in")
+
+	// Flop: the two covering players build a side pot.
+	_, err = env.pokerClient.MakeBet(ctx, &pokerrpc.MakeBetRequest{PlayerId: state.CurrentPlayer, TableId: tableID, Amount: 50})
+	require.NoError(t, err)
+	state = env.getGameState(ctx, tableID)
+	_, err = env.pokerClient.CallBet(ctx, &pokerrpc.CallBetRequest{PlayerId: state.CurrentPlayer, TableId: tableID})
+	require.NoError(t, err)
+	state = env.getGameState(ctx, tableID)
+	require.Equal(t, pokerrpc.GamePhase_TURN, state.Phase)
+	potBefore := state.Pot
+
+	// Wait for the TURN snapshot to hit the database.
+	var potState poker.PotManagerState
+	require.Eventually(t, func() bool {
+		ts, err := env.db.LoadTableState(tableID)
+		if err != nil || ts.GamePhase != pokerrpc.GamePhase_TURN.String() {
+			return false
+		}
+		raw, _ := ts.PotState.(string)
+		potState = poker.PotManagerState{}
+		if raw == "" || json.Unmarshal([]byte(raw), &potState) != nil {
+			return false
+		}
+		var total int64
+		for _, pot := range potState.Pots {
+			total += pot.Amount
+		}
+		return total == potBefore
+	}, 5*time.Second, 50*time.Millisecond)
+	require.Len(t, potState.Pots, 2, "expected a main pot and a side pot")
+	require.Len(t, potState.Pots[1].EligiblePlayers, 2, "short stack must not be eligible for the side pot")
+
+	dbPlayers, err := env.db.LoadPlayerStates(tableID)
+	require.NoError(t, err)
+	sort.Slice(dbPlayers, func(i, j int) bool { return dbPlayers[i].TableSeat < dbPlayers[j].TableSeat })
+	boardBefore := state.CommunityCards
+
+	// Crash and restore.
+	env.restart()
+
+	state = env.getGameState(ctx, tableID)
+	require.Equal(t, pokerrpc.GamePhase_TURN, state.Phase)
+	assert.Equal(t, potBefore, state.Pot)
+	require.Zero(t, findPlayer(state, shortStack).Balance, "short stack should be all-in")
+
+	// Check it down to showdown.
+	deadline = time.Now().Add(10 * time.Second)
+	for state.Phase != pokerrpc.GamePhase_SHOWDOWN {
+		require.True(t, time.Now().Before(deadline), "hand did not reach showdown")
+		_, err = env.pokerClient.CheckBet(ctx, &pokerrpc.CheckBetRequest{PlayerId: state.CurrentPlayer, TableId: tableID})
+		require.NoError(t, err)
+		state = env.getGameState(ctx, tableID)
+	}
+	require.Len(t, state.CommunityCards, 5)
+	for i, c := range boardBefore {
+		assert.Equal(t, c.Suit, state.CommunityCards[i].Suit)
+		assert.Equal(t, c.Value, state.CommunityCards[i].Value)
+	}
+
+	// Settle the persisted pot structure independently and compare with the
+	// payouts made by the restored server.
+	board := toPokerCards(state.CommunityCards)
+	pm, err := poker.NewPotManagerFromState(&potState)
+	require.NoError(t, err)
+	oracle := make([]*poker.Player, len(dbPlayers))
+	for i, ps := range dbPlayers {
+		p := poker.NewPlayer(ps.PlayerID, ps.PlayerID, ps.Balance)
+		p.SetGameState(ps.GameState)
+		var hand []poker.Card
+		raw, _ := ps.Hand.(string)
+		require.NoError(t, json.Unmarshal([]byte(raw), &hand))
+		hv, err := poker.EvaluateHand(hand, board)
+		require.NoError(t, err)
+		p.HandValue = &hv
+		oracle[i] = p
+	}
+	require.NoError(t, pm.DistributePots(oracle))
+
+	winnersResp, err := env.pokerClient.GetLastWinners(ctx, &pokerrpc.GetLastWinnersRequest{TableId: tableID})
+	require.NoError(t, err)
+	winnings := make(map[string]int64)
+	for _, w := range winnersResp.Winners {
+		winnings[w.PlayerId] += w.Winnings
+	}
+
+	var finalChips int64
+	for i, ps := range dbPlayers {
+		expected := oracle[i].Balance - ps.Balance
+		assert.Equal(t, expected, winnings[ps.PlayerID], "winnings for %s", ps.PlayerID)
+		final := findPlayer(state, ps.PlayerID)
+		require.NotNil(t, final)
+		assert.Equal(t, oracle[i].Balance, final.Balance, "final stack for %s", ps.PlayerID)
+		finalChips += final.Balance
+	}
+	assert.Equal(t, totalChips, finalChips, "chips must be conserved across the restart")
+}
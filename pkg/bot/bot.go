@@ -2,21 +2,23 @@ package bot
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/companyzero/bisonrelay/clientrpc/types"
 	"github.com/companyzero/bisonrelay/zkidentity"
 	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/slog"
 	kit "github.com/vctt94/bisonbotkit"
-	"github.com/vctt94/bisonbotkit/logging"
-	"github.com/vctt94/pokerbisonrelay/pkg/poker"
 	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
 	"github.com/vctt94/pokerbisonrelay/pkg/server"
 	"google.golang.org/grpc"
@@ -25,23 +27,64 @@ import (
 
 const STARTING_CHIPS = 1000
 
+// Table settings used for tables created through the create command.
+const (
+	tableMinPlayers      = 2
+	tableMaxPlayers      = 6
+	tableSmallBlind      = 10
+	tableBigBlind        = 20
+	tableTimeBankSeconds = 6
+)
+
 // State holds the state of the poker bot
 type State struct {
-	db     server.Database
-	tables map[string]*poker.Table
-	mu     sync.RWMutex
+	srv         *server.Server
+	db          server.Database
+	withdrawals *server.Withdrawals
+
+	// relayed queues server notifications to be sent to players as PMs
+	relayed chan relayedNotification
+	dropped atomic.Uint64 // Notifications dropped because relayed was full
+	// dropping is set from a drop until the next notification is queued,
+	// so a burst of drops is logged once.
+	dropping atomic.Bool
+
+	// pendingUpdates holds the latest game update of each player not yet
+	// relayed. A newer update replaces it, so updates are never dropped.
+	updatesMu      sync.Mutex
+	pendingUpdates map[string]*pokerrpc.GameUpdate
+	updatesReady   chan struct{} // Signalled when pendingUpdates is filled
+
+	log slog.Logger
+
+	// gc mirrors tables bound to group chats
+	gc *gcRelay
 }
 
-// NewState creates a new bot state with the given database
-func NewState(db server.Database) *State {
+// NewState creates a new bot state whose table commands are served by srv,
+// the same server that serves gRPC clients.
+func NewState(srv *server.Server, db server.Database, withdrawals *server.Withdrawals) *State {
 	return &State{
-		db:     db,
-		tables: make(map[string]*poker.Table),
+		srv:            srv,
+		db:             db,
+		withdrawals:    withdrawals,
+		relayed:        make(chan relayedNotification, relayQueueSize),
+		updatesReady:   make(chan struct{}, 1),
+		pendingUpdates: make(map[string]*pokerrpc.GameUpdate),
+		gc:             newGCRelay(),
+		log:            slog.Disabled,
 	}
 }
 
-// SetupGRPCServer sets up and returns a configured GRPC server with TLS
-func SetupGRPCServer(datadir, certFile, keyFile, serverAddress string, db server.Database, logBackend *logging.LogBackend) (*grpc.Server, net.Listener, error) {
+// SetLogger sets the logger reporting relay problems, such as dropped
+// notifications.
+func (s *State) SetLogger(log slog.Logger) {
+	s.log = log
+}
+
+// SetupGRPCServer sets up and returns a configured GRPC server with TLS,
+// serving pokerServer. opts are added to the server's options.
+func SetupGRPCServer(datadir, certFile, keyFile, serverAddress string, pokerServer *server.Server, opts ...grpc.ServerOption) (*grpc.Server, net.Listener, error) {
 	// Determine certificate and key file paths
 	grpcCertFile := certFile
 	grpcKeyFile := keyFile
@@ -69,7 +112,7 @@ func SetupGRPCServer(datadir, certFile, keyFile, serverAddress string, db server
 	}
 
 	// Create gRPC server with TLS credentials
-	grpcServer := grpc.NewServer(grpc.Creds(creds))
+	grpcServer := grpc.NewServer(append([]grpc.ServerOption{grpc.Creds(creds)}, opts...)...)
 
 	// Create listener
 	grpcLis, err := net.Listen("tcp", serverAddress)
@@ -77,8 +120,7 @@ func SetupGRPCServer(datadir, certFile, keyFile, serverAddress string, db server
 		return nil, nil, fmt.Errorf("failed to listen for gRPC poker server: %v", err)
 	}
 
-	// Initialize and register the poker server
-	pokerServer := server.NewServer(db, logBackend)
+	// Register the poker server
 	pokerrpc.RegisterLobbyServiceServer(grpcServer, pokerServer)
 	pokerrpc.RegisterPokerServiceServer(grpcServer, pokerServer)
 
@@ -110,11 +152,71 @@ func (s *State) HandlePM(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM
 	case "create":
 		s.handleCreateTable(ctx, bot, pm, tokens, playerID)
 
+	case "create-private":
+		s.handleCreatePrivateTable(ctx, bot, pm, tokens, playerID)
+
+	case "invite":
+		s.handleInvite(ctx, bot, pm, tokens, playerID)
+
+	case "create-gc-table":
+		s.handleCreateGCTable(ctx, bot, pm, tokens, playerID)
+
+	case "bind":
+		s.handleBind(ctx, bot, pm, tokens, playerID)
+
 	case "join":
 		s.handleJoinTable(ctx, bot, pm, tokens, playerID)
 
+	case "reserve":
+		s.handleReserveSeat(ctx, bot, pm, tokens, playerID)
+
+	case "waitlist":
+		s.handleJoinWaitlist(ctx, bot, pm, tokens, playerID)
+
+	case "unwaitlist":
+		s.handleLeaveWaitlist(ctx, bot, pm, tokens, playerID)
+
 	case "tables":
-		s.handleListTables(ctx, bot, pm)
+		s.handleListTables(ctx, bot, pm, playerID)
+
+	case "ready":
+		s.handleReady(ctx, bot, pm, playerID, true)
+
+	case "unready":
+		s.handleReady(ctx, bot, pm, playerID, false)
+
+	case "sitout":
+		s.handleSitOut(ctx, bot, pm, playerID, true)
+
+	case "sitin":
+		s.handleSitOut(ctx, bot, pm, playerID, false)
+
+	case "check", "call", "bet", "raise", "fold", "allin":
+		s.handleAction(ctx, bot, pm, tokens, playerID)
+
+	case "chat", "say":
+		s.handleChat(ctx, bot, pm, tokens, playerID)
+
+	case "kick", "ban", "mute", "unmute", "pause", "resume", "close", "addbot":
+		s.handleHostAction(ctx, bot, pm, tokens, playerID)
+
+	case "timebank":
+		s.handleTimeBank(ctx, bot, pm, playerID)
+
+	case "show":
+		s.handleShow(ctx, bot, pm, playerID)
+
+	case "status":
+		s.handleStatus(ctx, bot, pm, playerID)
+
+	case "history":
+		s.handleHistory(ctx, bot, pm, tokens, playerID)
+
+	case "withdraw":
+		s.handleWithdraw(ctx, bot, pm, tokens, playerID)
+
+	case "withdrawals":
+		s.handleListWithdrawals(ctx, bot, pm, playerID)
 
 	case "help":
 		s.handleHelp(ctx, bot, pm)
@@ -125,198 +227,427 @@ func (s *State) HandlePM(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM
 }
 
 func (s *State) handleCreateTable(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
-	if len(tokens) < 2 {
-		bot.SendPM(ctx, pm.Nick, "Usage: create <buy-in amount in DCR> [starting-chips]")
-		return
+	s.createTable(ctx, bot, pm, tokens[1:], "Usage: create <buy-in amount in DCR> [starting-chips]", playerID, false)
+}
+
+func (s *State) handleCreatePrivateTable(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
+	s.createTable(ctx, bot, pm, tokens[1:], "Usage: create-private <buy-in amount in DCR> [starting-chips]", playerID, true)
+}
+
+// createTable creates a table hosted by the player from the [buy-in
+// [starting-chips]] arguments of a PM command and returns its ID. Private
+// tables are hidden from the table list and joined by invite. It PMs the
+// player the outcome and returns an empty string when no table was created.
+func (s *State) createTable(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, args []string, usage, playerID string, private bool) string {
+	if len(args) < 1 {
+		bot.SendPM(ctx, pm.Nick, usage)
+		return ""
 	}
 
 	// Parse buy-in amount
-	buyInFloat, err := strconv.ParseFloat(tokens[1], 64)
+	buyInFloat, err := strconv.ParseFloat(args[0], 64)
 	if err != nil {
 		bot.SendPM(ctx, pm.Nick, "Invalid buy-in amount. Please enter a valid number.")
-		return
+		return ""
 	}
 
 	buyIn, err := dcrutil.NewAmount(buyInFloat)
 	if err != nil {
 		bot.SendPM(ctx, pm.Nick, "Invalid DCR amount. Please enter a valid number.")
-		return
+		return ""
 	}
 
 	// Parse starting chips (optional, default to 1000)
 	startingChips := int64(STARTING_CHIPS)
-	if len(tokens) >= 3 {
-		parsed, err := strconv.ParseInt(tokens[2], 10, 64)
+	if len(args) >= 2 {
+		parsed, err := strconv.ParseInt(args[1], 10, 64)
 		if err != nil {
 			bot.SendPM(ctx, pm.Nick, "Invalid starting chips amount. Please enter a valid number.")
-			return
+			return ""
 		}
 		if parsed <= 0 {
 			bot.SendPM(ctx, pm.Nick, "Starting chips must be greater than 0.")
-			return
+			return ""
 		}
 		startingChips = parsed
 	}
 
-	// Check player balance
-	balance, err := s.db.GetPlayerBalance(playerID)
+	resp, err := s.srv.CreateTable(ctx, &pokerrpc.CreateTableRequest{
+		PlayerId:        playerID,
+		SmallBlind:      tableSmallBlind,
+		BigBlind:        tableBigBlind,
+		MaxPlayers:      tableMaxPlayers,
+		MinPlayers:      tableMinPlayers,
+		BuyIn:           int64(buyIn),
+		StartingChips:   startingChips,
+		TimeBankSeconds: tableTimeBankSeconds,
+		Private:         private,
+	})
 	if err != nil {
-		bot.SendPM(ctx, pm.Nick, "Error checking balance: "+err.Error())
+		bot.SendPM(ctx, pm.Nick, "Error creating table: "+err.Error())
+		return ""
+	}
+
+	if private {
+		bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Private table %s created with buy-in of %.8f DCR and %d starting chips. "+
+			"Use 'invite <user>' to invite players; the game starts once all players are ready.",
+			resp.TableId, buyIn.ToCoin(), startingChips))
+		return resp.TableId
+	}
+
+	bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Table %s created with buy-in of %.8f DCR and %d starting chips. "+
+		"Others can use 'join %s' to join; the game starts once all players are ready.",
+		resp.TableId, buyIn.ToCoin(), startingChips, resp.TableId))
+	return resp.TableId
+}
+
+// parseSeatArgs parses the [seat=<n>] [password or invite code] arguments of
+// the join and reserve commands. Seat 0 means none was chosen.
+func parseSeatArgs(args []string) (seat int32, secret string, ok bool) {
+	for _, arg := range args {
+		if v, found := strings.CutPrefix(strings.ToLower(arg), "seat="); found {
+			n, err := strconv.ParseInt(v, 10, 32)
+			if err != nil || n <= 0 || seat != 0 {
+				return 0, "", false
+			}
+			seat = int32(n)
+			continue
+		}
+		if secret != "" {
+			return 0, "", false
+		}
+		secret = arg
+	}
+	return seat, secret, true
+}
+
+func (s *State) handleJoinTable(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
+	seat, secret, ok := parseSeatArgs(tokens[min(len(tokens), 2):])
+	if len(tokens) < 2 || !ok {
+		bot.SendPM(ctx, pm.Nick, "Usage: join <table-id> [seat=<n>] [password or invite code]")
 		return
 	}
 
-	if balance < int64(buyIn) {
-		bot.SendPM(ctx, pm.Nick, "Insufficient balance for buy-in.")
+	tableID := tokens[1]
+	req := &pokerrpc.JoinTableRequest{
+		PlayerId: playerID,
+		TableId:  tableID,
+		Seat:     seat,
+		// The server accepts either one, so the secret is offered as both.
+		Password:   secret,
+		InviteCode: secret,
+	}
+	resp, err := s.srv.JoinTable(ctx, req)
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Error joining table: "+err.Error())
+		return
+	}
+	if !resp.Success {
+		bot.SendPM(ctx, pm.Nick, "Could not join table: "+resp.Message)
 		return
 	}
 
-	// Create new table
-	tableID := fmt.Sprintf("table-%d", time.Now().Unix())
-	table := poker.NewTable(poker.TableConfig{
-		ID:            tableID,
-		HostID:        playerID,
-		BuyIn:         int64(buyIn), // DCR buy-in amount (in atoms)
-		MinPlayers:    2,
-		MaxPlayers:    6,
-		SmallBlind:    10,            // Fixed chip amount for small blind
-		BigBlind:      20,            // Fixed chip amount for big blind
-		StartingChips: startingChips, // Poker chips given to each player
-		TimeBank:      6 * time.Second,
-	})
+	bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Joined table %s in seat %d. The game starts once all players are ready.",
+		tableID, resp.Seat))
+}
 
-	// Add creator as user to table
-	_, err = table.AddNewUser(playerID, pm.Nick, balance, 0)
-	if err != nil {
-		bot.SendPM(ctx, pm.Nick, "Error creating table: "+err.Error())
+func (s *State) handleReserveSeat(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
+	seat, secret, ok := parseSeatArgs(tokens[min(len(tokens), 2):])
+	if len(tokens) < 2 || !ok {
+		bot.SendPM(ctx, pm.Nick, "Usage: reserve <table-id> [seat=<n>] [password or invite code]")
 		return
 	}
 
-	// Deduct DCR buy-in from creator's account balance
-	err = s.db.UpdatePlayerBalance(playerID, -int64(buyIn), "table buy-in", "created table")
+	tableID := tokens[1]
+	resp, err := s.srv.ReserveSeat(ctx, &pokerrpc.ReserveSeatRequest{
+		PlayerId:   playerID,
+		TableId:    tableID,
+		Seat:       seat,
+		Password:   secret,
+		InviteCode: secret,
+	})
 	if err != nil {
-		bot.SendPM(ctx, pm.Nick, "Error deducting buy-in: "+err.Error())
+		bot.SendPM(ctx, pm.Nick, "Error reserving seat: "+errorMessage(err))
+		return
+	}
+	if !resp.Success {
+		bot.SendPM(ctx, pm.Nick, "Could not reserve a seat: "+resp.Message)
 		return
 	}
 
-	// Add table to state
-	s.mu.Lock()
-	s.tables[tableID] = table
-	s.mu.Unlock()
-
-	bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Table %s created with buy-in of %.8f DCR and %d starting chips. Use 'join %s' to join.",
-		tableID, buyIn.ToCoin(), startingChips, tableID))
+	bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Seat %d at table %s is held for you until %s. "+
+		"Tip the bot to top up your balance, then use 'join %s' to sit down.",
+		resp.Seat, tableID, time.Unix(resp.ExpiresAt, 0).Format("15:04"), tableID))
 }
 
-func (s *State) handleJoinTable(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
-	if len(tokens) < 2 {
-		bot.SendPM(ctx, pm.Nick, "Usage: join <table-id>")
+func (s *State) handleJoinWaitlist(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
+	if len(tokens) < 2 || len(tokens) > 3 {
+		bot.SendPM(ctx, pm.Nick, "Usage: waitlist <table-id> [password or invite code]")
 		return
 	}
 
 	tableID := tokens[1]
-	s.mu.RLock()
-	table, exists := s.tables[tableID]
-	s.mu.RUnlock()
+	var secret string
+	if len(tokens) == 3 {
+		secret = tokens[2]
+	}
+	resp, err := s.srv.JoinWaitlist(ctx, &pokerrpc.JoinWaitlistRequest{
+		PlayerId:   playerID,
+		TableId:    tableID,
+		Password:   secret,
+		InviteCode: secret,
+	})
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Error joining waitlist: "+errorMessage(err))
+		return
+	}
+	if !resp.Success {
+		bot.SendPM(ctx, pm.Nick, "Could not join the waitlist: "+resp.Message)
+		return
+	}
+
+	bot.SendPM(ctx, pm.Nick, fmt.Sprintf("You are number %d on the waitlist of table %s. "+
+		"You will be told when a seat is free for you.", resp.Position, tableID))
+}
 
-	if !exists {
-		bot.SendPM(ctx, pm.Nick, "Table not found.")
+func (s *State) handleLeaveWaitlist(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
+	if len(tokens) != 2 {
+		bot.SendPM(ctx, pm.Nick, "Usage: unwaitlist <table-id>")
 		return
 	}
 
-	// Check player DCR balance
-	dcrBalance, err := s.db.GetPlayerBalance(playerID)
+	resp, err := s.srv.LeaveWaitlist(ctx, &pokerrpc.LeaveWaitlistRequest{PlayerId: playerID, TableId: tokens[1]})
 	if err != nil {
-		bot.SendPM(ctx, pm.Nick, "Error checking balance: "+err.Error())
+		bot.SendPM(ctx, pm.Nick, "Error leaving waitlist: "+errorMessage(err))
 		return
 	}
+	bot.SendPM(ctx, pm.Nick, resp.Message)
+}
 
-	config := table.GetConfig()
+func (s *State) handleListTables(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, playerID string) {
+	resp, err := s.srv.GetTables(ctx, &pokerrpc.GetTablesRequest{PlayerId: playerID})
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Error listing tables: "+err.Error())
+		return
+	}
 
-	// Check if player has enough DCR for the buy-in
-	if dcrBalance < config.BuyIn {
-		bot.SendPM(ctx, pm.Nick, "Insufficient DCR balance for buy-in.")
+	if len(resp.Tables) == 0 {
+		bot.SendPM(ctx, pm.Nick, "No active tables.")
 		return
 	}
 
-	// Find next available seat
-	users := table.GetUsers()
-	occupiedSeats := make(map[int]bool)
-	for _, user := range users {
-		occupiedSeats[user.TableSeat] = true
+	tables := resp.Tables
+	sort.Slice(tables, func(i, j int) bool { return tables[i].Id < tables[j].Id })
+
+	var b strings.Builder
+	b.WriteString("Active tables:\n")
+	for _, t := range tables {
+		status := "waiting"
+		if t.GameStarted {
+			status = "in game"
+		}
+		if t.Private {
+			status += ", private"
+		}
+		if t.PasswordProtected {
+			status += ", password"
+		}
+		if n := len(t.ReservedSeats); n > 0 {
+			status += fmt.Sprintf(", %d reserved", n)
+		}
+		if n := len(t.Waitlist); n > 0 {
+			status += fmt.Sprintf(", %d waiting", n)
+		}
+		fmt.Fprintf(&b, "%s: %d/%d players, buy-in %.8f DCR, blinds %d/%d, %s\n", t.Id,
+			t.CurrentPlayers, t.MaxPlayers, dcrutil.Amount(t.BuyIn).ToCoin(), t.SmallBlind, t.BigBlind, status)
 	}
+	bot.SendPM(ctx, pm.Nick, b.String())
+}
 
-	nextSeat := 0
-	for i := 0; i < config.MaxPlayers; i++ {
-		if !occupiedSeats[i] {
-			nextSeat = i
-			break
+const (
+	// historyDefaultDays is the period summarized by the history command
+	// when no number of days is given.
+	historyDefaultDays = 30
+	// historyRecentCount is the number of individual transactions listed by
+	// the history command.
+	historyRecentCount = 10
+	// historyMaxTransactions bounds the transactions read for one summary.
+	historyMaxTransactions = 1000
+)
+
+// historyCategories lists the summary lines of the history command in the
+// order they are shown.
+var historyCategories = []string{"Deposits", "Buy-ins", "Cash-outs", "Tips", "Withdrawals", "Other"}
+
+// historyCategory returns the summary line a transaction type is counted in.
+func historyCategory(txType string) string {
+	switch txType {
+	case server.TransactionDeposit, server.TransactionLegacyDeposit:
+		return "Deposits"
+	case server.TransactionBuyIn:
+		return "Buy-ins"
+	case server.TransactionRefund:
+		return "Cash-outs"
+	case server.TransactionTipSent, server.TransactionTipReceived:
+		return "Tips"
+	case server.TransactionWithdrawal, server.TransactionWithdrawalReversal:
+		return "Withdrawals"
+	default:
+		return "Other"
+	}
+}
+
+func (s *State) handleHistory(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
+	days := historyDefaultDays
+	if len(tokens) > 1 {
+		n, err := strconv.Atoi(tokens[1])
+		if err != nil || n <= 0 {
+			bot.SendPM(ctx, pm.Nick, "Usage: history [days]")
+			return
 		}
+		days = n
 	}
 
-	// Add user to table
-	_, err = table.AddNewUser(playerID, pm.Nick, dcrBalance, nextSeat)
+	txs, err := s.db.GetTransactions(server.TransactionFilter{
+		PlayerID: playerID,
+		Since:    time.Now().AddDate(0, 0, -days),
+		Limit:    historyMaxTransactions,
+	})
 	if err != nil {
-		bot.SendPM(ctx, pm.Nick, "Error joining table: "+err.Error())
+		bot.SendPM(ctx, pm.Nick, "Error reading transaction history: "+err.Error())
 		return
 	}
-
-	// Deduct DCR buy-in from player's account balance
-	err = s.db.UpdatePlayerBalance(playerID, -config.BuyIn, "table buy-in", "joined table")
-	if err != nil {
-		// If balance update fails, remove player from table
-		table.RemoveUser(playerID)
-		bot.SendPM(ctx, pm.Nick, "Error deducting buy-in: "+err.Error())
+	if len(txs) == 0 {
+		bot.SendPM(ctx, pm.Nick, fmt.Sprintf("No transactions in the last %d days.", days))
 		return
 	}
 
-	// Notify all users at the table
-	users = table.GetUsers()
-	for _, u := range users {
-		if u.ID != playerID {
-			bot.SendPM(ctx, u.ID, fmt.Sprintf("%s has joined the table.", pm.Nick))
-		}
+	counts := make(map[string]int)
+	totals := make(map[string]int64)
+	for _, tx := range txs {
+		category := historyCategory(tx.Type)
+		counts[category]++
+		totals[category] += tx.Amount
 	}
 
-	bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Joined table %s. Current status:\n%s",
-		tableID, table.GetStatus()))
-
-	// Check if we can start the game
-	if len(users) >= table.GetMinPlayers() {
-		err = table.StartGame()
-		if err != nil {
-			bot.SendPM(ctx, pm.Nick, "Error starting game: "+err.Error())
-			return
+	var b strings.Builder
+	fmt.Fprintf(&b, "Transactions in the last %d days:\n", days)
+	for _, category := range historyCategories {
+		if counts[category] == 0 {
+			continue
 		}
+		fmt.Fprintf(&b, "- %s: %d, net %+.8f DCR\n", category, counts[category],
+			dcrutil.Amount(totals[category]).ToCoin())
+	}
 
-		// Notify all users
-		for _, u := range users {
-			bot.SendPM(ctx, u.ID, "Game started!")
+	b.WriteString("Most recent:\n")
+	for i, tx := range txs {
+		if i == historyRecentCount {
+			break
+		}
+		when := tx.CreatedAt
+		if t, err := time.Parse(time.RFC3339, tx.CreatedAt); err == nil {
+			when = t.Format("2006-01-02 15:04")
 		}
+		fmt.Fprintf(&b, "%s %s %+.8f DCR", when, tx.Type, dcrutil.Amount(tx.Amount).ToCoin())
+		if tx.Description != "" {
+			fmt.Fprintf(&b, " (%s)", tx.Description)
+		}
+		b.WriteString("\n")
+	}
+	if len(txs) == historyMaxTransactions {
+		fmt.Fprintf(&b, "Only the latest %d transactions were summarized.\n", historyMaxTransactions)
 	}
+	bot.SendPM(ctx, pm.Nick, b.String())
 }
 
-func (s *State) handleListTables(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+func (s *State) handleWithdraw(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
+	if len(tokens) != 2 {
+		bot.SendPM(ctx, pm.Nick, "Usage: withdraw <amount in DCR>")
+		return
+	}
+	amountFloat, err := strconv.ParseFloat(tokens[1], 64)
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Invalid amount. Please enter a valid number.")
+		return
+	}
+	amount, err := dcrutil.NewAmount(amountFloat)
+	if err != nil || amount <= 0 {
+		bot.SendPM(ctx, pm.Nick, "Invalid DCR amount. Please enter a positive number.")
+		return
+	}
+
+	withdrawal, err := s.withdrawals.Request(ctx, playerID, int64(amount), "")
+	switch {
+	case withdrawal != nil && err != nil:
+		bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Withdrawal %d could not be paid and was reversed: %v",
+			withdrawal.ID, err))
+	case errors.Is(err, server.ErrInsufficientBalance):
+		bot.SendPM(ctx, pm.Nick, "Insufficient balance for this withdrawal.")
+	case errors.Is(err, server.ErrWithdrawalLimit):
+		bot.SendPM(ctx, pm.Nick, fmt.Sprintf("This withdrawal would exceed the daily limit of %.8f DCR.",
+			dcrutil.Amount(s.withdrawals.DailyLimit()).ToCoin()))
+	case err != nil:
+		bot.SendPM(ctx, pm.Nick, "Error processing withdrawal: "+err.Error())
+	default:
+		bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Withdrawal %d of %.8f DCR is being sent to you as a tip. "+
+			"You will be notified once it completes.", withdrawal.ID, amount.ToCoin()))
+	}
+}
 
-	if len(s.tables) == 0 {
-		bot.SendPM(ctx, pm.Nick, "No active tables.")
+func (s *State) handleListWithdrawals(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, playerID string) {
+	withdrawals, err := s.withdrawals.List(playerID, historyRecentCount)
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Error reading withdrawals: "+err.Error())
+		return
+	}
+	if len(withdrawals) == 0 {
+		bot.SendPM(ctx, pm.Nick, "No withdrawals.")
 		return
 	}
 
-	msg := "Active tables:\n"
-	for id, table := range s.tables {
-		msg += fmt.Sprintf("%s: %d/%d players\n", id, len(table.GetUsers()), table.GetMaxPlayers())
+	var b strings.Builder
+	b.WriteString("Recent withdrawals:\n")
+	for _, w := range withdrawals {
+		fmt.Fprintf(&b, "#%d %.8f DCR %s", w.ID, dcrutil.Amount(w.Amount).ToCoin(), w.Status)
+		if w.Detail != "" {
+			fmt.Fprintf(&b, " (%s)", w.Detail)
+		}
+		b.WriteString("\n")
 	}
-	bot.SendPM(ctx, pm.Nick, msg)
+	bot.SendPM(ctx, pm.Nick, b.String())
 }
 
 func (s *State) handleHelp(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM) {
 	helpMsg := `Available commands:
 - balance: Check your current balance
 - create <amount> [starting-chips]: Create a new poker table with specified buy-in and optional starting chips (default: 1000)
-- join <table-id>: Join an existing poker table
+- create-gc-table <gc> <amount> [starting-chips]: Create a table whose public events are posted to a group chat the bot is in
+- bind <gc> [table-id]: Post the public events of a table you host (default: your current table) to a group chat
+- create-private <amount> [starting-chips]: Create a table hidden from the table list that players join by invite
+- invite <user> [table-id]: Send a user an invite code to a table you host (default: your current table)
+- join <table-id> [seat=<n>] [password or invite code]: Join an existing poker table, in the seat you reserved or choose
+- reserve <table-id> [seat=<n>] [password or invite code]: Hold a seat for a few minutes while you top up your balance for the buy-in
+- waitlist <table-id> [password or invite code]: Wait in line for a seat at a full table; you are told when one is free for you
+- unwaitlist <table-id>: Leave the waitlist of a table
 - tables: List all active tables
+- kick <player-id> [reason] / ban <player-id> [reason]: Remove a player from the table you host between hands, refunding their chips; banned players cannot rejoin
+- mute <player-id> / unmute <player-id>: Silence a player in the chat of the table you host, or let them talk again
+- pause / resume: Pause or resume the game at the table you host
+- close [reason]: Close the table you host once the current hand ends, refunding every player's chips
+- addbot <random|tag|equity>: Seat a bot at the table you host; you pay its buy-in and get its chips back when it leaves
+- ready / unready: Mark yourself ready to play, or not; the game starts once everyone is ready
+- sitout / sitin: Keep your seat but skip the next hands while you are away, or be dealt in again; players sitting out too long are removed
+- check, call, fold: Act on your turn
+- bet <chips> / raise <chips>: Bet or raise to a total of <chips> for this betting round
+- allin: Bet all your chips
+- timebank: Add your time bank to the clock of your turn; it refills a little every hand
+- chat <message> (or say): Send a message to the players at your table; you get theirs as PMs
+- show: Show your cards to the table
+- status: Show the table, the board and your cards
+- history [days]: Summarize your deposits, buy-ins, cash-outs and tips (default: last 30 days)
+- withdraw <amount>: Withdraw DCR from your balance, paid back to you as a tip
+- withdrawals: List your recent withdrawals and their status
 - help: Show this help message`
 	bot.SendPM(ctx, pm.Nick, helpMsg)
 }
@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"time"
 
+	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/vctt94/bisonbotkit/config"
 	"github.com/vctt94/bisonbotkit/utils"
+	"github.com/vctt94/pokerbisonrelay/pkg/server"
 )
 
 // BotConfig represents the processed bot configuration
@@ -18,8 +22,44 @@ type BotConfig struct {
 	KeyFile       string
 	MaxLogFiles   string
 	LogFile       string
+	DatabaseDSN   string // PostgreSQL DSN; empty to use the SQLite file in DataDir
+
+	// WithdrawDailyLimit is the most a player may withdraw in 24 hours, in
+	// atoms. 0 disables the limit.
+	WithdrawDailyLimit int64
+
+	// Wallet pays withdrawals to payout addresses; nil when the config
+	// names no wallet, so that only tips are paid.
+	Wallet *WalletConfig
+
+	// GCMessageInterval is the least time between two messages the bot
+	// posts to the same group chat. Table events in between are batched.
+	GCMessageInterval time.Duration
+
+	// AdminSocket is the unix socket the admin service is served on; empty
+	// to not serve it locally.
+	AdminSocket string
+	// AdminToken, when set, also serves the admin service on the gRPC
+	// listener to callers presenting it.
+	AdminToken string
+
+	// DrainTimeout is the longest the bot waits for running hands to
+	// finish when asked to stop.
+	DrainTimeout time.Duration
+
+	// MetricsAddress is the host:port /metrics, /healthz and /readyz are
+	// served on over HTTP; empty to not serve them.
+	MetricsAddress string
 }
 
+// defaultWithdrawDailyLimit is the daily withdrawal limit, in DCR, used when
+// the config does not set withdrawdailylimit.
+const defaultWithdrawDailyLimit = 10.0
+
+// defaultGCMessageInterval is used when the config does not set
+// gcmsginterval.
+const defaultGCMessageInterval = 5 * time.Second
+
 // LoadBotConfig loads and processes the bot configuration
 func LoadBotConfig(appName, datadir string) (*BotConfig, error) {
 	// Set up configuration directory
@@ -52,6 +92,51 @@ func LoadBotConfig(appName, datadir string) (*BotConfig, error) {
 	}
 	serverAddress := fmt.Sprintf("%s:%s", grpcHost, grpcPort)
 
+	// Daily withdrawal limit in DCR
+	withdrawLimit := defaultWithdrawDailyLimit
+	if v := cfg.ExtraConfig["withdrawdailylimit"]; v != "" {
+		withdrawLimit, err = strconv.ParseFloat(v, 64)
+		if err != nil || withdrawLimit < 0 {
+			return nil, fmt.Errorf("invalid withdrawdailylimit %q", v)
+		}
+	}
+	withdrawLimitAtoms, err := dcrutil.NewAmount(withdrawLimit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid withdrawdailylimit: %v", err)
+	}
+
+	// Wallet paying withdrawals to addresses
+	var wallet *WalletConfig
+	if host := cfg.ExtraConfig["walletrpchost"]; host != "" {
+		wallet = &WalletConfig{
+			Host:     host,
+			User:     cfg.ExtraConfig["walletrpcuser"],
+			Password: cfg.ExtraConfig["walletrpcpass"],
+			CertFile: cfg.ExtraConfig["walletrpccert"],
+		}
+		if wallet.CertFile == "" {
+			return nil, fmt.Errorf("walletrpccert is required with walletrpchost")
+		}
+	}
+
+	// Group chat rate limit
+	gcInterval := defaultGCMessageInterval
+	if v := cfg.ExtraConfig["gcmsginterval"]; v != "" {
+		gcInterval, err = time.ParseDuration(v)
+		if err != nil || gcInterval <= 0 {
+			return nil, fmt.Errorf("invalid gcmsginterval %q", v)
+		}
+	}
+
+	// Drain on shutdown
+	drainTimeout := server.DefaultDrainTimeout
+	if v := cfg.ExtraConfig["draintimeout"]; v != "" {
+		drainTimeout, err = time.ParseDuration(v)
+		if err != nil || drainTimeout <= 0 {
+			return nil, fmt.Errorf("invalid draintimeout %q", v)
+		}
+	}
+
 	return &BotConfig{
 		Config:        cfg,
 		DataDir:       datadir,
@@ -60,5 +145,14 @@ func LoadBotConfig(appName, datadir string) (*BotConfig, error) {
 		KeyFile:       filepath.Join(datadir, "server.key"),
 		MaxLogFiles:   "5",
 		LogFile:       filepath.Join(logDir, "pokerbot.log"),
+		DatabaseDSN:   cfg.ExtraConfig["dbdsn"],
+
+		WithdrawDailyLimit: int64(withdrawLimitAtoms),
+		Wallet:             wallet,
+		GCMessageInterval:  gcInterval,
+		AdminSocket:        cfg.ExtraConfig["adminsocket"],
+		AdminToken:         cfg.ExtraConfig["admintoken"],
+		DrainTimeout:       drainTimeout,
+		MetricsAddress:     cfg.ExtraConfig["metricsaddr"],
 	}, nil
 }
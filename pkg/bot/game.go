@@ -0,0 +1,359 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/companyzero/bisonrelay/clientrpc/types"
+	kit "github.com/vctt94/bisonbotkit"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/status"
+)
+
+// errorMessage returns the message of a server error without the gRPC status
+// code decoration.
+func errorMessage(err error) string {
+	return status.Convert(err).Message()
+}
+
+// currentTable returns the ID of the table the player is seated at. It PMs the
+// player and returns an empty string when they are not at a table.
+func (s *State) currentTable(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, playerID string) string {
+	resp, err := s.srv.GetPlayerCurrentTable(ctx, &pokerrpc.GetPlayerCurrentTableRequest{PlayerId: playerID})
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Error finding your table: "+errorMessage(err))
+		return ""
+	}
+	if resp.TableId == "" {
+		bot.SendPM(ctx, pm.Nick, "You are not at a table. Use 'tables' and 'join <table-id>' first.")
+		return ""
+	}
+	return resp.TableId
+}
+
+// gameState returns the game state of the table as seen by the player.
+func (s *State) gameState(ctx context.Context, tableID, playerID string) (*pokerrpc.GameUpdate, error) {
+	resp, err := s.srv.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID, PlayerId: playerID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GameState, nil
+}
+
+func (s *State) handleReady(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, playerID string, ready bool) {
+	tableID := s.currentTable(ctx, bot, pm, playerID)
+	if tableID == "" {
+		return
+	}
+
+	var err error
+	if ready {
+		_, err = s.srv.SetPlayerReady(ctx, &pokerrpc.SetPlayerReadyRequest{PlayerId: playerID, TableId: tableID})
+	} else {
+		_, err = s.srv.SetPlayerUnready(ctx, &pokerrpc.SetPlayerUnreadyRequest{PlayerId: playerID, TableId: tableID})
+	}
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Error updating ready status: "+errorMessage(err))
+	}
+	// Success is confirmed by the relayed PLAYER_READY notification.
+}
+
+// handleSitOut sits the player out or back in. Success is confirmed by the
+// relayed PLAYER_SAT_OUT or PLAYER_SAT_IN notification.
+func (s *State) handleSitOut(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, playerID string, out bool) {
+	tableID := s.currentTable(ctx, bot, pm, playerID)
+	if tableID == "" {
+		return
+	}
+
+	var (
+		ok  bool
+		msg string
+		err error
+	)
+	if out {
+		var resp *pokerrpc.SitOutResponse
+		if resp, err = s.srv.SitOut(ctx, &pokerrpc.SitOutRequest{PlayerId: playerID, TableId: tableID}); err == nil {
+			ok, msg = resp.Success, resp.Message
+		}
+	} else {
+		var resp *pokerrpc.SitInResponse
+		if resp, err = s.srv.SitIn(ctx, &pokerrpc.SitInRequest{PlayerId: playerID, TableId: tableID}); err == nil {
+			ok, msg = resp.Success, resp.Message
+		}
+	}
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Error updating sit-out status: "+errorMessage(err))
+		return
+	}
+	if !ok {
+		bot.SendPM(ctx, pm.Nick, msg)
+	}
+}
+
+// handleChat sends a message to the chat of the player's table. The other
+// players get it as a relayed CHAT_MESSAGE notification.
+func (s *State) handleChat(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
+	if len(tokens) < 2 {
+		bot.SendPM(ctx, pm.Nick, "Usage: chat <message>")
+		return
+	}
+	tableID := s.currentTable(ctx, bot, pm, playerID)
+	if tableID == "" {
+		return
+	}
+
+	resp, err := s.srv.SendChatMessage(ctx, &pokerrpc.SendChatMessageRequest{
+		PlayerId: playerID,
+		TableId:  tableID,
+		Text:     strings.Join(tokens[1:], " "),
+	})
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Cannot chat: "+errorMessage(err))
+		return
+	}
+	if !resp.Success {
+		bot.SendPM(ctx, pm.Nick, resp.Message)
+	}
+}
+
+// handleAction performs a betting action for the player. Successful actions
+// are not answered directly: the relayed notification confirms them to every
+// player at the table.
+func (s *State) handleAction(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
+	tableID := s.currentTable(ctx, bot, pm, playerID)
+	if tableID == "" {
+		return
+	}
+
+	action := strings.ToLower(tokens[0])
+	var err error
+	switch action {
+	case "check":
+		_, err = s.srv.CheckBet(ctx, &pokerrpc.CheckBetRequest{PlayerId: playerID, TableId: tableID})
+	case "call":
+		_, err = s.srv.CallBet(ctx, &pokerrpc.CallBetRequest{PlayerId: playerID, TableId: tableID})
+	case "fold":
+		_, err = s.srv.FoldBet(ctx, &pokerrpc.FoldBetRequest{PlayerId: playerID, TableId: tableID})
+	case "bet", "raise", "allin":
+		amount, ok := s.betAmount(ctx, bot, pm, tokens, tableID, playerID)
+		if !ok {
+			return
+		}
+		_, err = s.srv.MakeBet(ctx, &pokerrpc.MakeBetRequest{PlayerId: playerID, TableId: tableID, Amount: amount})
+	}
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Cannot %s: %s", action, errorMessage(err)))
+	}
+}
+
+// betAmount returns the total bet of the player for this betting round that
+// a bet, raise or allin command asks for. Bets and raises are given as the
+// total to bet to, like the gRPC MakeBet call. It PMs the player and returns
+// false when the command cannot be played.
+func (s *State) betAmount(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, tableID, playerID string) (int64, bool) {
+	action := strings.ToLower(tokens[0])
+	var amount int64
+	if action != "allin" {
+		if len(tokens) != 2 {
+			bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Usage: %s <chips>", action))
+			return 0, false
+		}
+		n, err := strconv.ParseInt(tokens[1], 10, 64)
+		if err != nil || n <= 0 {
+			bot.SendPM(ctx, pm.Nick, "Invalid amount. Please enter a positive number of chips.")
+			return 0, false
+		}
+		amount = n
+	}
+
+	state, err := s.gameState(ctx, tableID, playerID)
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Cannot %s: %s", action, errorMessage(err)))
+		return 0, false
+	}
+	me := findPlayer(state, playerID)
+	if me == nil || !state.GameStarted {
+		bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Cannot %s: you are not in a hand.", action))
+		return 0, false
+	}
+
+	switch action {
+	case "allin":
+		amount = me.CurrentBet + me.Balance
+	case "bet":
+		if state.CurrentBet > me.CurrentBet {
+			bot.SendPM(ctx, pm.Nick, fmt.Sprintf("There is a bet of %d to you; use 'call' or 'raise <chips>'.",
+				state.CurrentBet))
+			return 0, false
+		}
+	case "raise":
+		if amount <= state.CurrentBet {
+			bot.SendPM(ctx, pm.Nick, fmt.Sprintf("A raise must be to more than the current bet of %d.",
+				state.CurrentBet))
+			return 0, false
+		}
+	}
+	return amount, true
+}
+
+func (s *State) handleShow(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, playerID string) {
+	tableID := s.currentTable(ctx, bot, pm, playerID)
+	if tableID == "" {
+		return
+	}
+	if _, err := s.srv.ShowCards(ctx, &pokerrpc.ShowCardsRequest{PlayerId: playerID, TableId: tableID}); err != nil {
+		bot.SendPM(ctx, pm.Nick, "Cannot show cards: "+errorMessage(err))
+	}
+}
+
+// handleTimeBank adds the player's time bank to the clock of their turn.
+// Success is confirmed by the relayed TIME_BANK_USED notification.
+func (s *State) handleTimeBank(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, playerID string) {
+	tableID := s.currentTable(ctx, bot, pm, playerID)
+	if tableID == "" {
+		return
+	}
+	resp, err := s.srv.UseTimeBank(ctx, &pokerrpc.UseTimeBankRequest{PlayerId: playerID, TableId: tableID})
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Cannot use your time bank: "+errorMessage(err))
+		return
+	}
+	if !resp.Success {
+		bot.SendPM(ctx, pm.Nick, resp.Message)
+	}
+}
+
+func (s *State) handleStatus(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, playerID string) {
+	tableID := s.currentTable(ctx, bot, pm, playerID)
+	if tableID == "" {
+		return
+	}
+	state, err := s.gameState(ctx, tableID, playerID)
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Error reading game state: "+errorMessage(err))
+		return
+	}
+	bot.SendPM(ctx, pm.Nick, formatGameState(playerID, state))
+}
+
+// findPlayer returns the player's entry in a game update, or nil.
+func findPlayer(u *pokerrpc.GameUpdate, playerID string) *pokerrpc.Player {
+	for _, p := range u.Players {
+		if p.Id == playerID {
+			return p
+		}
+	}
+	return nil
+}
+
+// formatCards renders cards as space separated value and suit pairs.
+func formatCards(cards []*pokerrpc.Card) string {
+	s := make([]string, 0, len(cards))
+	for _, c := range cards {
+		s = append(s, c.Value+c.Suit)
+	}
+	return strings.Join(s, " ")
+}
+
+// phaseName returns the display name of a game phase.
+func phaseName(phase pokerrpc.GamePhase) string {
+	switch phase {
+	case pokerrpc.GamePhase_WAITING:
+		return "Waiting"
+	case pokerrpc.GamePhase_NEW_HAND_DEALING:
+		return "Dealing"
+	case pokerrpc.GamePhase_PRE_FLOP:
+		return "Pre-flop"
+	case pokerrpc.GamePhase_FLOP:
+		return "Flop"
+	case pokerrpc.GamePhase_TURN:
+		return "Turn"
+	case pokerrpc.GamePhase_RIVER:
+		return "River"
+	case pokerrpc.GamePhase_SHOWDOWN:
+		return "Showdown"
+	default:
+		return phase.String()
+	}
+}
+
+// turnPrompt tells the player it is their turn, how long they have to act
+// and which commands they can use.
+func turnPrompt(u *pokerrpc.GameUpdate, me *pokerrpc.Player) string {
+	var prompt string
+	toCall := u.CurrentBet - me.CurrentBet
+	if toCall <= 0 {
+		prompt = fmt.Sprintf("Your turn: pot %d, you have %d chips. Options: check, bet <chips>, fold, allin",
+			u.Pot, me.Balance)
+	} else {
+		prompt = fmt.Sprintf("Your turn: pot %d, %d to call, you have %d chips. Options: call, raise <chips>, fold, allin",
+			u.Pot, toCall, me.Balance)
+	}
+	if u.ActionDeadlineUnixMs > 0 {
+		left := time.Until(time.UnixMilli(u.ActionDeadlineUnixMs)).Round(time.Second)
+		prompt += fmt.Sprintf(". You have %v to act", left)
+		if me.TimeBankSeconds > 0 {
+			prompt += fmt.Sprintf("; 'timebank' adds %ds", me.TimeBankSeconds)
+		}
+	}
+	return prompt
+}
+
+// formatGameState renders the status command's view of a table.
+func formatGameState(playerID string, u *pokerrpc.GameUpdate) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Table %s: %s", u.TableId, phaseName(u.Phase))
+	if !u.GameStarted {
+		fmt.Fprintf(&b, ", %d/%d players joined\n", u.PlayersJoined, u.PlayersRequired)
+	} else {
+		fmt.Fprintf(&b, ", pot %d, current bet %d\n", u.Pot, u.CurrentBet)
+		if len(u.CommunityCards) > 0 {
+			fmt.Fprintf(&b, "Board: %s\n", formatCards(u.CommunityCards))
+		}
+	}
+
+	for _, p := range u.Players {
+		name := shortPlayerID(p.Id)
+		if p.Id == playerID {
+			name = "You"
+		}
+		fmt.Fprintf(&b, "- ")
+		if p.Seat > 0 {
+			fmt.Fprintf(&b, "Seat %d, ", p.Seat)
+		}
+		fmt.Fprintf(&b, "%s: %d chips", name, p.Balance)
+		if u.GameStarted && p.IsDealer {
+			b.WriteString(", button")
+		}
+		if p.CurrentBet > 0 {
+			fmt.Fprintf(&b, ", bet %d", p.CurrentBet)
+		}
+		switch {
+		case p.SittingOut:
+			b.WriteString(", sitting out")
+		case p.Folded:
+			b.WriteString(", folded")
+		case !u.GameStarted && p.IsReady:
+			b.WriteString(", ready")
+		case !u.GameStarted:
+			b.WriteString(", not ready")
+		}
+		if len(p.Hand) > 0 {
+			fmt.Fprintf(&b, ", %s", formatCards(p.Hand))
+		}
+		if u.GameStarted && p.Id == u.CurrentPlayer {
+			b.WriteString(" (to act)")
+		}
+		b.WriteString("\n")
+	}
+
+	if me := findPlayer(u, playerID); u.GameStarted && me != nil && u.CurrentPlayer == playerID {
+		b.WriteString(turnPrompt(u, me))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,275 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/companyzero/bisonrelay/zkidentity"
+	"github.com/decred/dcrd/dcrutil/v4"
+	kit "github.com/vctt94/bisonbotkit"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"github.com/vctt94/pokerbisonrelay/pkg/server"
+)
+
+// gcMaxPendingLines bounds the table events waiting to be posted to one group
+// chat. Events past it are counted and summarized instead.
+const gcMaxPendingLines = 50
+
+// gcRelay mirrors the public events of tables bound to group chats: joins,
+// actions, board cards, showdowns and chip counts. Hole cards and turn
+// prompts stay in private PMs. Events are batched per group chat and posted
+// at most once per interval by RunGCRelay to bound relay costs.
+type gcRelay struct {
+	mu       sync.Mutex
+	bindings map[string]string             // tableID -> GC ID
+	phases   map[string]pokerrpc.GamePhase // tableID -> last phase posted
+	pending  map[string][]string           // GC ID -> lines to post
+	dropped  map[string]int                // GC ID -> lines over gcMaxPendingLines
+}
+
+func newGCRelay() *gcRelay {
+	return &gcRelay{
+		bindings: make(map[string]string),
+		phases:   make(map[string]pokerrpc.GamePhase),
+		pending:  make(map[string][]string),
+		dropped:  make(map[string]int),
+	}
+}
+
+// bind routes the events of a table to a group chat.
+func (r *gcRelay) bind(tableID, gcID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bindings[tableID] = gcID
+}
+
+// post queues a line for the group chat the table is bound to, if any.
+func (r *gcRelay) post(tableID, line string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.postLocked(tableID, line)
+}
+
+func (r *gcRelay) postLocked(tableID, line string) {
+	gcID, ok := r.bindings[tableID]
+	if !ok || line == "" {
+		return
+	}
+	if len(r.pending[gcID]) >= gcMaxPendingLines {
+		r.dropped[gcID]++
+		return
+	}
+	r.pending[gcID] = append(r.pending[gcID], line)
+}
+
+// notification queues the public rendering of a table notification.
+func (r *gcRelay) notification(tableID string, n *pokerrpc.Notification) {
+	r.post(tableID, formatNotification("", n))
+}
+
+// update queues what a game update shows to onlookers: chip counts when a
+// hand starts, the board on every street and the hands revealed at showdown.
+func (r *gcRelay) update(tableID string, u *pokerrpc.GameUpdate) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.bindings[tableID]; !ok || u.Phase == r.phases[tableID] {
+		return
+	}
+	r.phases[tableID] = u.Phase
+
+	var line string
+	switch u.Phase {
+	case pokerrpc.GamePhase_PRE_FLOP:
+		chips := make([]string, 0, len(u.Players))
+		for _, p := range u.Players {
+			chips = append(chips, fmt.Sprintf("%s %d", shortPlayerID(p.Id), p.Balance+p.CurrentBet))
+		}
+		line = "New hand. Chips: " + strings.Join(chips, ", ")
+	case pokerrpc.GamePhase_FLOP, pokerrpc.GamePhase_TURN, pokerrpc.GamePhase_RIVER:
+		line = fmt.Sprintf("%s: %s (pot %d)", phaseName(u.Phase), formatCards(u.CommunityCards), u.Pot)
+	case pokerrpc.GamePhase_SHOWDOWN:
+		var hands []string
+		for _, p := range u.Players {
+			if p.Folded || len(p.Hand) == 0 {
+				continue
+			}
+			hand := shortPlayerID(p.Id) + " " + formatCards(p.Hand)
+			if p.HandDescription != "" {
+				hand += " (" + p.HandDescription + ")"
+			}
+			hands = append(hands, hand)
+		}
+		if len(hands) > 0 {
+			line = "Hands: " + strings.Join(hands, ", ")
+		}
+	}
+	if line != "" {
+		r.postLocked(tableID, "["+tableID+"] "+line)
+	}
+}
+
+// take removes and returns the messages waiting for each group chat.
+func (r *gcRelay) take() map[string]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	msgs := make(map[string]string, len(r.pending))
+	for gcID, lines := range r.pending {
+		if n := r.dropped[gcID]; n > 0 {
+			lines = append(lines, fmt.Sprintf("(%d more table events not shown)", n))
+		}
+		msgs[gcID] = strings.Join(lines, "\n")
+	}
+	r.pending = make(map[string][]string)
+	r.dropped = make(map[string]int)
+	return msgs
+}
+
+// unbind stops routing the events of a table to its group chat.
+func (r *gcRelay) unbind(tableID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.bindings, tableID)
+	delete(r.phases, tableID)
+}
+
+// RelayTableNotification implements server.TableRelay. A closed table is
+// unbound from its group chat once its closing is posted.
+func (s *State) RelayTableNotification(tableID string, notification *pokerrpc.Notification) {
+	s.gc.notification(tableID, notification)
+	if notification.Type == pokerrpc.NotificationType_TABLE_CLOSED {
+		s.gc.unbind(tableID)
+		// A binding left behind is dropped by LoadGCTables on restart.
+		_ = s.db.DeleteGCTable(tableID)
+	}
+}
+
+// RelayTableUpdate implements server.TableRelay.
+func (s *State) RelayTableUpdate(tableID string, update *pokerrpc.GameUpdate) {
+	s.gc.update(tableID, update)
+}
+
+// RunGCRelay posts the batched table events to their group chats every
+// interval until ctx is done.
+func (s *State) RunGCRelay(ctx context.Context, bot *kit.Bot, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for gcID, msg := range s.gc.take() {
+				bot.SendGC(ctx, gcID, msg)
+			}
+		}
+	}
+}
+
+// LoadGCTables restores the group chat bindings saved in the database,
+// dropping those of tables the server no longer has.
+func (s *State) LoadGCTables(ctx context.Context) error {
+	bindings, err := s.db.GetGCTables()
+	if err != nil {
+		return fmt.Errorf("failed to load group chat tables: %v", err)
+	}
+	for _, b := range bindings {
+		if s.srv.TableInfo(b.TableID) == nil {
+			if err := s.db.DeleteGCTable(b.TableID); err != nil {
+				return fmt.Errorf("failed to delete binding of table %s: %v", b.TableID, err)
+			}
+			continue
+		}
+		s.gc.bind(b.TableID, b.GCID)
+	}
+	return nil
+}
+
+// resolveGC returns the ID and name of the group chat of the bot matching gc,
+// either its name or its hex ID. It PMs the player and returns an empty ID
+// when there is no such group chat.
+func (s *State) resolveGC(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, gc string) (string, string) {
+	gcs, err := bot.GetGCs(ctx)
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Error listing group chats: "+err.Error())
+		return "", ""
+	}
+	for _, info := range gcs {
+		var id zkidentity.ShortID
+		id.FromBytes(info.Id)
+		if strings.EqualFold(info.Name, gc) || id.String() == strings.ToLower(gc) {
+			return id.String(), info.Name
+		}
+	}
+	bot.SendPM(ctx, pm.Nick, fmt.Sprintf("The bot is not in a group chat named %q. Invite it to the group chat first.", gc))
+	return "", ""
+}
+
+// bindTable binds the table to the group chat, saves the binding and
+// announces the table in the group chat.
+func (s *State) bindTable(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, table *pokerrpc.Table, gcID, gcName, playerID string) {
+	err := s.db.SaveGCTable(server.GCTable{TableID: table.Id, GCID: gcID, BoundBy: playerID})
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Error saving group chat binding: "+err.Error())
+		return
+	}
+	s.gc.bind(table.Id, gcID)
+
+	bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Table %s is now posted to group chat %s.", table.Id, gcName))
+	s.gc.post(table.Id, fmt.Sprintf("[%s] Poker table open: buy-in %.8f DCR, blinds %d/%d, %d/%d players. "+
+		"PM me 'join %s' to play.", table.Id, dcrutil.Amount(table.BuyIn).ToCoin(), table.SmallBlind,
+		table.BigBlind, table.CurrentPlayers, table.MaxPlayers, table.Id))
+}
+
+func (s *State) handleCreateGCTable(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
+	usage := "Usage: create-gc-table <gc> <buy-in amount in DCR> [starting-chips]"
+	if len(tokens) < 3 {
+		bot.SendPM(ctx, pm.Nick, usage)
+		return
+	}
+	gcID, gcName := s.resolveGC(ctx, bot, pm, tokens[1])
+	if gcID == "" {
+		return
+	}
+	tableID := s.createTable(ctx, bot, pm, tokens[2:], usage, playerID, false)
+	if tableID == "" {
+		return
+	}
+	if table := s.srv.TableInfo(tableID); table != nil {
+		s.bindTable(ctx, bot, pm, table, gcID, gcName, playerID)
+	}
+}
+
+func (s *State) handleBind(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
+	if len(tokens) < 2 || len(tokens) > 3 {
+		bot.SendPM(ctx, pm.Nick, "Usage: bind <gc> [table-id]")
+		return
+	}
+
+	var tableID string
+	if len(tokens) == 3 {
+		tableID = tokens[2]
+	} else if tableID = s.currentTable(ctx, bot, pm, playerID); tableID == "" {
+		return
+	}
+	table := s.srv.TableInfo(tableID)
+	if table == nil {
+		bot.SendPM(ctx, pm.Nick, "Table not found.")
+		return
+	}
+	if table.HostId != playerID {
+		bot.SendPM(ctx, pm.Nick, "Only the table host can bind it to a group chat.")
+		return
+	}
+
+	gcID, gcName := s.resolveGC(ctx, bot, pm, tokens[1])
+	if gcID == "" {
+		return
+	}
+	s.bindTable(ctx, bot, pm, table, gcID, gcName, playerID)
+}
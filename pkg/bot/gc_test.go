@@ -0,0 +1,53 @@
+package bot
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+func TestGCRelayBatchesPublicEvents(t *testing.T) {
+	r := newGCRelay()
+	r.bind("t1", "gc1")
+
+	// Unbound tables are not posted anywhere.
+	r.notification("t2", &pokerrpc.Notification{Type: pokerrpc.NotificationType_CHECK_MADE, TableId: "t2", PlayerId: "alice"})
+	require.Empty(t, r.take())
+
+	r.notification("t1", &pokerrpc.Notification{Type: pokerrpc.NotificationType_PLAYER_JOINED, TableId: "t1", PlayerId: "alice"})
+	players := []*pokerrpc.Player{
+		{Id: "alice", Balance: 990, CurrentBet: 10},
+		{Id: "bob", Balance: 980, CurrentBet: 20},
+	}
+	preflop := &pokerrpc.GameUpdate{TableId: "t1", Phase: pokerrpc.GamePhase_PRE_FLOP, Players: players}
+	r.update("t1", preflop)
+	r.update("t1", preflop) // Same phase: nothing new
+	r.notification("t1", &pokerrpc.Notification{Type: pokerrpc.NotificationType_CALL_MADE, TableId: "t1", PlayerId: "alice", Amount: 10})
+	r.update("t1", &pokerrpc.GameUpdate{TableId: "t1", Phase: pokerrpc.GamePhase_FLOP, Pot: 40, Players: players,
+		CommunityCards: []*pokerrpc.Card{card("2", "♣"), card("7", "♦"), card("J", "♠")}})
+
+	msgs := r.take()
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "[t1] alice joined the table.\n"+
+		"[t1] New hand. Chips: alice 1000, bob 1000\n"+
+		"[t1] alice called 10.\n"+
+		"[t1] Flop: 2♣ 7♦ J♠ (pot 40)", msgs["gc1"])
+
+	// Taking empties the queue.
+	require.Empty(t, r.take())
+}
+
+func TestGCRelayDropsPastLimit(t *testing.T) {
+	r := newGCRelay()
+	r.bind("t1", "gc1")
+	for i := 0; i < gcMaxPendingLines+3; i++ {
+		r.post("t1", fmt.Sprintf("line %d", i))
+	}
+	msg := r.take()["gc1"]
+	assert.Contains(t, msg, fmt.Sprintf("line %d", gcMaxPendingLines-1))
+	assert.NotContains(t, msg, fmt.Sprintf("line %d", gcMaxPendingLines))
+	assert.Contains(t, msg, "(3 more table events not shown)")
+}
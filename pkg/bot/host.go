@@ -0,0 +1,106 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/decred/dcrd/dcrutil/v4"
+	kit "github.com/vctt94/bisonbotkit"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// handleHostAction runs a moderation command on the table the player hosts
+// and is seated at: kick and ban take the target player ID and an optional
+// reason, mute and unmute take the target player ID, close takes an optional
+// reason and addbot the bot's strategy.
+func (s *State) handleHostAction(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
+	cmd := strings.ToLower(tokens[0])
+	if (cmd == "kick" || cmd == "ban") && len(tokens) < 2 {
+		bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Usage: %s <player-id> [reason]", cmd))
+		return
+	}
+	if (cmd == "mute" || cmd == "unmute") && len(tokens) != 2 {
+		bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Usage: %s <player-id>", cmd))
+		return
+	}
+	if cmd == "addbot" && len(tokens) < 2 {
+		bot.SendPM(ctx, pm.Nick, "Usage: addbot <random|tag|equity>")
+		return
+	}
+	tableID := s.currentTable(ctx, bot, pm, playerID)
+	if tableID == "" {
+		return
+	}
+
+	var msg string
+	var err error
+	switch cmd {
+	case "kick":
+		var resp *pokerrpc.KickPlayerResponse
+		resp, err = s.srv.KickPlayer(ctx, &pokerrpc.KickPlayerRequest{
+			PlayerId: playerID,
+			TableId:  tableID,
+			TargetId: tokens[1],
+			Reason:   strings.Join(tokens[2:], " "),
+		})
+		if err == nil {
+			msg = fmt.Sprintf("%s; %.8f DCR refunded to them.", resp.Message, dcrutil.Amount(resp.Refund).ToCoin())
+		}
+	case "ban":
+		var resp *pokerrpc.BanPlayerResponse
+		resp, err = s.srv.BanPlayer(ctx, &pokerrpc.BanPlayerRequest{
+			PlayerId: playerID,
+			TableId:  tableID,
+			TargetId: tokens[1],
+			Reason:   strings.Join(tokens[2:], " "),
+		})
+		if err == nil {
+			msg = resp.Message + "."
+		}
+	case "mute", "unmute":
+		var resp *pokerrpc.MuteChatPlayerResponse
+		resp, err = s.srv.MuteChatPlayer(ctx, &pokerrpc.MuteChatPlayerRequest{
+			PlayerId: playerID,
+			TableId:  tableID,
+			TargetId: tokens[1],
+			Muted:    cmd == "mute",
+		})
+		if err == nil {
+			msg = resp.Message + "."
+		}
+	case "pause":
+		_, err = s.srv.PauseTable(ctx, &pokerrpc.PauseTableRequest{PlayerId: playerID, TableId: tableID})
+	case "resume":
+		_, err = s.srv.ResumeTable(ctx, &pokerrpc.ResumeTableRequest{PlayerId: playerID, TableId: tableID})
+	case "close":
+		var resp *pokerrpc.CloseTableResponse
+		resp, err = s.srv.CloseTable(ctx, &pokerrpc.CloseTableRequest{
+			PlayerId: playerID,
+			TableId:  tableID,
+			Reason:   strings.Join(tokens[1:], " "),
+		})
+		if err == nil {
+			msg = resp.Message + "."
+		}
+	case "addbot":
+		var resp *pokerrpc.AddBotResponse
+		resp, err = s.srv.AddBot(ctx, &pokerrpc.AddBotRequest{
+			PlayerId: playerID,
+			TableId:  tableID,
+			Strategy: strings.ToLower(tokens[1]),
+		})
+		if err == nil {
+			msg = resp.Message + "."
+		}
+	}
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Cannot %s: %s", cmd, errorMessage(err)))
+		return
+	}
+	// Pausing and resuming are confirmed by the relayed notification.
+	if msg != "" {
+		bot.SendPM(ctx, pm.Nick, "["+tableID+"] "+msg)
+	}
+}
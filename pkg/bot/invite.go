@@ -0,0 +1,65 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/companyzero/bisonrelay/clientrpc/types"
+	"github.com/companyzero/bisonrelay/zkidentity"
+	"github.com/decred/dcrd/dcrutil/v4"
+	kit "github.com/vctt94/bisonbotkit"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// handleInvite issues an invite code to a table the player hosts and PMs it
+// to the invited user. Users given by ID get an invite only they can use and
+// are put on the table's invite list; users given by nick get a code anyone
+// holding it can use, since the bot cannot resolve nicks to IDs.
+func (s *State) handleInvite(ctx context.Context, bot *kit.Bot, pm *types.ReceivedPM, tokens []string, playerID string) {
+	if len(tokens) < 2 || len(tokens) > 3 {
+		bot.SendPM(ctx, pm.Nick, "Usage: invite <user nick or ID> [table-id]")
+		return
+	}
+
+	var tableID string
+	if len(tokens) == 3 {
+		tableID = tokens[2]
+	} else if tableID = s.currentTable(ctx, bot, pm, playerID); tableID == "" {
+		return
+	}
+	table := s.srv.TableInfo(tableID)
+	if table == nil {
+		bot.SendPM(ctx, pm.Nick, "Table not found.")
+		return
+	}
+
+	user := tokens[1]
+	var inviteeID string
+	var uid zkidentity.ShortID
+	if err := uid.FromString(user); err == nil {
+		inviteeID = uid.String()
+	}
+
+	resp, err := s.srv.CreateTableInvite(ctx, &pokerrpc.CreateTableInviteRequest{
+		PlayerId:  playerID,
+		TableId:   tableID,
+		InviteeId: inviteeID,
+	})
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, "Cannot invite: "+errorMessage(err))
+		return
+	}
+
+	expires := time.Unix(resp.ExpiresAt, 0).UTC().Format("2006-01-02 15:04 UTC")
+	err = bot.SendPM(ctx, user, fmt.Sprintf("%s invited you to poker table %s: buy-in %.8f DCR, blinds %d/%d, "+
+		"%d/%d players. PM me 'join %s %s' to join. The invite expires %s.", pm.Nick, tableID,
+		dcrutil.Amount(table.BuyIn).ToCoin(), table.SmallBlind, table.BigBlind, table.CurrentPlayers,
+		table.MaxPlayers, tableID, resp.Code, expires))
+	if err != nil {
+		bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Error sending the invite to %s: %v. They can still join with "+
+			"'join %s %s' until %s.", user, err, tableID, resp.Code, expires))
+		return
+	}
+	bot.SendPM(ctx, pm.Nick, fmt.Sprintf("Invite to table %s sent to %s. It expires %s.", tableID, user, expires))
+}
@@ -0,0 +1,332 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/decred/dcrd/dcrutil/v4"
+	kit "github.com/vctt94/bisonbotkit"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// relayQueueSize bounds the notifications waiting to be sent as PMs. When the
+// queue is full further notifications are dropped rather than stalling the
+// server's event processing. Game updates are not queued but coalesced.
+const relayQueueSize = 256
+
+// relayedNotification is a server notification addressed to one player.
+type relayedNotification struct {
+	playerID     string
+	notification *pokerrpc.Notification
+}
+
+// playerView is what a player has been told about their current hand, so that
+// game updates only produce a PM when something they act on changed.
+type playerView struct {
+	phase pokerrpc.GamePhase
+	hand  string // Hole cards last sent, empty between hands
+	turn  string // Betting state of the last turn prompt, empty when not their turn
+}
+
+// RelayNotification implements server.NotificationRelay. It queues the
+// notification to be sent to the player as a PM by RelayNotifications.
+func (s *State) RelayNotification(playerID string, notification *pokerrpc.Notification) {
+	select {
+	case s.relayed <- relayedNotification{playerID: playerID, notification: notification}:
+		s.dropping.Store(false)
+	default:
+		n := s.dropped.Add(1)
+		if !s.dropping.Swap(true) {
+			s.log.Warnf("Notification queue full, dropping notifications to players "+
+				"(%d dropped so far)", n)
+		}
+	}
+}
+
+// DroppedNotifications returns the number of notifications dropped because
+// the relay queue was full.
+func (s *State) DroppedNotifications() uint64 {
+	return s.dropped.Load()
+}
+
+// RelayGameUpdate implements server.NotificationRelay. It keeps the update
+// as the player's latest, to be turned into hole card, board and turn PMs by
+// RelayNotifications. An update not relayed yet is replaced, as only the
+// latest state matters for what the player is told.
+func (s *State) RelayGameUpdate(playerID string, update *pokerrpc.GameUpdate) {
+	s.updatesMu.Lock()
+	s.pendingUpdates[playerID] = update
+	s.updatesMu.Unlock()
+	select {
+	case s.updatesReady <- struct{}{}:
+	default: // Already signalled
+	}
+}
+
+// takeUpdates returns the pending game updates, leaving none pending.
+func (s *State) takeUpdates() map[string]*pokerrpc.GameUpdate {
+	s.updatesMu.Lock()
+	defer s.updatesMu.Unlock()
+	updates := s.pendingUpdates
+	s.pendingUpdates = make(map[string]*pokerrpc.GameUpdate, len(updates))
+	return updates
+}
+
+// RelayNotifications sends the queued server notifications and game updates
+// to players as PMs until ctx is done. Players are Bison Relay users, so their
+// player ID is the user ID PMs are addressed to.
+func (s *State) RelayNotifications(ctx context.Context, bot *kit.Bot) {
+	// Only this goroutine reads and writes the views.
+	views := make(map[string]*playerView)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case r := <-s.relayed:
+			msg := formatNotification(r.playerID, r.notification)
+			if leftTable(r.playerID, r.notification) {
+				delete(views, r.playerID)
+			}
+			if msg != "" {
+				bot.SendPM(ctx, r.playerID, msg)
+			}
+		case <-s.updatesReady:
+			for playerID, update := range s.takeUpdates() {
+				view := views[playerID]
+				if view == nil {
+					view = &playerView{}
+					views[playerID] = view
+				}
+				if msg := view.update(playerID, update); msg != "" {
+					bot.SendPM(ctx, playerID, msg)
+				}
+			}
+		}
+	}
+}
+
+// leftTable returns whether the notification means the player is no longer
+// seated at its table.
+func leftTable(playerID string, n *pokerrpc.Notification) bool {
+	switch n.Type {
+	case pokerrpc.NotificationType_PLAYER_LEFT, pokerrpc.NotificationType_PLAYER_KICKED,
+		pokerrpc.NotificationType_PLAYER_BANNED:
+		return n.PlayerId == playerID
+	case pokerrpc.NotificationType_TABLE_CLOSED:
+		return true
+	}
+	return false
+}
+
+// update records a game update seen by the player and returns the PM telling
+// them what changed: their hole cards for a new hand, the board on every
+// street and a prompt when it becomes their turn. It returns an empty string
+// when there is nothing new.
+func (v *playerView) update(playerID string, u *pokerrpc.GameUpdate) string {
+	var lines []string
+
+	me := findPlayer(u, playerID)
+	hand := ""
+	if me != nil {
+		hand = formatCards(me.Hand)
+	}
+	if hand != "" && hand != v.hand {
+		lines = append(lines, "Your cards: "+hand)
+	}
+	v.hand = hand
+
+	if u.Phase != v.phase {
+		switch u.Phase {
+		case pokerrpc.GamePhase_FLOP, pokerrpc.GamePhase_TURN, pokerrpc.GamePhase_RIVER:
+			lines = append(lines, fmt.Sprintf("%s: %s (pot %d)", phaseName(u.Phase),
+				formatCards(u.CommunityCards), u.Pot))
+		}
+	}
+	v.phase = u.Phase
+
+	turn := ""
+	if me != nil && u.CurrentPlayer == playerID && bettingPhase(u.Phase) {
+		turn = fmt.Sprintf("%s/%s/%d/%d", hand, u.Phase, u.CurrentBet, me.CurrentBet)
+		if turn != v.turn {
+			lines = append(lines, turnPrompt(u, me))
+		}
+	}
+	v.turn = turn
+
+	if len(lines) == 0 {
+		return ""
+	}
+	return "[" + u.TableId + "] " + strings.Join(lines, "\n")
+}
+
+// bettingPhase returns whether players act during the phase.
+func bettingPhase(phase pokerrpc.GamePhase) bool {
+	switch phase {
+	case pokerrpc.GamePhase_PRE_FLOP, pokerrpc.GamePhase_FLOP,
+		pokerrpc.GamePhase_TURN, pokerrpc.GamePhase_RIVER:
+		return true
+	}
+	return false
+}
+
+// shortPlayerID abbreviates a player ID for display.
+func shortPlayerID(playerID string) string {
+	if len(playerID) > 8 {
+		return playerID[:8]
+	}
+	return playerID
+}
+
+// formatNotification renders a notification for the player receiving it, or
+// for onlookers when playerID is empty. It returns an empty string for
+// notifications that are not worth a message.
+func formatNotification(playerID string, n *pokerrpc.Notification) string {
+	who, is, was, their := shortPlayerID(n.PlayerId), "is", "was", "their"
+	if playerID != "" && n.PlayerId == playerID {
+		who, is, was, their = "You", "are", "were", "your"
+	}
+
+	var msg string
+	switch n.Type {
+	case pokerrpc.NotificationType_PLAYER_JOINED:
+		if who == "You" {
+			return ""
+		}
+		msg = who + " joined the table."
+	case pokerrpc.NotificationType_PLAYER_LEFT:
+		msg = who + " left the table."
+	case pokerrpc.NotificationType_PLAYER_SAT_OUT:
+		msg = who + " " + is + " sitting out."
+		if n.Auto {
+			msg = who + " missed too many turns and " + is + " now sitting out."
+		}
+		if who == "You" {
+			msg += " Use 'sitin' to be dealt in again."
+		}
+	case pokerrpc.NotificationType_PLAYER_SAT_IN:
+		msg = who + " " + is + " back in."
+	case pokerrpc.NotificationType_TIME_BANK_USED:
+		msg = fmt.Sprintf("%s used %s time bank: %ds left to act.", who, their, n.Countdown)
+	case pokerrpc.NotificationType_PLAYER_READY:
+		msg = who + " " + is + " ready."
+	case pokerrpc.NotificationType_PLAYER_UNREADY:
+		msg = who + " " + is + " no longer ready."
+	case pokerrpc.NotificationType_ALL_PLAYERS_READY,
+		pokerrpc.NotificationType_NEW_HAND_STARTED:
+		msg = n.Message
+	case pokerrpc.NotificationType_GAME_STARTED:
+		msg = "Game started!"
+	case pokerrpc.NotificationType_GAME_ENDED:
+		msg = "Game ended."
+		if n.Message != "" {
+			msg += " " + n.Message
+		}
+	case pokerrpc.NotificationType_SMALL_BLIND_POSTED:
+		msg = fmt.Sprintf("%s posted the small blind of %d.", who, n.Amount)
+	case pokerrpc.NotificationType_BIG_BLIND_POSTED:
+		msg = fmt.Sprintf("%s posted the big blind of %d.", who, n.Amount)
+	case pokerrpc.NotificationType_BET_MADE:
+		msg = fmt.Sprintf("%s bet %d.", who, n.Amount)
+	case pokerrpc.NotificationType_CALL_MADE:
+		msg = fmt.Sprintf("%s called %d.", who, n.Amount)
+	case pokerrpc.NotificationType_CHECK_MADE:
+		msg = who + " checked."
+	case pokerrpc.NotificationType_PLAYER_FOLDED:
+		msg = who + " folded."
+	case pokerrpc.NotificationType_CARDS_SHOWN:
+		msg = who + " showed " + their + " cards."
+	case pokerrpc.NotificationType_SHOWDOWN_RESULT:
+		msg = formatShowdown(playerID, n)
+	case pokerrpc.NotificationType_PLAYER_KICKED:
+		if n.Auto {
+			msg = who + " " + was + " removed from the table for sitting out too long."
+		} else {
+			msg = who + " " + was + " removed from the table by the host."
+		}
+		if who == "You" && n.Amount > 0 {
+			msg += fmt.Sprintf(" %.8f DCR was refunded to you.", dcrutil.Amount(n.Amount).ToCoin())
+		}
+		msg += reasonSuffix(n.Message)
+	case pokerrpc.NotificationType_PLAYER_BANNED:
+		msg = who + " " + was + " banned from the table by the host." + reasonSuffix(n.Message)
+	case pokerrpc.NotificationType_GAME_PAUSED:
+		msg = "The host paused the game."
+	case pokerrpc.NotificationType_GAME_RESUMED:
+		msg = "The host resumed the game."
+	case pokerrpc.NotificationType_TABLE_CLOSED:
+		msg = "The host closed the table."
+		if playerID != "" {
+			msg += " Your chips were refunded to your balance."
+		}
+		msg += reasonSuffix(n.Message)
+	case pokerrpc.NotificationType_CHAT_MESSAGE:
+		msg = formatChat(playerID, n)
+	case pokerrpc.NotificationType_SEAT_OFFERED:
+		msg = fmt.Sprintf("Seat %d is free for you. Use 'join %s' within %d seconds to take it, "+
+			"or it goes to the next player waiting.", n.Seat, n.TableId, n.Countdown)
+	default:
+		msg = n.Message
+	}
+	if msg == "" {
+		return ""
+	}
+	if n.TableId != "" {
+		msg = "[" + n.TableId + "] " + msg
+	}
+	return msg
+}
+
+// formatChat renders a chat message of a table, or the recent chat sent to a
+// player who joined it. Players are not echoed their own messages.
+func formatChat(playerID string, n *pokerrpc.Notification) string {
+	if n.PlayerId == "" {
+		if len(n.Chat) == 0 {
+			return ""
+		}
+		var b strings.Builder
+		b.WriteString("Recent chat:")
+		for _, m := range n.Chat {
+			fmt.Fprintf(&b, "\n%s: %s", shortPlayerID(m.PlayerId), m.Text)
+		}
+		return b.String()
+	}
+	if playerID != "" && n.PlayerId == playerID {
+		return ""
+	}
+	return shortPlayerID(n.PlayerId) + ": " + n.Message
+}
+
+// reasonSuffix renders the reason a host gave for a moderation action.
+func reasonSuffix(reason string) string {
+	if reason == "" {
+		return ""
+	}
+	return " Reason: " + reason
+}
+
+// formatShowdown renders the winners of a showdown.
+func formatShowdown(playerID string, n *pokerrpc.Notification) string {
+	winners := n.Winners
+	if n.Showdown != nil && len(n.Showdown.Winners) > 0 {
+		winners = n.Showdown.Winners
+	}
+	if len(winners) == 0 {
+		return n.Message
+	}
+
+	var b strings.Builder
+	b.WriteString("Showdown:")
+	for _, w := range winners {
+		who := shortPlayerID(w.PlayerId)
+		if playerID != "" && w.PlayerId == playerID {
+			who = "You"
+		}
+		fmt.Fprintf(&b, " %s won %d", who, w.Winnings)
+		if len(w.BestHand) > 0 { // No hand is shown when everyone else folded
+			fmt.Fprintf(&b, " (%s)", strings.ToLower(strings.ReplaceAll(w.HandRank.String(), "_", " ")))
+		}
+		b.WriteString(".")
+	}
+	return b.String()
+}
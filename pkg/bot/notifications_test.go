@@ -0,0 +1,107 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+func card(value, suit string) *pokerrpc.Card {
+	return &pokerrpc.Card{Value: value, Suit: suit}
+}
+
+// TestPlayerViewUpdate walks a player through a hand and checks which game
+// updates produce a PM.
+func TestPlayerViewUpdate(t *testing.T) {
+	v := &playerView{}
+	hand := []*pokerrpc.Card{card("A", "♠"), card("K", "♥")}
+	update := func(phase pokerrpc.GamePhase, current string, currentBet, myBet int64, board ...*pokerrpc.Card) *pokerrpc.GameUpdate {
+		return &pokerrpc.GameUpdate{
+			TableId:        "t1",
+			Phase:          phase,
+			CurrentPlayer:  current,
+			CurrentBet:     currentBet,
+			Pot:            30,
+			CommunityCards: board,
+			GameStarted:    true,
+			Players: []*pokerrpc.Player{
+				{Id: "me", Balance: 990, CurrentBet: myBet, Hand: hand},
+				{Id: "other", Balance: 980, CurrentBet: currentBet},
+			},
+		}
+	}
+
+	// Hole cards and the first prompt come together.
+	msg := v.update("me", update(pokerrpc.GamePhase_PRE_FLOP, "me", 20, 10))
+	assert.Contains(t, msg, "[t1] Your cards: A♠ K♥")
+	assert.Contains(t, msg, "10 to call")
+	assert.Contains(t, msg, "call, raise <chips>, fold, allin")
+
+	// The same state again says nothing new.
+	assert.Empty(t, v.update("me", update(pokerrpc.GamePhase_PRE_FLOP, "me", 20, 10)))
+	assert.Empty(t, v.update("me", update(pokerrpc.GamePhase_PRE_FLOP, "other", 20, 20)))
+
+	// A new street shows the board, then prompts when it is our turn.
+	flop := []*pokerrpc.Card{card("2", "♣"), card("7", "♦"), card("J", "♠")}
+	msg = v.update("me", update(pokerrpc.GamePhase_FLOP, "other", 0, 0, flop...))
+	assert.Equal(t, "[t1] Flop: 2♣ 7♦ J♠ (pot 30)", msg)
+	msg = v.update("me", update(pokerrpc.GamePhase_FLOP, "me", 0, 0, flop...))
+	assert.Contains(t, msg, "check, bet <chips>, fold, allin")
+	assert.NotContains(t, msg, "Flop")
+
+	// No prompts outside betting phases.
+	assert.Empty(t, v.update("me", update(pokerrpc.GamePhase_SHOWDOWN, "me", 0, 0, flop...)))
+}
+
+func TestRelayQueue(t *testing.T) {
+	s := NewState(nil, nil, nil)
+
+	// Notifications beyond the queue are dropped and counted.
+	for i := 0; i < relayQueueSize+3; i++ {
+		s.RelayNotification("me", &pokerrpc.Notification{Type: pokerrpc.NotificationType_BET_MADE})
+	}
+	assert.Len(t, s.relayed, relayQueueSize)
+	assert.Equal(t, uint64(3), s.DroppedNotifications())
+
+	// Game updates are never dropped: each player keeps the latest.
+	for i := int64(1); i <= relayQueueSize+3; i++ {
+		s.RelayGameUpdate("me", &pokerrpc.GameUpdate{Pot: i})
+	}
+	s.RelayGameUpdate("other", &pokerrpc.GameUpdate{Pot: 1})
+	assert.Len(t, s.updatesReady, 1)
+	updates := s.takeUpdates()
+	require.Len(t, updates, 2)
+	assert.Equal(t, int64(relayQueueSize+3), updates["me"].Pot)
+	assert.Empty(t, s.takeUpdates())
+}
+
+func TestFormatNotification(t *testing.T) {
+	cases := []struct {
+		n    *pokerrpc.Notification
+		want string
+	}{
+		{&pokerrpc.Notification{Type: pokerrpc.NotificationType_CALL_MADE, TableId: "t1", PlayerId: "0123456789abcdef", Amount: 20},
+			"[t1] 01234567 called 20."},
+		{&pokerrpc.Notification{Type: pokerrpc.NotificationType_PLAYER_READY, TableId: "t1", PlayerId: "me"},
+			"[t1] You are ready."},
+		{&pokerrpc.Notification{Type: pokerrpc.NotificationType_PLAYER_JOINED, TableId: "t1", PlayerId: "me"},
+			""},
+		{&pokerrpc.Notification{Type: pokerrpc.NotificationType_TIME_BANK_USED, TableId: "t1", PlayerId: "me", Countdown: 45},
+			"[t1] You used your time bank: 45s left to act."},
+		{&pokerrpc.Notification{Type: pokerrpc.NotificationType_SHOWDOWN_RESULT, TableId: "t1",
+			Showdown: &pokerrpc.Showdown{Winners: []*pokerrpc.Winner{{PlayerId: "me", Winnings: 40}}}},
+			"[t1] Showdown: You won 40."},
+		{&pokerrpc.Notification{Type: pokerrpc.NotificationType_CHAT_MESSAGE, TableId: "t1", PlayerId: "0123456789abcdef", Message: "gl all"},
+			"[t1] 01234567: gl all"},
+		{&pokerrpc.Notification{Type: pokerrpc.NotificationType_CHAT_MESSAGE, TableId: "t1", PlayerId: "me", Message: "gl all"},
+			""},
+		{&pokerrpc.Notification{Type: pokerrpc.NotificationType_CHAT_MESSAGE, TableId: "t1",
+			Chat: []*pokerrpc.ChatMessage{{PlayerId: "alice", Text: "hi"}, {PlayerId: "me", Text: "hey"}}},
+			"[t1] Recent chat:\nalice: hi\nme: hey"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.want, formatNotification("me", c.n), c.n.Type.String())
+	}
+}
@@ -0,0 +1,54 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/companyzero/bisonrelay/zkidentity"
+	"github.com/decred/dcrd/dcrutil/v4"
+	kit "github.com/vctt94/bisonbotkit"
+	"github.com/vctt94/pokerbisonrelay/pkg/server"
+)
+
+// payoutTipAttempts is the number of times Bison Relay tries to deliver a
+// withdrawal tip before reporting it as failed.
+const payoutTipAttempts = 3
+
+// ErrAddressPayoutUnsupported is returned for withdrawals to a payout address
+// when no wallet is configured: the bot's Bison Relay client can pay tips but
+// holds no on-chain wallet.
+var ErrAddressPayoutUnsupported = errors.New("payouts to an address are not supported by this bot; withdraw without a payout address to be paid by tip")
+
+// BotPayoutSender pays withdrawals out as Bison Relay tips sent by the bot,
+// and to payout addresses from the wallet configured for it, if any.
+type BotPayoutSender struct {
+	bot    *kit.Bot
+	wallet *WalletPayer
+}
+
+var _ server.PayoutSender = (*BotPayoutSender)(nil)
+
+// NewBotPayoutSender returns a PayoutSender tipping players from bot and
+// paying addresses from wallet, which may be nil.
+func NewBotPayoutSender(bot *kit.Bot, wallet *WalletPayer) *BotPayoutSender {
+	return &BotPayoutSender{bot: bot, wallet: wallet}
+}
+
+// SendTip queues a tip of amount atoms to the player.
+func (t *BotPayoutSender) SendTip(ctx context.Context, playerID string, amount int64) error {
+	var uid zkidentity.ShortID
+	if err := uid.FromString(playerID); err != nil {
+		return fmt.Errorf("invalid player ID %q: %v", playerID, err)
+	}
+	return t.bot.PayTip(ctx, uid, dcrutil.Amount(amount), payoutTipAttempts)
+}
+
+// SendToAddress pays amount atoms to address from the wallet and returns the
+// transaction hash. It fails with ErrAddressPayoutUnsupported without one.
+func (t *BotPayoutSender) SendToAddress(ctx context.Context, address string, amount int64) (string, error) {
+	if t.wallet == nil {
+		return "", ErrAddressPayoutUnsupported
+	}
+	return t.wallet.SendToAddress(ctx, address, amount)
+}
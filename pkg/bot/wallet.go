@@ -0,0 +1,112 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// WalletConfig locates the JSON-RPC server of the dcrwallet paying
+// withdrawals to payout addresses.
+type WalletConfig struct {
+	Host     string // host:port of the wallet's JSON-RPC server
+	User     string
+	Password string
+	CertFile string // TLS certificate of the wallet's RPC server
+}
+
+// WalletPayer pays amounts to addresses from a dcrwallet, which must be
+// unlocked, through its JSON-RPC server.
+type WalletPayer struct {
+	url      string
+	user     string
+	password string
+	client   *http.Client
+}
+
+// NewWalletPayer returns a WalletPayer for the wallet described by cfg.
+func NewWalletPayer(cfg WalletConfig) (*WalletPayer, error) {
+	pem, err := os.ReadFile(cfg.CertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read wallet certificate: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in %s", cfg.CertFile)
+	}
+	return newWalletPayer("https://"+cfg.Host, cfg.User, cfg.Password, &http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}},
+	}), nil
+}
+
+func newWalletPayer(url, user, password string, client *http.Client) *WalletPayer {
+	return &WalletPayer{url: url, user: user, password: password, client: client}
+}
+
+// walletRequest and walletResponse are dcrwallet JSON-RPC messages.
+type walletRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type walletResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// SendToAddress pays amount atoms to address and returns the hash of the
+// transaction.
+func (w *WalletPayer) SendToAddress(ctx context.Context, address string, amount int64) (string, error) {
+	var txHash string
+	err := w.call(ctx, "sendtoaddress", []interface{}{address, dcrutil.Amount(amount).ToCoin()}, &txHash)
+	if err != nil {
+		return "", err
+	}
+	return txHash, nil
+}
+
+// call runs a JSON-RPC method of the wallet and decodes its result.
+func (w *WalletPayer) call(ctx context.Context, method string, params []interface{}, result interface{}) error {
+	body, err := json.Marshal(walletRequest{JSONRPC: "1.0", ID: 1, Method: method, Params: params})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(w.user, w.password)
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("wallet %s: %v", method, err)
+	}
+	defer resp.Body.Close()
+
+	// dcrwallet answers failed calls with an error status and a JSON-RPC
+	// error, and rejected credentials with a bare 401.
+	var reply walletResponse
+	if err := json.NewDecoder(resp.Body).Decode(&reply); err != nil {
+		return fmt.Errorf("wallet %s: %s", method, resp.Status)
+	}
+	if reply.Error != nil {
+		return fmt.Errorf("wallet %s: %s (code %d)", method, reply.Error.Message, reply.Error.Code)
+	}
+	if err := json.Unmarshal(reply.Result, result); err != nil {
+		return fmt.Errorf("wallet %s: invalid result: %v", method, err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package bot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWalletPayer pays through a fake dcrwallet JSON-RPC server.
+func TestWalletPayer(t *testing.T) {
+	var got walletRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "rpcuser" || pass != "rpcpass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		if got.Params[0] == "TsBadAddress" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"result":null,"error":{"code":-5,"message":"invalid address"},"id":1}`))
+			return
+		}
+		w.Write([]byte(`{"result":"abcd","error":null,"id":1}`))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	sender := NewBotPayoutSender(nil, newWalletPayer(srv.URL, "rpcuser", "rpcpass", srv.Client()))
+	txHash, err := sender.SendToAddress(ctx, "TsPayoutAddress", 150000000)
+	require.NoError(t, err)
+	assert.Equal(t, "abcd", txHash)
+	assert.Equal(t, "sendtoaddress", got.Method)
+	assert.Equal(t, []interface{}{"TsPayoutAddress", 1.5}, got.Params)
+
+	_, err = sender.SendToAddress(ctx, "TsBadAddress", 1)
+	assert.ErrorContains(t, err, "invalid address")
+
+	wrongAuth := newWalletPayer(srv.URL, "rpcuser", "wrong", srv.Client())
+	_, err = wrongAuth.SendToAddress(ctx, "TsPayoutAddress", 1)
+	assert.ErrorContains(t, err, "401")
+
+	// Without a wallet only tips are paid.
+	_, err = NewBotPayoutSender(nil, nil).SendToAddress(ctx, "TsPayoutAddress", 1)
+	assert.ErrorIs(t, err, ErrAddressPayoutUnsupported)
+}
@@ -0,0 +1,400 @@
+// Package agent runs an automated player against the poker server's gRPC
+// API. An Agent follows the game stream of the table its player is seated
+// at, gets ready between games and asks a strategy for an action whenever it
+// is the player's turn, acting before the player's action clock runs out.
+//
+// The strategies of package strategy can drive an Agent directly:
+//
+//	s, _ := strategy.New("tag", time.Now().UnixNano())
+//	a, err := agent.New(agent.Config{
+//		PlayerID: id,
+//		TableID:  tableID,
+//		Lobby:    pokerrpc.NewLobbyServiceClient(conn),
+//		Poker:    pokerrpc.NewPokerServiceClient(conn),
+//		Strategy: s,
+//	})
+//	...
+//	err = a.Run(ctx)
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/decred/slog"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"github.com/vctt94/pokerbisonrelay/pkg/strategy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultMargin is how long before the time bank runs out an Agent
+	// stops waiting for its strategy.
+	DefaultMargin = time.Second
+
+	// DefaultPollInterval is how often an Agent checks its seat and the
+	// game state besides the updates the stream pushes.
+	DefaultPollInterval = 2 * time.Second
+
+	// DefaultReconnectDelay is the first wait before reopening a broken
+	// game stream. The wait doubles up to maxReconnectDelay while the
+	// server stays unreachable.
+	DefaultReconnectDelay = time.Second
+	maxReconnectDelay     = 30 * time.Second
+
+	// unreadyTimeout bounds the last call an Agent makes when stopped.
+	unreadyTimeout = 5 * time.Second
+)
+
+// ErrNotSeated is returned by Run when the player is not, or no longer,
+// seated at the table: they left, were kicked or the table closed.
+var ErrNotSeated = errors.New("player is not seated at the table")
+
+// Config configures an Agent.
+type Config struct {
+	PlayerID string
+	TableID  string
+	Lobby    pokerrpc.LobbyServiceClient
+	Poker    pokerrpc.PokerServiceClient
+
+	// Strategy decides the player's actions. Decisions it takes that the
+	// game does not allow are turned into the closest legal action.
+	Strategy strategy.Strategy
+
+	// TimeBank is how long the player has to act when the server does not
+	// stream the deadline of their turn. When zero it is read from the
+	// table; a table without a time bank lets the strategy take as long as
+	// it wants.
+	TimeBank time.Duration
+	// Margin is how long before the time bank runs out the Agent gives up
+	// waiting for the strategy and checks or folds (default DefaultMargin).
+	Margin time.Duration
+
+	PollInterval   time.Duration // Default DefaultPollInterval
+	ReconnectDelay time.Duration // Default DefaultReconnectDelay
+
+	// OnAction, when set, is called after each action the Agent takes
+	// with the state it acted on.
+	OnAction func(update *pokerrpc.GameUpdate, action strategy.Action)
+
+	Log slog.Logger // Default slog.Disabled
+}
+
+// Agent plays for one player at one table.
+type Agent struct {
+	cfg Config
+
+	// decideMu serializes calls into the strategy, which need not be safe
+	// for concurrent use; a decision abandoned at the deadline may still
+	// be running when the next one starts.
+	decideMu sync.Mutex
+
+	bigBlind int64
+	turn     turnKey   // Turn being played or last acted on
+	turnAt   time.Time // When the turn was first seen
+	deadline time.Time // When the server acts for the player, if it says
+	acted    bool      // Whether the Agent acted on turn
+}
+
+// turnKey identifies one decision of the player: the state changes after
+// every action taken at the table.
+type turnKey struct {
+	phase      pokerrpc.GamePhase
+	board      int
+	pot        int64
+	currentBet int64
+	myBet      int64
+	myBalance  int64
+}
+
+// New returns an Agent for cfg.
+func New(cfg Config) (*Agent, error) {
+	switch {
+	case cfg.PlayerID == "":
+		return nil, errors.New("agent: player ID is required")
+	case cfg.TableID == "":
+		return nil, errors.New("agent: table ID is required")
+	case cfg.Lobby == nil || cfg.Poker == nil:
+		return nil, errors.New("agent: lobby and poker service clients are required")
+	case cfg.Strategy == nil:
+		return nil, errors.New("agent: strategy is required")
+	}
+	if cfg.Margin <= 0 {
+		cfg.Margin = DefaultMargin
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	if cfg.ReconnectDelay <= 0 {
+		cfg.ReconnectDelay = DefaultReconnectDelay
+	}
+	if cfg.Log == nil {
+		cfg.Log = slog.Disabled
+	}
+	return &Agent{cfg: cfg}, nil
+}
+
+// Run plays until ctx is done, returning nil, or until the player is no
+// longer seated at the table, returning ErrNotSeated. A broken game stream
+// is reopened with backoff. On the way out the player is marked unready so
+// the table does not wait on them for the next game.
+func (a *Agent) Run(ctx context.Context) error {
+	if err := a.loadTable(ctx); err != nil {
+		return err
+	}
+
+	delay := a.cfg.ReconnectDelay
+	for {
+		healthy, err := a.session(ctx)
+		if ctx.Err() != nil {
+			a.unready()
+			return nil
+		}
+		if errors.Is(err, ErrNotSeated) {
+			return err
+		}
+		if healthy {
+			delay = a.cfg.ReconnectDelay
+		}
+		a.cfg.Log.Warnf("Game stream of table %s lost, reconnecting in %s: %v", a.cfg.TableID, delay, err)
+		select {
+		case <-ctx.Done():
+			a.unready()
+			return nil
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+// loadTable reads the blinds and, unless configured, the time bank of the
+// table.
+func (a *Agent) loadTable(ctx context.Context) error {
+	resp, err := a.cfg.Lobby.GetTables(ctx, &pokerrpc.GetTablesRequest{PlayerId: a.cfg.PlayerID})
+	if err != nil {
+		return fmt.Errorf("agent: get tables: %w", err)
+	}
+	for _, t := range resp.Tables {
+		if t.Id != a.cfg.TableID {
+			continue
+		}
+		a.bigBlind = t.BigBlind
+		if a.cfg.TimeBank == 0 {
+			a.cfg.TimeBank = time.Duration(t.TimeBankSeconds) * time.Second
+		}
+		return nil
+	}
+	return ErrNotSeated
+}
+
+// session follows one game stream until it breaks. healthy reports whether
+// the stream delivered anything, so that a working connection resets the
+// reconnect backoff.
+func (a *Agent) session(ctx context.Context) (healthy bool, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := a.cfg.Poker.StartGameStream(ctx, &pokerrpc.StartGameStreamRequest{
+		PlayerId: a.cfg.PlayerID,
+		TableId:  a.cfg.TableID,
+	})
+	if err != nil {
+		return false, a.streamErr(err)
+	}
+
+	updates := make(chan *pokerrpc.GameUpdate)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			update, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case updates <- update:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(a.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return healthy, ctx.Err()
+		case err := <-recvErr:
+			return healthy, a.streamErr(err)
+		case update := <-updates:
+			healthy = true
+			a.handle(ctx, update)
+		case <-ticker.C:
+			update, err := a.poll(ctx)
+			if err != nil {
+				return healthy, err
+			}
+			a.handle(ctx, update)
+		}
+	}
+}
+
+// streamErr maps a game stream error, telling a vanished table apart from a
+// broken connection.
+func (a *Agent) streamErr(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return ErrNotSeated
+	}
+	return err
+}
+
+// poll checks that the player is still seated and fetches the game state,
+// covering updates the stream did not deliver.
+func (a *Agent) poll(ctx context.Context) (*pokerrpc.GameUpdate, error) {
+	cur, err := a.cfg.Lobby.GetPlayerCurrentTable(ctx, &pokerrpc.GetPlayerCurrentTableRequest{PlayerId: a.cfg.PlayerID})
+	if err != nil {
+		return nil, err
+	}
+	if cur.TableId != a.cfg.TableID {
+		return nil, ErrNotSeated
+	}
+	resp, err := a.cfg.Poker.GetGameState(ctx, &pokerrpc.GetGameStateRequest{
+		TableId:  a.cfg.TableID,
+		PlayerId: a.cfg.PlayerID,
+	})
+	if err != nil {
+		return nil, a.streamErr(err)
+	}
+	return resp.GameState, nil
+}
+
+// handle readies the player between games and acts on their turn.
+func (a *Agent) handle(ctx context.Context, update *pokerrpc.GameUpdate) {
+	if update == nil {
+		return
+	}
+	v := strategy.View{Update: update, PlayerID: a.cfg.PlayerID, BigBlind: a.bigBlind}
+	self := v.Self()
+	if self == nil {
+		return
+	}
+
+	if !update.GameStarted {
+		if !self.IsReady {
+			_, err := a.cfg.Lobby.SetPlayerReady(ctx, &pokerrpc.SetPlayerReadyRequest{
+				PlayerId: a.cfg.PlayerID,
+				TableId:  a.cfg.TableID,
+			})
+			if err != nil {
+				a.cfg.Log.Debugf("Failed to get ready: %v", err)
+			}
+		}
+		return
+	}
+	if update.CurrentPlayer != a.cfg.PlayerID || self.Folded || self.IsAllIn {
+		return
+	}
+
+	key := turnKey{
+		phase:      update.Phase,
+		board:      len(update.CommunityCards),
+		pot:        update.Pot,
+		currentBet: update.CurrentBet,
+		myBet:      self.CurrentBet,
+		myBalance:  self.Balance,
+	}
+	if key != a.turn {
+		a.turn, a.turnAt, a.acted = key, time.Now(), false
+	}
+	a.deadline = time.Time{}
+	if update.ActionDeadlineUnixMs > 0 {
+		a.deadline = time.UnixMilli(update.ActionDeadlineUnixMs)
+	}
+	if a.acted {
+		return
+	}
+	a.acted = true
+
+	action := a.decide(v)
+	if err := a.act(ctx, action); err != nil {
+		// Try again on the next update or poll if the turn is still ours.
+		a.cfg.Log.Warnf("Failed to %v: %v", action, err)
+		a.acted = false
+		return
+	}
+	if a.cfg.OnAction != nil {
+		a.cfg.OnAction(update, action)
+	}
+}
+
+// decide asks the strategy for a legal action, checking or folding instead
+// when it does not answer before the player's clock runs out.
+func (a *Agent) decide(v strategy.View) strategy.Action {
+	decided := make(chan strategy.Action, 1)
+	go func() {
+		a.decideMu.Lock()
+		defer a.decideMu.Unlock()
+		decided <- a.cfg.Strategy.Decide(v)
+	}()
+
+	deadline := a.deadline
+	if deadline.IsZero() && a.cfg.TimeBank > 0 {
+		deadline = a.turnAt.Add(a.cfg.TimeBank)
+	}
+	var timeout <-chan time.Time
+	if !deadline.IsZero() {
+		left := time.Until(deadline.Add(-a.cfg.Margin))
+		if left < 0 {
+			left = 0
+		}
+		timer := time.NewTimer(left)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case action := <-decided:
+		return v.Legal(action)
+	case <-timeout:
+		a.cfg.Log.Warnf("Strategy did not decide in time, checking or folding")
+		return v.Legal(strategy.Action{Kind: strategy.Check})
+	}
+}
+
+// act sends action to the server.
+func (a *Agent) act(ctx context.Context, action strategy.Action) error {
+	var err error
+	switch action.Kind {
+	case strategy.Fold:
+		_, err = a.cfg.Poker.FoldBet(ctx, &pokerrpc.FoldBetRequest{PlayerId: a.cfg.PlayerID, TableId: a.cfg.TableID})
+	case strategy.Check:
+		_, err = a.cfg.Poker.CheckBet(ctx, &pokerrpc.CheckBetRequest{PlayerId: a.cfg.PlayerID, TableId: a.cfg.TableID})
+	case strategy.Call:
+		_, err = a.cfg.Poker.CallBet(ctx, &pokerrpc.CallBetRequest{PlayerId: a.cfg.PlayerID, TableId: a.cfg.TableID})
+	case strategy.Bet:
+		_, err = a.cfg.Poker.MakeBet(ctx, &pokerrpc.MakeBetRequest{PlayerId: a.cfg.PlayerID, TableId: a.cfg.TableID, Amount: action.Amount})
+	default:
+		err = fmt.Errorf("unknown action %v", action)
+	}
+	return err
+}
+
+// unready marks the player unready once the Agent stops, so the next game
+// does not wait on them.
+func (a *Agent) unready() {
+	ctx, cancel := context.WithTimeout(context.Background(), unreadyTimeout)
+	defer cancel()
+	_, err := a.cfg.Lobby.SetPlayerUnready(ctx, &pokerrpc.SetPlayerUnreadyRequest{
+		PlayerId: a.cfg.PlayerID,
+		TableId:  a.cfg.TableID,
+	})
+	if err != nil {
+		a.cfg.Log.Debugf("Failed to unready: %v", err)
+	}
+}
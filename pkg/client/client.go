@@ -22,7 +22,9 @@ import (
 	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
 	pokerutils "github.com/vctt94/pokerbisonrelay/pkg/utils"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 // Message types for UI communication
@@ -54,6 +56,7 @@ type PokerClient struct {
 	// Game streaming
 	gameStream   pokerrpc.PokerService_StartGameStreamClient
 	gameStreamMu sync.Mutex
+	watchCancel  context.CancelFunc // Ends the stream of a watched table
 
 	// For reconnection handling
 	ctx          context.Context
@@ -368,6 +371,10 @@ func (pc *PokerClient) stopGameStream() {
 		pc.gameStream = nil
 		pc.log.Info("Stopped game stream")
 	}
+	if pc.watchCancel != nil {
+		pc.watchCancel()
+		pc.watchCancel = nil
+	}
 }
 
 // handleGameStreamUpdates processes incoming game updates from the stream
@@ -393,7 +400,8 @@ func (pc *PokerClient) handleGameStreamUpdates(ctx context.Context) {
 
 			update, err := stream.Recv()
 			if err != nil {
-				if errors.Is(err, io.EOF) || strings.Contains(err.Error(), "transport is closing") ||
+				if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled ||
+					strings.Contains(err.Error(), "transport is closing") ||
 					strings.Contains(err.Error(), "connection is being forcefully terminated") {
 					pc.log.Info("Game stream closed")
 					return
@@ -10,6 +10,7 @@ import (
 
 	"github.com/vctt94/pokerbisonrelay/pkg/poker"
 	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // StartGameStream starts receiving real-time game updates for the current table
@@ -45,8 +46,56 @@ func (pc *PokerClient) StartGameStream(ctx context.Context) error {
 	return nil
 }
 
+// WatchTable starts receiving the public game updates of a table the player
+// is not seated at, in place of any current game stream. The server sends
+// them with the delay of the table.
+func (pc *PokerClient) WatchTable(ctx context.Context, tableID string) error {
+	pc.stopGameStream()
+
+	pc.gameStreamMu.Lock()
+	defer pc.gameStreamMu.Unlock()
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	stream, err := pc.PokerService.StartGameStream(watchCtx, &pokerrpc.StartGameStreamRequest{
+		PlayerId: pc.ID,
+		TableId:  tableID,
+		Spectate: true,
+	})
+	if err == nil {
+		// The server sends the headers once the player is watching; a
+		// stream it refuses ends without any and yields its error on Recv.
+		var md metadata.MD
+		if md, err = stream.Header(); err == nil && md == nil {
+			_, err = stream.Recv()
+		}
+	}
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to watch table: %w", err)
+	}
+
+	pc.gameStream = stream
+	pc.watchCancel = cancel
+	go pc.handleGameStreamUpdates(watchCtx)
+
+	pc.log.Infof("Watching table %s", tableID)
+	return nil
+}
+
+// StopWatching stops receiving the updates of a watched table.
+func (pc *PokerClient) StopWatching() {
+	pc.stopGameStream()
+}
+
 // CreateTable creates a new poker table using poker.TableConfig
 func (pc *PokerClient) CreateTable(ctx context.Context, config poker.TableConfig) (string, error) {
+	return pc.CreateRestrictedTable(ctx, config, false, "")
+}
+
+// CreateRestrictedTable creates a new poker table that is hidden from the
+// lobby and joined by invite when private is set, and that requires password
+// to join when it is not empty.
+func (pc *PokerClient) CreateRestrictedTable(ctx context.Context, config poker.TableConfig, private bool, password string) (string, error) {
 	// Convert poker.TableConfig to RPC CreateTableRequest
 	timeBankSeconds := int32(config.TimeBank.Seconds())
 	resp, err := pc.LobbyService.CreateTable(ctx, &pokerrpc.CreateTableRequest{
@@ -60,6 +109,15 @@ func (pc *PokerClient) CreateTable(ctx context.Context, config poker.TableConfig
 		StartingChips:   config.StartingChips,
 		TimeBankSeconds: timeBankSeconds,
 		AutoStartMs:     int32(config.AutoStartDelay.Milliseconds()),
+		Private:         private,
+		Password:        password,
+
+		SitOutAfterTimeouts: int32(config.SitOutAfterTimeouts),
+		SitOutLimitSeconds:  int32(config.SitOutLimit / time.Second),
+
+		TimeBankReserveSeconds: int32(config.TimeBankReserve / time.Second),
+		MaxSpectators:          int32(config.MaxSpectators),
+		SpectatorDelaySeconds:  int32(config.SpectatorDelay / time.Second),
 	})
 	if err != nil {
 		return "", err
@@ -75,9 +133,24 @@ func (pc *PokerClient) CreateTable(ctx context.Context, config poker.TableConfig
 
 // JoinTable joins an existing poker table and tracks the table ID
 func (pc *PokerClient) JoinTable(ctx context.Context, tableID string) error {
+	return pc.JoinRestrictedTable(ctx, tableID, "", "")
+}
+
+// JoinRestrictedTable joins a private or password-protected table with its
+// password or an invite code.
+func (pc *PokerClient) JoinRestrictedTable(ctx context.Context, tableID, password, inviteCode string) error {
+	return pc.JoinTableSeat(ctx, tableID, 0, password, inviteCode)
+}
+
+// JoinTableSeat joins a table in the given seat, numbered from 1. Seat 0
+// takes the seat the player reserved, or the first free one.
+func (pc *PokerClient) JoinTableSeat(ctx context.Context, tableID string, seat int, password, inviteCode string) error {
 	resp, err := pc.LobbyService.JoinTable(ctx, &pokerrpc.JoinTableRequest{
-		PlayerId: pc.ID,
-		TableId:  tableID,
+		PlayerId:   pc.ID,
+		TableId:    tableID,
+		Password:   password,
+		InviteCode: inviteCode,
+		Seat:       int32(seat),
 	})
 	if err != nil {
 		return err
@@ -126,15 +199,155 @@ func (pc *PokerClient) LeaveTable(ctx context.Context) error {
 	return nil
 }
 
+// ReserveSeat holds a seat, numbered from 1, at a table for the player while
+// they top up their balance; seat 0 holds the first free seat. A zero ttl
+// uses the server's default lifetime.
+func (pc *PokerClient) ReserveSeat(ctx context.Context, tableID string, seat int, password, inviteCode string, ttl time.Duration) (*pokerrpc.ReserveSeatResponse, error) {
+	return pc.LobbyService.ReserveSeat(ctx, &pokerrpc.ReserveSeatRequest{
+		PlayerId:   pc.ID,
+		TableId:    tableID,
+		Seat:       int32(seat),
+		Password:   password,
+		InviteCode: inviteCode,
+		TtlSeconds: int64(ttl / time.Second),
+	})
+}
+
+// JoinWaitlist puts the player in line for a seat at a full table. The
+// server sends a SEAT_OFFERED notification when a seat is held for them.
+func (pc *PokerClient) JoinWaitlist(ctx context.Context, tableID, password, inviteCode string) (*pokerrpc.JoinWaitlistResponse, error) {
+	return pc.LobbyService.JoinWaitlist(ctx, &pokerrpc.JoinWaitlistRequest{
+		PlayerId:   pc.ID,
+		TableId:    tableID,
+		Password:   password,
+		InviteCode: inviteCode,
+	})
+}
+
+// LeaveWaitlist takes the player out of a table's waitlist.
+func (pc *PokerClient) LeaveWaitlist(ctx context.Context, tableID string) (*pokerrpc.LeaveWaitlistResponse, error) {
+	return pc.LobbyService.LeaveWaitlist(ctx, &pokerrpc.LeaveWaitlistRequest{PlayerId: pc.ID, TableId: tableID})
+}
+
 // GetTables returns all available tables
 func (pc *PokerClient) GetTables(ctx context.Context) ([]*pokerrpc.Table, error) {
-	resp, err := pc.LobbyService.GetTables(ctx, &pokerrpc.GetTablesRequest{})
+	resp, err := pc.LobbyService.GetTables(ctx, &pokerrpc.GetTablesRequest{PlayerId: pc.ID})
 	if err != nil {
 		return nil, err
 	}
 	return resp.Tables, nil
 }
 
+// dropTable forgets the table the player was removed from, either by the host
+// or because the host closed it.
+func (pc *PokerClient) dropTable(tableID string) {
+	if tableID == "" || pc.GetCurrentTableID() != tableID {
+		return
+	}
+	pc.stopGameStream()
+	pc.SetCurrentTableID("")
+}
+
+// CreateTableInvite issues an invite code to a table hosted by the player.
+// An empty inviteeID creates a code anyone can use; a zero ttl uses the
+// server's default lifetime.
+func (pc *PokerClient) CreateTableInvite(ctx context.Context, tableID, inviteeID string, ttl time.Duration) (*pokerrpc.CreateTableInviteResponse, error) {
+	return pc.LobbyService.CreateTableInvite(ctx, &pokerrpc.CreateTableInviteRequest{
+		PlayerId:   pc.ID,
+		TableId:    tableID,
+		InviteeId:  inviteeID,
+		TtlSeconds: int64(ttl / time.Second),
+	})
+}
+
+// KickPlayer removes a player from a table hosted by the player. Their chips
+// are cashed out; players can only be removed between hands.
+func (pc *PokerClient) KickPlayer(ctx context.Context, tableID, targetID, reason string) (*pokerrpc.KickPlayerResponse, error) {
+	return pc.LobbyService.KickPlayer(ctx, &pokerrpc.KickPlayerRequest{
+		PlayerId: pc.ID,
+		TableId:  tableID,
+		TargetId: targetID,
+		Reason:   reason,
+	})
+}
+
+// BanPlayer bars a player from rejoining a table hosted by the player,
+// removing them first if they are seated.
+func (pc *PokerClient) BanPlayer(ctx context.Context, tableID, targetID, reason string) (*pokerrpc.BanPlayerResponse, error) {
+	return pc.LobbyService.BanPlayer(ctx, &pokerrpc.BanPlayerRequest{
+		PlayerId: pc.ID,
+		TableId:  tableID,
+		TargetId: targetID,
+		Reason:   reason,
+	})
+}
+
+// MuteChatPlayer refuses, or accepts again, the chat messages of a player at
+// a table hosted by the player.
+func (pc *PokerClient) MuteChatPlayer(ctx context.Context, tableID, targetID string, muted bool) (*pokerrpc.MuteChatPlayerResponse, error) {
+	return pc.LobbyService.MuteChatPlayer(ctx, &pokerrpc.MuteChatPlayerRequest{
+		PlayerId: pc.ID,
+		TableId:  tableID,
+		TargetId: targetID,
+		Muted:    muted,
+	})
+}
+
+// SitOut keeps the player's seat at a table without being dealt into the
+// following hands.
+func (pc *PokerClient) SitOut(ctx context.Context, tableID string) (*pokerrpc.SitOutResponse, error) {
+	return pc.LobbyService.SitOut(ctx, &pokerrpc.SitOutRequest{
+		PlayerId: pc.ID,
+		TableId:  tableID,
+	})
+}
+
+// SitIn deals the player back in at a table they sat out of.
+func (pc *PokerClient) SitIn(ctx context.Context, tableID string) (*pokerrpc.SitInResponse, error) {
+	return pc.LobbyService.SitIn(ctx, &pokerrpc.SitInRequest{
+		PlayerId: pc.ID,
+		TableId:  tableID,
+	})
+}
+
+// PauseTable pauses the game of a table hosted by the player.
+func (pc *PokerClient) PauseTable(ctx context.Context, tableID string) (*pokerrpc.PauseTableResponse, error) {
+	return pc.LobbyService.PauseTable(ctx, &pokerrpc.PauseTableRequest{
+		PlayerId: pc.ID,
+		TableId:  tableID,
+	})
+}
+
+// ResumeTable resumes the paused game of a table hosted by the player.
+func (pc *PokerClient) ResumeTable(ctx context.Context, tableID string) (*pokerrpc.ResumeTableResponse, error) {
+	return pc.LobbyService.ResumeTable(ctx, &pokerrpc.ResumeTableRequest{
+		PlayerId: pc.ID,
+		TableId:  tableID,
+	})
+}
+
+// CloseTable closes a table hosted by the player once its current hand ends,
+// cashing out every player.
+func (pc *PokerClient) CloseTable(ctx context.Context, tableID, reason string) (*pokerrpc.CloseTableResponse, error) {
+	return pc.LobbyService.CloseTable(ctx, &pokerrpc.CloseTableRequest{
+		PlayerId: pc.ID,
+		TableId:  tableID,
+		Reason:   reason,
+	})
+}
+
+// AddBot seats a bot playing strategyName at a table hosted by the player,
+// who pays its buy-in and collects its chips when it leaves. A zero
+// thinkTime uses the server default.
+func (pc *PokerClient) AddBot(ctx context.Context, tableID, strategyName string, thinkTime time.Duration) (*pokerrpc.AddBotResponse, error) {
+	return pc.LobbyService.AddBot(ctx, &pokerrpc.AddBotRequest{
+		PlayerId:    pc.ID,
+		TableId:     tableID,
+		Strategy:    strategyName,
+		ThinkTimeMs: thinkTime.Milliseconds(),
+	})
+}
+
 // GetPlayerCurrentTable returns the current table for the player
 func (pc *PokerClient) GetPlayerCurrentTable(ctx context.Context) (string, error) {
 	resp, err := pc.LobbyService.GetPlayerCurrentTable(ctx, &pokerrpc.GetPlayerCurrentTableRequest{
@@ -189,6 +402,38 @@ func (pc *PokerClient) ProcessTip(ctx context.Context, toPlayerID string, amount
 	return resp.NewBalance, nil
 }
 
+// GetTransactions returns a page of this player's transaction history. The
+// request's player ID is always set to the client's own.
+func (pc *PokerClient) GetTransactions(ctx context.Context, req *pokerrpc.GetTransactionsRequest) (*pokerrpc.GetTransactionsResponse, error) {
+	req.PlayerId = pc.ID
+	return pc.LobbyService.GetTransactions(ctx, req)
+}
+
+// RequestWithdrawal withdraws amount atoms from the player's balance. The
+// payout is sent to the configured payout address when toAddress is set and
+// as a Bison Relay tip otherwise.
+func (pc *PokerClient) RequestWithdrawal(ctx context.Context, amount int64, toAddress bool) (*pokerrpc.RequestWithdrawalResponse, error) {
+	req := &pokerrpc.RequestWithdrawalRequest{
+		PlayerId: pc.ID,
+		Amount:   amount,
+	}
+	if toAddress {
+		if pc.cfg == nil || pc.cfg.PayoutAddress == "" {
+			return nil, fmt.Errorf("no payout address configured")
+		}
+		req.PayoutAddress = pc.cfg.PayoutAddress
+	}
+	return pc.LobbyService.RequestWithdrawal(ctx, req)
+}
+
+// GetWithdrawals returns the player's most recent withdrawals.
+func (pc *PokerClient) GetWithdrawals(ctx context.Context, limit int32) (*pokerrpc.GetWithdrawalsResponse, error) {
+	return pc.LobbyService.GetWithdrawals(ctx, &pokerrpc.GetWithdrawalsRequest{
+		PlayerId: pc.ID,
+		Limit:    limit,
+	})
+}
+
 // SetPlayerReady sets the player ready status
 func (pc *PokerClient) SetPlayerReady(ctx context.Context) error {
 	tableID := pc.GetCurrentTableID()
@@ -388,6 +633,14 @@ func (pc *PokerClient) StartNotificationStream(ctx context.Context) error {
 					}
 					pc.log.Infof("Big blind posted: %d chips by %s", ntfn.Amount, ntfn.PlayerId)
 
+				case pokerrpc.NotificationType_PLAYER_KICKED, pokerrpc.NotificationType_PLAYER_BANNED:
+					if ntfn.PlayerId == pc.ID {
+						pc.dropTable(ntfn.TableId)
+					}
+
+				case pokerrpc.NotificationType_TABLE_CLOSED:
+					pc.dropTable(ntfn.TableId)
+
 				default:
 					pc.log.Debug("received unknown notification type", "type", ntfn.Type)
 				}
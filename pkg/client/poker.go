@@ -67,6 +67,25 @@ func (pc *PokerClient) Fold(ctx context.Context) error {
 	return err
 }
 
+// UseTimeBank adds the player's time bank to the clock of their turn at a
+// table.
+func (pc *PokerClient) UseTimeBank(ctx context.Context, tableID string) (*pokerrpc.UseTimeBankResponse, error) {
+	return pc.PokerService.UseTimeBank(ctx, &pokerrpc.UseTimeBankRequest{
+		PlayerId: pc.ID,
+		TableId:  tableID,
+	})
+}
+
+// SendChatMessage sends a message to the chat of a table the player is
+// seated at.
+func (pc *PokerClient) SendChatMessage(ctx context.Context, tableID, text string) (*pokerrpc.SendChatMessageResponse, error) {
+	return pc.PokerService.SendChatMessage(ctx, &pokerrpc.SendChatMessageRequest{
+		PlayerId: pc.ID,
+		TableId:  tableID,
+		Text:     text,
+	})
+}
+
 // Check checks (bet 0 when no one has bet)
 func (pc *PokerClient) Check(ctx context.Context) error {
 	currentTableID := pc.GetCurrentTableID()
@@ -0,0 +1,264 @@
+package poker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// Defaults of the time bank settings of TableConfig.
+const (
+	// DefaultTimeBankReserve is the extra time each player holds in reserve,
+	// on top of the base time of every action.
+	DefaultTimeBankReserve = 30 * time.Second
+	// DefaultTimeBankRefill is how much of their reserve is given back to
+	// each player dealt into a hand.
+	DefaultTimeBankRefill = 5 * time.Second
+)
+
+var (
+	// ErrTimeBankEmpty is returned when a player whose reserve is spent
+	// would draw on their time bank.
+	ErrTimeBankEmpty = errors.New("time bank is empty")
+	// ErrNoActionClock is returned when a player would draw on their time
+	// bank at a table without an action clock.
+	ErrNoActionClock = errors.New("table has no action clock")
+)
+
+// TimeBankEvent is the payload of the TIME_BANK_USED table event.
+type TimeBankEvent struct {
+	PlayerID string
+	Added    time.Duration // Time drawn from the player's reserve
+	Deadline time.Time     // New deadline of the player's turn
+}
+
+// AutoActionEvent is the payload of the CHECK_MADE and PLAYER_FOLDED table
+// events of turns played by the table for a player who ran out of time or
+// sits out.
+type AutoActionEvent struct {
+	PlayerID string
+}
+
+// timeBankReserve returns the most time a player may hold in reserve.
+func (t *Table) timeBankReserve() time.Duration {
+	if t.config.TimeBankReserve > 0 {
+		return t.config.TimeBankReserve
+	}
+	return DefaultTimeBankReserve
+}
+
+// timeBankRefill returns how much reserve a player dealt in gets back.
+func (t *Table) timeBankRefill() time.Duration {
+	if t.config.TimeBankRefill > 0 {
+		return t.config.TimeBankRefill
+	}
+	return DefaultTimeBankRefill
+}
+
+// refillTimeBanks gives back some reserve to the users dealt into a new
+// hand. Assumes the lock is held.
+func (t *Table) refillTimeBanks(dealtIn []*User) {
+	reserve := t.timeBankReserve()
+	for _, u := range dealtIn {
+		u.TimeBank += t.timeBankRefill()
+		if u.TimeBank > reserve {
+			u.TimeBank = reserve
+		}
+	}
+}
+
+// turnPlayer returns the player to act, or nil when nobody is. Assumes the
+// lock is held.
+func (t *Table) turnPlayer() *Player {
+	if t.game == nil || !t.handInProgress() {
+		return nil
+	}
+	i := t.game.currentPlayer
+	if i < 0 || i >= len(t.game.players) {
+		return nil
+	}
+	p := t.game.players[i]
+	if state := p.GetCurrentStateString(); state == "FOLDED" || state == "ALL_IN" {
+		return nil
+	}
+	return p
+}
+
+// startTurn starts the action clock of the player to act: they have
+// TimeBank to act, plus what they draw from their reserve, before the table
+// checks or folds for them. Players sitting out are acted for right away.
+// The clock runs per turn, not per street: every action gets the full base
+// time again, and only the reserve carries over from one action to the next.
+// Assumes the lock is held.
+func (t *Table) startTurn() {
+	t.turn++
+	t.stopClock()
+	t.deadline = time.Time{}
+
+	p := t.turnPlayer()
+	if p == nil || t.paused {
+		return
+	}
+	if u := t.users[p.ID]; u != nil && u.SittingOut {
+		t.armClock(0)
+		return
+	}
+	if t.config.TimeBank <= 0 {
+		return
+	}
+	t.deadline = time.Now().Add(t.config.TimeBank)
+	t.armClock(t.config.TimeBank)
+}
+
+// armClock runs out the current turn after d. Assumes the lock is held.
+func (t *Table) armClock(d time.Duration) {
+	turn := t.turn
+	t.actionTimer = time.AfterFunc(d, func() { t.expireTurn(turn) })
+}
+
+// stopClock stops the timer of the current turn. Assumes the lock is held.
+func (t *Table) stopClock() {
+	if t.actionTimer != nil {
+		t.actionTimer.Stop()
+		t.actionTimer = nil
+	}
+}
+
+// pauseClock stops the clock of the current turn, keeping the time left.
+// Assumes the lock is held.
+func (t *Table) pauseClock() {
+	t.stopClock()
+	t.clockLeft = 0
+	if !t.deadline.IsZero() {
+		t.clockLeft = time.Until(t.deadline)
+	}
+}
+
+// resumeClock gives the player to act back the time they had left when the
+// table was paused. Assumes the lock is held.
+func (t *Table) resumeClock() {
+	if t.turnPlayer() == nil {
+		return
+	}
+	left := time.Duration(0)
+	if !t.deadline.IsZero() {
+		left = t.clockLeft
+		t.deadline = time.Now().Add(left)
+	}
+	t.armClock(left)
+}
+
+// StopClock stops the action clock, for the table to be dropped.
+func (t *Table) StopClock() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.turn++
+	t.stopClock()
+}
+
+// ActionDeadline returns when the table acts for the current player, or the
+// zero time when nobody is on the clock.
+func (t *Table) ActionDeadline() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.deadline
+}
+
+// TimeBankLeft returns the time a seated user holds in their time bank.
+func (t *Table) TimeBankLeft(userID string) time.Duration {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if u := t.users[userID]; u != nil {
+		return u.TimeBank
+	}
+	return 0
+}
+
+// UseTimeBank adds the whole reserve of the player to act to their clock and
+// returns their new deadline.
+func (t *Table) UseTimeBank(userID string) (time.Time, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	u := t.users[userID]
+	if u == nil {
+		return time.Time{}, fmt.Errorf("user not at table")
+	}
+	if t.paused {
+		return time.Time{}, ErrTablePaused
+	}
+	if p := t.turnPlayer(); p == nil || p.ID != userID {
+		return time.Time{}, fmt.Errorf("not your turn to act")
+	}
+	if t.deadline.IsZero() {
+		return time.Time{}, ErrNoActionClock
+	}
+	if u.TimeBank <= 0 {
+		return time.Time{}, ErrTimeBankEmpty
+	}
+
+	added := u.TimeBank
+	u.TimeBank = 0
+	t.deadline = t.deadline.Add(added)
+	t.stopClock()
+	t.armClock(time.Until(t.deadline))
+	t.log.Debugf("Player %s drew %v from their time bank", userID, added)
+	t.PublishEvent(pokerrpc.NotificationType_TIME_BANK_USED, t.config.ID,
+		TimeBankEvent{PlayerID: userID, Added: added, Deadline: t.deadline})
+	return t.deadline, nil
+}
+
+// expireTurn runs out a turn whose clock expired, unless it was played or
+// the table paused meanwhile.
+func (t *Table) expireTurn(turn uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if turn != t.turn || t.paused {
+		return
+	}
+	t.timeOut()
+}
+
+// timeOut checks or folds for the player to act, who ran out of time or
+// sits out. Players who miss too many actions in a row are sat out. Assumes
+// the lock is held.
+func (t *Table) timeOut() {
+	currentPlayer := t.turnPlayer()
+	if currentPlayer == nil {
+		return
+	}
+	user := t.users[currentPlayer.ID]
+	sittingOut := user != nil && user.SittingOut
+	if !sittingOut && (t.deadline.IsZero() || time.Now().Before(t.deadline)) {
+		return
+	}
+	if user != nil && !sittingOut {
+		user.MissedActions++
+		if user.MissedActions >= t.sitOutAfter() {
+			t.sitOut(user, true)
+		}
+	}
+
+	now := time.Now()
+	event := pokerrpc.NotificationType_PLAYER_FOLDED
+	// A check is valid if the player's current bet equals the current bet
+	// (meaning they don't need to put any additional money in)
+	if currentPlayer.HasBet == t.game.currentBet {
+		event = pokerrpc.NotificationType_CHECK_MADE
+		currentPlayer.LastAction = now
+		t.game.IncrementActionsInRound()
+	} else {
+		// Fold: they cannot check because they need to call or raise
+		currentPlayer.stateMachine.Dispatch(playerStateFolded)
+		currentPlayer.LastAction = now
+	}
+	t.advanceToNextPlayer()
+	t.PublishEvent(event, t.config.ID, AutoActionEvent{PlayerID: currentPlayer.ID})
+
+	// Check if this action completes the betting round
+	t.MaybeAdvancePhase()
+	t.lastAction = now
+	t.startTurn()
+}
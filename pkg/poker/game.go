@@ -195,10 +195,13 @@ func stateBlinds(entity *Game) GameStateFn {
 		if amount > p.Balance {
 			// Player cannot cover blind – treat as all-in of remaining balance.
 			amount = p.Balance
-			p.stateMachine.Dispatch(playerStateAllIn)
 		}
 		p.Balance -= amount
 		p.HasBet += amount
+		if p.Balance == 0 {
+			// Dispatch once the chips are in, or the state bounces back to IN_GAME.
+			p.stateMachine.Dispatch(playerStateAllIn)
+		}
 		entity.potManager.AddBet(pos, amount, entity.players)
 	}
 
@@ -471,10 +474,67 @@ func (g *Game) SetPlayers(users []*User) {
 		// Copy table-level state from user
 		player.TableSeat = user.TableSeat
 		player.IsReady = user.IsReady
+		player.SittingOut = user.SittingOut
 		player.LastAction = time.Now() // Set current time since User doesn't have LastAction
 
 		g.players[i] = player
 	}
+	g.markDealer()
+}
+
+// nextSeatIndex returns the index in players, sorted by seat, of the first
+// player dealt in seated after seat, wrapping around the table. It returns 0
+// when nobody is dealt in.
+func nextSeatIndex(players []*Player, seat int) int {
+	first := -1
+	for i, p := range players {
+		if p.dealtOut {
+			continue
+		}
+		if p.TableSeat > seat {
+			return i
+		}
+		if first < 0 {
+			first = i
+		}
+	}
+	if first < 0 {
+		return 0
+	}
+	return first
+}
+
+// nextDealtIn returns the index of the first player dealt in after index i,
+// wrapping around the table, or i when there is none. Assumes the lock is
+// held.
+func (g *Game) nextDealtIn(i int) int {
+	n := len(g.players)
+	for k := 1; k <= n; k++ {
+		j := (i + k) % n
+		if !g.players[j].dealtOut {
+			return j
+		}
+	}
+	return i
+}
+
+// dealtInCount returns how many players were dealt into the hand. Assumes
+// the lock is held.
+func (g *Game) dealtInCount() int {
+	n := 0
+	for _, p := range g.players {
+		if !p.dealtOut {
+			n++
+		}
+	}
+	return n
+}
+
+// markDealer flags the player holding the button. Assumes the lock is held.
+func (g *Game) markDealer() {
+	for i, p := range g.players {
+		p.IsDealer = i == g.dealer
+	}
 }
 
 // IncrementActionsInRound increments the action counter for the current betting round
@@ -503,6 +563,13 @@ func (g *Game) ResetForNewHand(activePlayers []*Player) error {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 
+	// The button moves to the next occupied seat after the one that held it,
+	// whoever joined or left in between.
+	buttonSeat := -1
+	if g.dealer >= 0 && g.dealer < len(g.players) {
+		buttonSeat = g.players[g.dealer].TableSeat
+	}
+
 	// Update player references for this hand - use the same objects to maintain unified state
 	g.players = activePlayers
 	potManager := NewPotManager(len(activePlayers))
@@ -516,9 +583,8 @@ func (g *Game) ResetForNewHand(activePlayers []*Player) error {
 	g.winners = nil
 
 	// Advance dealer position for new hand
-	if len(activePlayers) > 0 {
-		g.dealer = (g.dealer + 1) % len(activePlayers)
-	}
+	g.dealer = nextSeatIndex(activePlayers, buttonSeat)
+	g.markDealer()
 
 	// Create a shuffled deck for the new hand.
 	// If a deterministic seed is configured, advance the sequence by incorporating
@@ -631,7 +697,7 @@ func (g *Game) handlePlayerCall(playerID string) error {
 	}
 
 	player.Balance -= delta
-	player.HasBet = g.currentBet
+	player.HasBet += delta
 	player.LastAction = time.Now()
 
 	// Update player state using state machine dispatch
@@ -993,20 +1059,50 @@ func (g *Game) maybeAdvancePhase() {
 	g.log.Debugf("maybeAdvancePhase: phase=%v actionsInRound=%d currentBet=%d",
 		g.phase, g.actionsInRound, g.currentBet)
 
-	// Count active players (non-folded and non-all-in) from game players
-	// All-in players can't act, so they don't count toward the action requirement
-	activePlayers := 0
+	// Count players still in the hand, and the active ones (non-folded and
+	// non-all-in) among them. All-in players can't act, so they don't count
+	// toward the action requirement
+	inHand, activePlayers := 0, 0
 	for _, p := range g.players {
-		if p.GetCurrentStateString() != "FOLDED" && p.GetCurrentStateString() != "ALL_IN" {
+		if p.GetCurrentStateString() == "FOLDED" {
+			continue
+		}
+		inHand++
+		if p.GetCurrentStateString() != "ALL_IN" {
 			activePlayers++
 		}
 	}
 
 	// If only one player remains, advance to showdown
+	if inHand <= 1 {
+		g.phase = pokerrpc.GamePhase_SHOWDOWN
+		g.stateMachine.Dispatch(stateShowdown)
+		g.log.Debugf("maybeAdvancePhase: only %d players in hand, moving to SHOWDOWN", inHand)
+		return
+	}
+
+	// If at most one player can still act there is no more betting: once
+	// that player has matched the bet, run out the board and show down
 	if activePlayers <= 1 {
+		for _, p := range g.players {
+			state := p.GetCurrentStateString()
+			if state != "FOLDED" && state != "ALL_IN" && p.HasBet < g.currentBet {
+				g.log.Debugf("maybeAdvancePhase: waiting for %s to call the all-in", p.ID)
+				return
+			}
+		}
+		if len(g.communityCards) < 3 {
+			g.StateFlop()
+		}
+		if len(g.communityCards) < 4 {
+			g.StateTurn()
+		}
+		if len(g.communityCards) < 5 {
+			g.StateRiver()
+		}
 		g.phase = pokerrpc.GamePhase_SHOWDOWN
 		g.stateMachine.Dispatch(stateShowdown)
-		g.log.Debugf("maybeAdvancePhase: only %d active players, moving to SHOWDOWN", activePlayers)
+		g.log.Debugf("maybeAdvancePhase: %d players can act, ran out the board, moving to SHOWDOWN", activePlayers)
 		return
 	}
 
@@ -1098,7 +1194,8 @@ func (g *Game) initializeCurrentPlayer() {
 		return
 	}
 
-	numPlayers := len(g.players)
+	// Players sitting out hold no position
+	numPlayers := g.dealtInCount()
 
 	// In pre-flop, start with Under the Gun (player after big blind)
 	if g.phase == pokerrpc.GamePhase_PRE_FLOP {
@@ -1108,7 +1205,7 @@ func (g *Game) initializeCurrentPlayer() {
 			g.currentPlayer = g.dealer
 		} else {
 			// In multi-way, Under the Gun acts first (after big blind)
-			g.currentPlayer = (g.dealer + 3) % numPlayers
+			g.currentPlayer = g.nextDealtIn(g.nextDealtIn(g.nextDealtIn(g.dealer)))
 		}
 	} else {
 		// In post-flop streets, start with small blind position
@@ -1117,7 +1214,7 @@ func (g *Game) initializeCurrentPlayer() {
 			g.currentPlayer = g.dealer
 		} else {
 			// In multi-way, small blind is player after dealer
-			g.currentPlayer = (g.dealer + 1) % numPlayers
+			g.currentPlayer = g.nextDealtIn(g.dealer)
 		}
 	}
 
@@ -1131,8 +1228,10 @@ func (g *Game) initializeCurrentPlayer() {
 			g.currentPlayer = 0 // Reset to first player if out of bounds
 		}
 
-		// Use the unified player state directly
-		if g.players[g.currentPlayer].GetCurrentStateString() != "FOLDED" {
+		// Use the unified player state directly; folded and all-in players
+		// can't act
+		state := g.players[g.currentPlayer].GetCurrentStateString()
+		if state != "FOLDED" && state != "ALL_IN" {
 			break
 		}
 
@@ -1187,6 +1286,7 @@ func (g *Game) SetGameState(dealer, currentPlayer, round, betRound int, currentB
 	defer g.mu.Unlock()
 
 	g.dealer = dealer
+	g.markDealer()
 	g.currentPlayer = currentPlayer
 	g.round = round
 	g.betRound = betRound
@@ -1307,6 +1407,7 @@ type GameStateSnapshot struct {
 	BetRound       int
 	CommunityCards []Card
 	DeckState      interface{}
+	PotState       *PotManagerState
 	Players        []*Player
 }
 
@@ -1336,6 +1437,11 @@ func (g *Game) GetStateSnapshot() GameStateSnapshot {
 		}
 		// Copy the hand cards
 		copy(playerCopy.Hand, player.Hand)
+		// Preserve the player's state (folded, all-in, ...) so persisted
+		// snapshots restore it faithfully.
+		if player.stateMachine != nil {
+			playerCopy.stateMachine = statemachine.NewStateMachine(playerCopy, player.stateMachine.GetCurrentState())
+		}
 		playersCopy[i] = playerCopy
 	}
 
@@ -1357,6 +1463,7 @@ func (g *Game) GetStateSnapshot() GameStateSnapshot {
 		BetRound:       g.betRound,
 		CommunityCards: communityCardsCopy,
 		DeckState:      g.deck.GetState(),
+		PotState:       g.potManager.GetState(),
 		Players:        playersCopy,
 	}
 }
@@ -1393,6 +1500,42 @@ func (g *Game) ForceSetPot(amount int64) {
 	g.potManager.Pots[0].Amount = amount
 }
 
+// SetPotManagerState replaces the pot manager with one rebuilt from a
+// persisted state, restoring side pots, eligibility and per-player bets.
+func (g *Game) SetPotManagerState(state *PotManagerState) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	pm, err := NewPotManagerFromState(state)
+	if err != nil {
+		return err
+	}
+	if state.NumPlayers != len(g.players) {
+		return fmt.Errorf("pot state has %d players, game has %d",
+			state.NumPlayers, len(g.players))
+	}
+
+	g.potManager = pm
+	return nil
+}
+
+// SetDeckState restores the remaining cards of the deck from persistence so
+// that the rest of the board is dealt exactly as it would have been.
+func (g *Game) SetDeckState(state *DeckState) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.deck == nil {
+		deck, err := NewDeckFromState(state, nil)
+		if err != nil {
+			return err
+		}
+		g.deck = deck
+		return nil
+	}
+	return g.deck.RestoreState(state)
+}
+
 // SetOnNewHandStartedCallback registers a callback to be executed each time a
 // new hand is successfully auto-started. The callback will be invoked from the
 // auto-start timer goroutine, so it MUST be thread-safe and return quickly.
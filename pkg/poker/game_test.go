@@ -9,6 +9,7 @@ import (
 
 	"github.com/decred/slog"
 	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
 )
 
 // createTestLogger creates a simple logger for testing
@@ -509,3 +510,28 @@ func TestAutoStartOnNewHandStarted(t *testing.T) {
 	}
 	mu.Unlock()
 }
+
+func TestInitializeCurrentPlayerSkipsAllIn(t *testing.T) {
+	game, err := NewGame(GameConfig{
+		NumPlayers:    3,
+		StartingChips: 1000,
+		Seed:          42,
+		Log:           createTestLogger(),
+	})
+	require.NoError(t, err)
+	game.SetPlayers([]*User{
+		NewUser("p0", "p0", 1000, 0),
+		NewUser("p1", "p1", 1000, 1),
+		NewUser("p2", "p2", 1000, 2),
+	})
+	game.dealer = 0
+	game.phase = pokerrpc.GamePhase_FLOP
+
+	// The small blind is all-in, so the first to act after the flop is the
+	// big blind.
+	game.players[1].Balance = 0
+	game.players[1].stateMachine.Dispatch(playerStateAllIn)
+	require.Equal(t, "ALL_IN", game.players[1].GetCurrentStateString())
+	game.initializeCurrentPlayer()
+	require.Equal(t, 2, game.currentPlayer)
+}
@@ -0,0 +1,101 @@
+package poker
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// CheckInvariants verifies the bookkeeping of the hand and returns every
+// violation found, or nil. It holds after every action:
+//   - no chips are created or lost: the stacks plus the pots add up to what
+//     the players started the hand with, which also covers DistributePots
+//     once the hand is settled
+//   - until the pots are paid out, each player's total bet is what left
+//     their stack, and the pots add up to the total bets
+//   - nobody's HasBet exceeds what they put in this betting round
+//   - during a betting round the current player is neither folded nor
+//     all-in
+func (g *Game) CheckInvariants() error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.checkInvariants()
+}
+
+// checkInvariants is the core logic without locking (for internal use)
+func (g *Game) checkInvariants() error {
+	var errs []error
+
+	var stacks, started int64
+	for _, p := range g.players {
+		if p.Balance < 0 {
+			errs = append(errs, fmt.Errorf("player %s has a negative stack %d", p.ID, p.Balance))
+		}
+		stacks += p.Balance
+		started += p.StartingBalance
+	}
+	pots := g.potManager.GetTotalPot()
+	if stacks+pots != started {
+		errs = append(errs, fmt.Errorf("stacks %d plus pots %d != %d at the start of the hand", stacks, pots, started))
+	}
+
+	// Paid out pots are zeroed while the bets stay recorded
+	settled := pots == 0
+	if err := g.potManager.checkInvariants(g.players, settled); err != nil {
+		errs = append(errs, err)
+	}
+	for i, p := range g.players {
+		if put := g.potManager.CurrentBets[i]; p.HasBet > put {
+			errs = append(errs, fmt.Errorf("player %s has bet %d but put in %d this round", p.ID, p.HasBet, put))
+		}
+		if paid := p.StartingBalance - p.Balance; !settled && g.potManager.TotalBets[i] != paid {
+			errs = append(errs, fmt.Errorf("player %s has total bets %d but paid %d", p.ID, g.potManager.TotalBets[i], paid))
+		}
+	}
+
+	switch g.phase {
+	case pokerrpc.GamePhase_PRE_FLOP, pokerrpc.GamePhase_FLOP, pokerrpc.GamePhase_TURN, pokerrpc.GamePhase_RIVER:
+		if g.currentPlayer < 0 || g.currentPlayer >= len(g.players) {
+			errs = append(errs, fmt.Errorf("current player %d out of range in %v", g.currentPlayer, g.phase))
+			break
+		}
+		cur := g.players[g.currentPlayer]
+		if state := cur.GetCurrentStateString(); state == "FOLDED" || state == "ALL_IN" {
+			errs = append(errs, fmt.Errorf("current player %s is %s in %v", cur.ID, state, g.phase))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkInvariants verifies that the pots add up to the total bets, unless
+// they were paid out, and match the players.
+func (pm *PotManager) checkInvariants(players []*Player, settled bool) error {
+	var errs []error
+
+	var bets int64
+	for i, bet := range pm.TotalBets {
+		if i < 0 || i >= len(players) {
+			errs = append(errs, fmt.Errorf("bet of player index %d out of range (players=%d)", i, len(players)))
+		}
+		if bet < 0 {
+			errs = append(errs, fmt.Errorf("player index %d has negative total bets %d", i, bet))
+		}
+		bets += bet
+	}
+	if total := pm.GetTotalPot(); !settled && total != bets {
+		errs = append(errs, fmt.Errorf("pots %d != total bets %d", total, bets))
+	}
+
+	for pi, pot := range pm.Pots {
+		if pot.Amount < 0 {
+			errs = append(errs, fmt.Errorf("[pot %d] negative amount %d", pi, pot.Amount))
+		}
+		if len(pot.Eligibility) != len(players) {
+			errs = append(errs, fmt.Errorf("[pot %d] eligibility len %d != players len %d", pi, len(pot.Eligibility), len(players)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,141 @@
+package poker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/decred/slog"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// newInvariantTable returns a started table of n players with the given
+// stacks, checking the invariants after every action.
+func newInvariantTable(t testing.TB, n int, chips, smallBlind int64, seed int64) *Table {
+	t.Helper()
+	if seed == 0 {
+		seed = 1
+	}
+	table := NewTable(TableConfig{
+		ID:              "invariants",
+		Log:             slog.Disabled,
+		GameLog:         slog.Disabled,
+		HostID:          "p0",
+		MinPlayers:      2,
+		MaxPlayers:      n,
+		SmallBlind:      smallBlind,
+		BigBlind:        2 * smallBlind,
+		StartingChips:   chips,
+		Seed:            seed,
+		CheckInvariants: true,
+	})
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("p%d", i)
+		_, err := table.AddNewUser(id, id, 0, i)
+		require.NoError(t, err)
+		require.NoError(t, table.SetPlayerReady(id, true))
+	}
+	require.True(t, table.CheckAllPlayersReady())
+	require.NoError(t, table.StartGame())
+	return table
+}
+
+// act takes the action picked by b for the current player, falling back to
+// the actions that are legal.
+func act(table *Table, b byte) error {
+	game := table.GetGame()
+	cur := game.GetCurrentPlayerObject()
+	owed := game.GetCurrentBet() - cur.HasBet
+	switch b % 4 {
+	case 0:
+		if owed > 0 {
+			return table.HandleFold(cur.ID)
+		}
+	case 1:
+		if owed > 0 {
+			return table.HandleCall(cur.ID)
+		}
+	case 2:
+		raiseTo := game.GetCurrentBet() + int64(b/4)*game.config.BigBlind/8 + game.config.BigBlind
+		return table.MakeBet(cur.ID, raiseTo)
+	}
+	if owed > 0 {
+		return table.HandleCall(cur.ID)
+	}
+	return table.HandleCheck(cur.ID)
+}
+
+// playActions plays the hands picked by actions, checking the invariants
+// after each one, until the actions run out or the game ends.
+func playActions(t testing.TB, table *Table, actions []byte) {
+	t.Helper()
+	for _, b := range actions {
+		game := table.GetGame()
+		if game == nil {
+			return
+		}
+		if game.GetPhase() == pokerrpc.GamePhase_SHOWDOWN {
+			require.NoError(t, table.StartNextHand())
+			if game = table.GetGame(); game == nil {
+				return
+			}
+		}
+		require.NoError(t, game.CheckInvariants())
+		if game.GetPhase() == pokerrpc.GamePhase_SHOWDOWN {
+			continue // The blinds ran the new hand out
+		}
+		require.NoError(t, act(table, b))
+		if game = table.GetGame(); game != nil {
+			require.NoError(t, game.CheckInvariants(), "after action %d", b)
+		}
+	}
+}
+
+func TestCheckInvariants(t *testing.T) {
+	table := newInvariantTable(t, 3, 1000, 10, 1)
+	playActions(t, table, []byte{2, 1, 1, 3, 3, 3, 2, 0, 1})
+	game := table.GetGame()
+	require.NotNil(t, game)
+	require.NoError(t, game.CheckInvariants())
+
+	// Chips out of thin air.
+	game.players[0].Balance += 5
+	require.ErrorContains(t, game.CheckInvariants(), "stacks")
+	game.players[0].Balance -= 5
+
+	// A bet that was never put in.
+	game.players[1].HasBet += 100
+	require.ErrorContains(t, game.CheckInvariants(), "put in")
+	game.players[1].HasBet -= 100
+
+	// A pot out of step with the bets.
+	game.potManager.Pots[0].Amount++
+	require.ErrorContains(t, game.CheckInvariants(), "total bets")
+	game.potManager.Pots[0].Amount--
+
+	require.NoError(t, game.CheckInvariants())
+}
+
+func TestCheckInvariantsAllIns(t *testing.T) {
+	// Stacks shorter than the blinds put players all-in from the start.
+	for seed := int64(1); seed <= 20; seed++ {
+		table := newInvariantTable(t, 4, 15, 5, seed)
+		actions := make([]byte, 200)
+		for i := range actions {
+			actions[i] = byte(i * int(seed))
+		}
+		playActions(t, table, actions)
+	}
+}
+
+func FuzzGameActions(f *testing.F) {
+	f.Add(byte(3), byte(100), byte(5), []byte{2, 1, 1, 3, 3, 3, 2, 0, 1})
+	f.Add(byte(6), byte(15), byte(5), []byte{1, 1, 1, 1, 1, 1, 1, 1})
+	f.Add(byte(2), byte(40), byte(10), []byte{6, 10, 14, 18, 22, 26})
+	f.Fuzz(func(t *testing.T, players, chips, smallBlind byte, actions []byte) {
+		n := 2 + int(players)%8
+		sb := 1 + int64(smallBlind)%50
+		table := newInvariantTable(t, n, 1+int64(chips)*4, sb, int64(players)<<16|int64(chips)<<8|int64(smallBlind))
+		playActions(t, table, actions)
+	})
+}
@@ -0,0 +1,236 @@
+package poker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+var (
+	// ErrHandInProgress is returned for host actions that must wait until the
+	// current hand ends.
+	ErrHandInProgress = errors.New("a hand is in progress")
+	// ErrTablePaused is returned for player actions and new hands while the
+	// host has paused the table.
+	ErrTablePaused = errors.New("table is paused")
+	// ErrTableClosing is returned when a new game or hand would start on a
+	// table that is closing.
+	ErrTableClosing = errors.New("table is closing")
+	// ErrTableDraining is returned when a new game or hand would start while
+	// the server drains.
+	ErrTableDraining = errors.New("server is draining")
+)
+
+// holdErr returns why no new game or hand may start, or nil when one may.
+// Assumes the lock is held.
+func (t *Table) holdErr() error {
+	switch {
+	case t.paused:
+		return ErrTablePaused
+	case t.closing:
+		return ErrTableClosing
+	case t.draining:
+		return ErrTableDraining
+	}
+	return nil
+}
+
+// HandInProgress returns whether a hand is being played, that is whether the
+// game has not reached the showdown of its current hand.
+func (t *Table) HandInProgress() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.handInProgress()
+}
+
+// handInProgress is HandInProgress without acquiring locks.
+func (t *Table) handInProgress() bool {
+	return t.game != nil && t.game.phase != pokerrpc.GamePhase_SHOWDOWN
+}
+
+// chipsOf returns the chips of a seated user: their game balance while a game
+// is on, what they ended the last game with, or the starting chips when they
+// have not played yet. Assumes the lock is held.
+func (t *Table) chipsOf(userID string) int64 {
+	if t.game != nil {
+		for _, p := range t.game.players {
+			if p.ID == userID {
+				return p.Balance
+			}
+		}
+		return t.config.StartingChips
+	}
+	if chips, ok := t.finalChips[userID]; ok {
+		return chips
+	}
+	return t.config.StartingChips
+}
+
+// ChipCounts returns the chips of every seated user, as used to cash players
+// out of the table.
+func (t *Table) ChipCounts() map[string]int64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	chips := make(map[string]int64, len(t.users))
+	for id := range t.users {
+		chips[id] = t.chipsOf(id)
+	}
+	return chips
+}
+
+// KickUser removes a user from the table between hands and returns the chips
+// they leave with. The game ends when fewer than two users remain.
+func (t *Table) KickUser(userID string) (int64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.users[userID]; !ok {
+		return 0, fmt.Errorf("user not at table")
+	}
+	if t.handInProgress() {
+		return 0, ErrHandInProgress
+	}
+
+	chips := t.chipsOf(userID)
+	delete(t.users, userID)
+	delete(t.finalChips, userID)
+	if t.game != nil && len(t.users) < 2 {
+		t.game.CancelAutoStart()
+		t.endGame("Not enough players remaining")
+	}
+	t.lastAction = time.Now()
+	return chips, nil
+}
+
+// Pause freezes the table: players cannot act, the current player's action
+// clock stops running and no new hand is started until Resume is called.
+func (t *Table) Pause() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.paused {
+		return ErrTablePaused
+	}
+	if t.closing {
+		return ErrTableClosing
+	}
+	if t.draining {
+		return ErrTableDraining
+	}
+	t.paused = true
+	t.pauseClock()
+	if t.game != nil {
+		t.game.CancelAutoStart()
+	}
+	return nil
+}
+
+// Resume unfreezes a paused table. The current player gets back the time
+// that was left on their action clock, and a hand that ended while paused is
+// followed by a new one after the auto-start delay.
+func (t *Table) Resume() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.paused {
+		return fmt.Errorf("table is not paused")
+	}
+	t.resume()
+	return nil
+}
+
+// resume clears the pause, assuming the lock is held.
+func (t *Table) resume() {
+	t.paused = false
+	if t.game == nil {
+		return
+	}
+	t.resumeClock()
+	if t.game.phase == pokerrpc.GamePhase_SHOWDOWN && t.config.AutoStartDelay > 0 && t.holdErr() == nil {
+		t.game.ScheduleAutoStart()
+	}
+}
+
+// IsPaused returns whether the host has paused the table.
+func (t *Table) IsPaused() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.paused
+}
+
+// BeginClose marks the table as closing. A paused table is resumed so the
+// current hand can be played out, and no further hand is started.
+func (t *Table) BeginClose() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.closing = true
+	if t.paused {
+		t.resume()
+	}
+	if t.game != nil {
+		t.game.CancelAutoStart()
+	}
+}
+
+// Drain stops the table from starting new games and hands, for a server
+// restart. A paused table is resumed so the hand in progress plays out.
+func (t *Table) Drain() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.draining = true
+	if t.paused {
+		t.resume()
+	}
+	if t.game != nil {
+		t.game.CancelAutoStart()
+	}
+}
+
+// ForceEndGame ends the game at the table. The chips players put into the
+// pot of an unfinished hand are returned to them. Players stay seated and
+// must ready up again to play.
+func (t *Table) ForceEndGame(reason string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.game == nil {
+		return fmt.Errorf("no game in progress")
+	}
+	t.game.CancelAutoStart()
+	if t.handInProgress() && t.game.potManager != nil {
+		for i, p := range t.game.players {
+			p.Balance += t.game.potManager.GetTotalBet(i)
+		}
+	}
+	t.endGame(reason)
+	t.lastAction = time.Now()
+	return nil
+}
+
+// LastActivity returns when the table last changed: a user joined or left,
+// or a hand started or progressed.
+func (t *Table) LastActivity() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.lastAction
+}
+
+// IsClosing returns whether the table closes once the current hand ends.
+func (t *Table) IsClosing() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.closing
+}
+
+// IsDraining returns whether the table stopped starting hands because the
+// server drains.
+func (t *Table) IsDraining() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.draining
+}
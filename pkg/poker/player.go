@@ -20,6 +20,7 @@ type Player struct {
 	TableSeat      int  // Seat position at the table
 	IsReady        bool // Ready to start/continue games
 	IsDisconnected bool // Whether player is disconnected (for game flow control)
+	SittingOut     bool // Keeps the seat but is not dealt in
 	LastAction     time.Time
 
 	// Game-level state (reset between hands)
@@ -31,6 +32,10 @@ type Player struct {
 	IsDealer        bool
 	IsTurn          bool
 
+	// Sat out of the current hand: folded from the deal and skipped for the
+	// button and blinds
+	dealtOut bool
+
 	// State machine - Rob Pike's pattern
 	stateMachine *statemachine.StateMachine[Player]
 
@@ -272,3 +272,96 @@ func (pm *PotManager) ReturnUncalledBet(players []*Player) {
 		pm.RebuildPotsIncremental(players)
 	}
 }
+
+// PotState is the serializable form of a single pot.
+type PotState struct {
+	Amount          int64 `json:"amount"`
+	EligiblePlayers []int `json:"eligible_players"` // Indices of players eligible to win this pot
+}
+
+// PotManagerState is the serializable form of a PotManager. It captures the
+// full side-pot structure together with each player's contributions so that a
+// hand interrupted by a restart can be settled exactly as it would have been.
+type PotManagerState struct {
+	NumPlayers  int           `json:"num_players"`
+	Pots        []PotState    `json:"pots"`
+	CurrentBets map[int]int64 `json:"current_bets"`
+	TotalBets   map[int]int64 `json:"total_bets"`
+}
+
+// GetState returns a deep copy of the pot manager state for persistence.
+func (pm *PotManager) GetState() *PotManagerState {
+	state := &PotManagerState{
+		Pots:        make([]PotState, 0, len(pm.Pots)),
+		CurrentBets: make(map[int]int64, len(pm.CurrentBets)),
+		TotalBets:   make(map[int]int64, len(pm.TotalBets)),
+	}
+
+	for _, pot := range pm.Pots {
+		if len(pot.Eligibility) > state.NumPlayers {
+			state.NumPlayers = len(pot.Eligibility)
+		}
+		ps := PotState{Amount: pot.Amount, EligiblePlayers: make([]int, 0, len(pot.Eligibility))}
+		for idx, elig := range pot.Eligibility {
+			if elig {
+				ps.EligiblePlayers = append(ps.EligiblePlayers, idx)
+			}
+		}
+		state.Pots = append(state.Pots, ps)
+	}
+	for idx, bet := range pm.CurrentBets {
+		state.CurrentBets[idx] = bet
+	}
+	for idx, bet := range pm.TotalBets {
+		state.TotalBets[idx] = bet
+	}
+
+	return state
+}
+
+// NewPotManagerFromState rebuilds a PotManager from a persisted state.
+func NewPotManagerFromState(state *PotManagerState) (*PotManager, error) {
+	if state == nil {
+		return nil, fmt.Errorf("pot manager state is nil")
+	}
+	if state.NumPlayers < 0 {
+		return nil, fmt.Errorf("invalid number of players: %d", state.NumPlayers)
+	}
+
+	pm := &PotManager{
+		Pots:        make([]*Pot, 0, len(state.Pots)),
+		CurrentBets: make(map[int]int64, len(state.CurrentBets)),
+		TotalBets:   make(map[int]int64, len(state.TotalBets)),
+	}
+
+	for pi, ps := range state.Pots {
+		pot := NewPot(state.NumPlayers)
+		pot.Amount = ps.Amount
+		for _, idx := range ps.EligiblePlayers {
+			if idx < 0 || idx >= state.NumPlayers {
+				return nil, fmt.Errorf("[pot %d] eligible player index %d out of range (players=%d)",
+					pi, idx, state.NumPlayers)
+			}
+			pot.MakeEligible(idx)
+		}
+		pm.Pots = append(pm.Pots, pot)
+	}
+	if len(pm.Pots) == 0 {
+		pm.Pots = []*Pot{NewPot(state.NumPlayers)}
+	}
+
+	for idx, bet := range state.CurrentBets {
+		if idx < 0 || idx >= state.NumPlayers {
+			return nil, fmt.Errorf("current bet player index %d out of range (players=%d)", idx, state.NumPlayers)
+		}
+		pm.CurrentBets[idx] = bet
+	}
+	for idx, bet := range state.TotalBets {
+		if idx < 0 || idx >= state.NumPlayers {
+			return nil, fmt.Errorf("total bet player index %d out of range (players=%d)", idx, state.NumPlayers)
+		}
+		pm.TotalBets[idx] = bet
+	}
+
+	return pm, nil
+}
@@ -1,6 +1,7 @@
 package poker
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -1294,3 +1295,120 @@ func TestContested_TieSplitRemainder(t *testing.T) {
 		}
 	}
 }
+
+// Side pots and per-player contributions survive a JSON round trip and pay
+// out exactly as the original pot manager would.
+func TestPotManagerState_RoundTripSidePots(t *testing.T) {
+	players := mkPlayers(3)
+	pm := NewPotManager(3)
+
+	pm.AddBet(0, 100, players) // A
+	pm.AddBet(1, 50, players)  // B (all-in)
+	pm.AddBet(2, 100, players) // C
+	players[0].HandValue = &HandValue{RankValue: 2}
+	players[1].HandValue = &HandValue{RankValue: 1} // B (best)
+	players[2].HandValue = &HandValue{RankValue: 2}
+
+	data, err := json.Marshal(pm.GetState())
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var state PotManagerState
+	if err := json.Unmarshal(data, &state); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	restored, err := NewPotManagerFromState(&state)
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+
+	if len(restored.Pots) != len(pm.Pots) {
+		t.Fatalf("pots=%d want %d", len(restored.Pots), len(pm.Pots))
+	}
+	for i := range pm.Pots {
+		if restored.Pots[i].Amount != pm.Pots[i].Amount ||
+			!equalBool(restored.Pots[i].Eligibility, pm.Pots[i].Eligibility) {
+			t.Fatalf("pot %d = %+v want %+v", i, restored.Pots[i], pm.Pots[i])
+		}
+	}
+	for i := 0; i < 3; i++ {
+		if restored.GetTotalBet(i) != pm.GetTotalBet(i) || restored.GetCurrentBet(i) != pm.GetCurrentBet(i) {
+			t.Fatalf("player %d bets total=%d current=%d want total=%d current=%d", i,
+				restored.GetTotalBet(i), restored.GetCurrentBet(i), pm.GetTotalBet(i), pm.GetCurrentBet(i))
+		}
+	}
+
+	bals, pot := settle(t, restored, players)
+	if pot != 250 {
+		t.Fatalf("pot=%d want 250", pot)
+	}
+	want := []int64{50, 150, 50} // main: B=150; side: A and C split 100
+	for i := range bals {
+		if bals[i] != want[i] {
+			t.Fatalf("balances=%v want %v", bals, want)
+		}
+	}
+}
+
+func TestPotManagerState_RejectsOutOfRangeIndex(t *testing.T) {
+	state := &PotManagerState{
+		NumPlayers: 2,
+		Pots:       []PotState{{Amount: 40, EligiblePlayers: []int{0, 2}}},
+		TotalBets:  map[int]int64{0: 20, 1: 20},
+	}
+	if _, err := NewPotManagerFromState(state); err == nil {
+		t.Fatalf("expected error for out of range eligible player")
+	}
+}
+
+// DistributePots pays out exactly the chips in the pots, whatever the side
+// pots and ties.
+func FuzzDistributePots(f *testing.F) {
+	f.Add([]byte{100, 0, 3, 50, 0, 1, 100, 0, 3})
+	f.Add([]byte{20, 1, 0, 60, 0, 2, 60, 0, 2, 5, 0, 2})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Three bytes per player: bet, folded and hand strength.
+		n := len(data) / 3
+		if n < 2 || n > 10 {
+			return
+		}
+		players := mkPlayers(n)
+		pm := NewPotManager(n)
+		top := 0
+		for i := 0; i < n; i++ {
+			if data[3*i] > data[3*top] {
+				top = i
+			}
+		}
+		for i := 0; i < n; i++ {
+			players[i].Balance = 0
+			players[i].HandValue = &HandValue{HandRank: pokerrpc.HandRank(data[3*i+2] % 3), RankValue: int(data[3*i+2] / 3 % 3)}
+			if bet := int64(data[3*i]); bet > 0 {
+				pm.AddBet(i, bet, players)
+			}
+		}
+		// Folds after the bets, as in a hand; whoever bet the most is still
+		// in, so every pot has someone to win it.
+		for i := 0; i < n; i++ {
+			if i != top && data[3*i+1]%2 == 1 {
+				players[i].stateMachine.Dispatch(playerStateFolded)
+			}
+		}
+		pm.RebuildPotsIncremental(players)
+		if err := pm.checkInvariants(players, false); err != nil {
+			t.Fatal(err)
+		}
+
+		total := pm.GetTotalPot()
+		if err := pm.DistributePots(players); err != nil {
+			t.Fatal(err)
+		}
+		var paid int64
+		for _, p := range players {
+			paid += p.Balance
+		}
+		if paid != total || pm.GetTotalPot() != 0 {
+			t.Fatalf("paid %d of pots %d, %d left", paid, total, pm.GetTotalPot())
+		}
+	})
+}
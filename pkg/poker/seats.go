@@ -0,0 +1,147 @@
+package poker
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var (
+	// ErrSeatTaken is returned when a player would sit in or reserve a seat
+	// that is occupied.
+	ErrSeatTaken = errors.New("seat is taken")
+	// ErrSeatReserved is returned when a player would sit in or reserve a
+	// seat held for another player.
+	ErrSeatReserved = errors.New("seat is reserved")
+	// ErrNoFreeSeat is returned when every seat is occupied or reserved.
+	ErrNoFreeSeat = errors.New("table is full")
+)
+
+// seatReservation is a seat held for a player until they sit down or it
+// expires.
+type seatReservation struct {
+	playerID string
+	expires  time.Time
+}
+
+// SeatReservation describes a held seat.
+type SeatReservation struct {
+	Seat     int
+	PlayerID string
+	Expires  time.Time
+}
+
+// seatErr returns why playerID cannot sit in seat, or nil when they can.
+// Assumes the lock is held.
+func (t *Table) seatErr(playerID string, seat int) error {
+	if seat < 0 || seat >= t.config.MaxPlayers {
+		return fmt.Errorf("seat %d does not exist: seats are numbered from 1 to %d", seat+1, t.config.MaxPlayers)
+	}
+	for _, u := range t.users {
+		if u.TableSeat == seat && u.ID != playerID {
+			return fmt.Errorf("%w: seat %d", ErrSeatTaken, seat+1)
+		}
+	}
+	if r, ok := t.reservations[seat]; ok && r.playerID != playerID && time.Now().Before(r.expires) {
+		return fmt.Errorf("%w: seat %d", ErrSeatReserved, seat+1)
+	}
+	return nil
+}
+
+// ReserveSeat holds seat for playerID for d, so that nobody else can take it
+// while they top up their balance to join. A player holds at most one seat:
+// a new reservation replaces their previous one. It returns when the
+// reservation expires.
+func (t *Table) ReserveSeat(playerID string, seat int, d time.Duration) (time.Time, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, seated := t.users[playerID]; seated {
+		return time.Time{}, fmt.Errorf("user already at table")
+	}
+	if err := t.seatErr(playerID, seat); err != nil {
+		return time.Time{}, err
+	}
+
+	t.releaseSeat(playerID)
+	if t.reservations == nil {
+		t.reservations = make(map[int]seatReservation)
+	}
+	expires := time.Now().Add(d)
+	t.reservations[seat] = seatReservation{playerID: playerID, expires: expires}
+	return expires, nil
+}
+
+// ReleaseSeat drops the reservation of playerID, if any, and returns whether
+// there was one.
+func (t *Table) ReleaseSeat(playerID string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.releaseSeat(playerID)
+}
+
+// releaseSeat is ReleaseSeat without acquiring the lock. Expired
+// reservations are dropped along the way.
+func (t *Table) releaseSeat(playerID string) bool {
+	now := time.Now()
+	released := false
+	for seat, r := range t.reservations {
+		switch {
+		case r.playerID == playerID:
+			delete(t.reservations, seat)
+			released = true
+		case !now.Before(r.expires):
+			delete(t.reservations, seat)
+		}
+	}
+	return released
+}
+
+// ReservedSeat returns the seat held for playerID and whether they hold one.
+func (t *Table) ReservedSeat(playerID string) (int, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	now := time.Now()
+	for seat, r := range t.reservations {
+		if r.playerID == playerID && now.Before(r.expires) {
+			return seat, true
+		}
+	}
+	return 0, false
+}
+
+// Reservations returns the seats currently held, ordered by seat.
+func (t *Table) Reservations() []SeatReservation {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	now := time.Now()
+	held := make([]SeatReservation, 0, len(t.reservations))
+	for seat, r := range t.reservations {
+		if now.Before(r.expires) {
+			held = append(held, SeatReservation{Seat: seat, PlayerID: r.playerID, Expires: r.expires})
+		}
+	}
+	sort.Slice(held, func(i, j int) bool { return held[i].Seat < held[j].Seat })
+	return held
+}
+
+// FreeSeat returns the seat playerID would sit in without choosing one: the
+// seat held for them, or else the lowest seat that is neither occupied nor
+// held for another player. It fails with ErrNoFreeSeat when there is none.
+func (t *Table) FreeSeat(playerID string) (int, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	now := time.Now()
+	for seat, r := range t.reservations {
+		if r.playerID == playerID && now.Before(r.expires) {
+			return seat, nil
+		}
+	}
+	for seat := 0; seat < t.config.MaxPlayers; seat++ {
+		if t.seatErr(playerID, seat) == nil {
+			return seat, nil
+		}
+	}
+	return 0, ErrNoFreeSeat
+}
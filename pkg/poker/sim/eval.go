@@ -0,0 +1,147 @@
+package sim
+
+import (
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+)
+
+// handScore ranks a five card hand: the category first, from 0 for a high
+// card to 8 for a straight flush, then the ranks that break ties, most
+// significant first. Greater scores are better hands.
+type handScore [6]int
+
+// Reference hand categories.
+const (
+	catHighCard = iota
+	catPair
+	catTwoPair
+	catTrips
+	catStraight
+	catFlush
+	catFullHouse
+	catQuads
+	catStraightFlush
+)
+
+// categoryRanks maps reference categories to the ranks of poker.HandValue.
+// Royal flushes are straight flushes ranked by their high card.
+var categoryRanks = [...]poker.HandRank{
+	catHighCard:      poker.HighCard,
+	catPair:          poker.Pair,
+	catTwoPair:       poker.TwoPair,
+	catTrips:         poker.ThreeOfAKind,
+	catStraight:      poker.Straight,
+	catFlush:         poker.Flush,
+	catFullHouse:     poker.FullHouse,
+	catQuads:         poker.FourOfAKind,
+	catStraightFlush: poker.StraightFlush,
+}
+
+// less reports whether s is a worse hand than o.
+func (s handScore) less(o handScore) bool {
+	for i := range s {
+		if s[i] != o[i] {
+			return s[i] < o[i]
+		}
+	}
+	return false
+}
+
+// rankOf returns the rank of a card, 2 through 14 for an ace.
+func rankOf(c poker.Card) int {
+	switch v := c.GetValue(); v {
+	case "A":
+		return 14
+	case "K":
+		return 13
+	case "Q":
+		return 12
+	case "J":
+		return 11
+	case "10":
+		return 10
+	default:
+		return int(v[0] - '0')
+	}
+}
+
+// bestScore scores the best five card hand out of cards, by brute force
+// over every combination. It is a deliberately naive evaluator to check the
+// game's against.
+func bestScore(cards []poker.Card) handScore {
+	var best handScore
+	var pick [5]poker.Card
+	first := true
+	var walk func(start, n int)
+	walk = func(start, n int) {
+		if n == 5 {
+			if s := score5(pick); first || best.less(s) {
+				best, first = s, false
+			}
+			return
+		}
+		for i := start; i <= len(cards)-(5-n); i++ {
+			pick[n] = cards[i]
+			walk(i+1, n+1)
+		}
+	}
+	walk(0, 0)
+	return best
+}
+
+// score5 scores exactly five cards.
+func score5(cards [5]poker.Card) handScore {
+	var counts [15]int
+	flush := true
+	for i, c := range cards {
+		counts[rankOf(c)]++
+		if i > 0 && c.GetSuit() != cards[0].GetSuit() {
+			flush = false
+		}
+	}
+
+	// Ranks ordered by how often they appear, then by rank.
+	var groups []int
+	for n := 4; n >= 1; n-- {
+		for r := 14; r >= 2; r-- {
+			if counts[r] == n {
+				groups = append(groups, r)
+			}
+		}
+	}
+
+	straightHigh := 0
+	if len(groups) == 5 {
+		switch {
+		case groups[0]-groups[4] == 4:
+			straightHigh = groups[0]
+		case groups[0] == 14 && groups[1] == 5: // The wheel, A-2-3-4-5
+			straightHigh = 5
+		}
+	}
+
+	var s handScore
+	switch {
+	case straightHigh > 0 && flush:
+		s[0], s[1] = catStraightFlush, straightHigh
+		return s
+	case counts[groups[0]] == 4:
+		s[0] = catQuads
+	case counts[groups[0]] == 3 && counts[groups[1]] == 2:
+		s[0] = catFullHouse
+	case flush:
+		s[0] = catFlush
+	case straightHigh > 0:
+		s[0], s[1] = catStraight, straightHigh
+		return s
+	case counts[groups[0]] == 3:
+		s[0] = catTrips
+	case counts[groups[0]] == 2 && counts[groups[1]] == 2:
+		s[0] = catTwoPair
+	case counts[groups[0]] == 2:
+		s[0] = catPair
+	default:
+		s[0] = catHighCard
+	}
+	copy(s[1:], groups)
+	return s
+}
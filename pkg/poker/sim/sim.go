@@ -0,0 +1,628 @@
+// Package sim plays poker hands headless, straight on poker.Table and
+// poker.Game without the server, gRPC or a database, to validate the pot
+// math and compare strategies over many hands.
+//
+// Every seed plays its hands on its own table, seats driven by the
+// strategies of package strategy, and the seeds are spread over all CPU
+// cores. After every action the simulator checks the invariants of the game
+// (see poker.Game.CheckInvariants) and that the hand moves through the
+// phases in order; at every showdown it checks the game's hand evaluator and
+// payouts against a naive reference. Fuzz does the same with random table
+// setups and random legal actions.
+package sim
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/decred/slog"
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"github.com/vctt94/pokerbisonrelay/pkg/strategy"
+)
+
+const (
+	// maxActionsPerHand bounds a hand; one still running after that many
+	// actions is reported as stuck.
+	maxActionsPerHand = 1000
+
+	// maxIssues is how many issues of each kind a Report keeps in full.
+	maxIssues = 20
+)
+
+// Config configures a simulation.
+type Config struct {
+	Seeds      []int64  // One table per seed
+	Strategies []string // Strategy of each seat, by strategy.New name
+	Hands      int      // Hands played per seed
+
+	SmallBlind    int64 // Default 10
+	BigBlind      int64 // Default 20
+	StartingChips int64 // Default 100 big blinds
+
+	Workers int // Tables played at once (0 = one per CPU)
+
+	// Fuzz plays every seed as Fuzz does, ignoring the seats, blinds and
+	// stacks above.
+	Fuzz bool
+}
+
+// Issue is a problem found while playing a hand.
+type Issue struct {
+	Seed int64
+	Hand int // Hand number of the seed, from 1
+	Msg  string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("seed %d hand %d: %s", i.Seed, i.Hand, i.Msg)
+}
+
+// Result is how one strategy fared.
+type Result struct {
+	Hands int64 // Hands dealt to a seat playing the strategy
+	Won   int64 // Hands a seat playing the strategy ended with more chips
+	Net   int64 // Chips won minus chips lost
+}
+
+// WinRate returns the share of its hands the strategy won chips in.
+func (r *Result) WinRate() float64 {
+	if r.Hands == 0 {
+		return 0
+	}
+	return float64(r.Won) / float64(r.Hands)
+}
+
+// Report sums up a simulation.
+type Report struct {
+	Hands    int64
+	Actions  int64
+	BigBlind int64
+
+	// Violations broke an invariant of the game: see
+	// poker.Game.CheckInvariants, plus a legal action rejected, phases out
+	// of order, a wrong payout or a stuck hand.
+	Violations     []Issue
+	ViolationCount int64
+	// Disagreements are showdowns where the game's hand evaluator ranked
+	// hands differently from the reference evaluator.
+	Disagreements     []Issue
+	DisagreementCount int64
+
+	Strategies map[string]*Result
+}
+
+// BBPer100 returns the big blinds a strategy won per 100 hands.
+func (r *Report) BBPer100(name string) float64 {
+	res := r.Strategies[name]
+	if res == nil || res.Hands == 0 || r.BigBlind == 0 {
+		return 0
+	}
+	return float64(res.Net) / float64(r.BigBlind) / float64(res.Hands) * 100
+}
+
+// String formats the report for people.
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d hands, %d actions\n", r.Hands, r.Actions)
+	fmt.Fprintf(&b, "%d invariant violations\n", r.ViolationCount)
+	for _, v := range r.Violations {
+		fmt.Fprintf(&b, "  %v\n", v)
+	}
+	fmt.Fprintf(&b, "%d evaluator disagreements\n", r.DisagreementCount)
+	for _, d := range r.Disagreements {
+		fmt.Fprintf(&b, "  %v\n", d)
+	}
+	names := make([]string, 0, len(r.Strategies))
+	for name := range r.Strategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		res := r.Strategies[name]
+		fmt.Fprintf(&b, "%-10s hands %d  won %.1f%%  net %d  %.2f bb/100\n",
+			name, res.Hands, res.WinRate()*100, res.Net, r.BBPer100(name))
+	}
+	return b.String()
+}
+
+func (r *Report) violation(seed int64, hand int, format string, args ...interface{}) {
+	r.ViolationCount++
+	if len(r.Violations) < maxIssues {
+		r.Violations = append(r.Violations, Issue{Seed: seed, Hand: hand, Msg: fmt.Sprintf(format, args...)})
+	}
+}
+
+func (r *Report) disagreement(seed int64, hand int, format string, args ...interface{}) {
+	r.DisagreementCount++
+	if len(r.Disagreements) < maxIssues {
+		r.Disagreements = append(r.Disagreements, Issue{Seed: seed, Hand: hand, Msg: fmt.Sprintf(format, args...)})
+	}
+}
+
+// merge adds o to r.
+func (r *Report) merge(o *Report) {
+	r.Hands += o.Hands
+	r.Actions += o.Actions
+	r.ViolationCount += o.ViolationCount
+	r.DisagreementCount += o.DisagreementCount
+	for _, v := range o.Violations {
+		if len(r.Violations) < maxIssues {
+			r.Violations = append(r.Violations, v)
+		}
+	}
+	for _, d := range o.Disagreements {
+		if len(r.Disagreements) < maxIssues {
+			r.Disagreements = append(r.Disagreements, d)
+		}
+	}
+	for name, res := range o.Strategies {
+		sum := r.Strategies[name]
+		if sum == nil {
+			sum = &Result{}
+			r.Strategies[name] = sum
+		}
+		sum.Hands += res.Hands
+		sum.Won += res.Won
+		sum.Net += res.Net
+	}
+}
+
+// Run plays cfg.Hands hands for each seed and reports what it found.
+func Run(cfg Config) (*Report, error) {
+	if len(cfg.Strategies) < 2 && !cfg.Fuzz {
+		return nil, errors.New("sim: at least two seats are needed")
+	}
+	for _, name := range cfg.Strategies {
+		if _, err := strategy.New(name, 1); err != nil {
+			return nil, fmt.Errorf("sim: %w", err)
+		}
+	}
+	if cfg.SmallBlind <= 0 {
+		cfg.SmallBlind = 10
+	}
+	if cfg.BigBlind <= 0 {
+		cfg.BigBlind = 2 * cfg.SmallBlind
+	}
+	if cfg.StartingChips <= 0 {
+		cfg.StartingChips = 100 * cfg.BigBlind
+	}
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	report := &Report{BigBlind: cfg.BigBlind, Strategies: make(map[string]*Result)}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	seeds := make(chan int64)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for seed := range seeds {
+				var r *Report
+				if cfg.Fuzz {
+					r = Fuzz(seed, cfg.Hands)
+				} else {
+					r = playSeed(cfg, seed)
+				}
+				mu.Lock()
+				report.merge(r)
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, seed := range cfg.Seeds {
+		seeds <- seed
+	}
+	close(seeds)
+	wg.Wait()
+	return report, nil
+}
+
+// Fuzz plays hands on a table whose seats, blinds and stacks are drawn from
+// seed, every seat taking random legal actions. Short stacks make all-ins
+// and side pots common.
+func Fuzz(seed int64, hands int) *Report {
+	rng := rand.New(rand.NewSource(seed))
+	seats := 2 + rng.Intn(8)
+	cfg := Config{
+		Strategies: make([]string, seats),
+		Hands:      hands,
+		SmallBlind: 1 + rng.Int63n(50),
+	}
+	for i := range cfg.Strategies {
+		cfg.Strategies[i] = "random"
+	}
+	cfg.BigBlind = cfg.SmallBlind * (1 + rng.Int63n(3))
+	cfg.StartingChips = cfg.BigBlind/2 + rng.Int63n(30*cfg.BigBlind)
+	return playSeed(cfg, seed)
+}
+
+// table is a seed's table and the strategies of its seats.
+type table struct {
+	cfg        Config
+	seed       int64
+	strategies []strategy.Strategy
+	report     *Report
+
+	t     *poker.Table
+	games int // Games started, to derive their shuffle seeds
+	hand  int // Hands played
+}
+
+// playSeed plays the hands of one seed.
+func playSeed(cfg Config, seed int64) *Report {
+	tb := &table{
+		cfg:    cfg,
+		seed:   seed,
+		report: &Report{BigBlind: cfg.BigBlind, Strategies: make(map[string]*Result)},
+	}
+	for i, name := range cfg.Strategies {
+		s, err := strategy.New(name, seed*int64(len(cfg.Strategies))+int64(i))
+		if err != nil {
+			panic(err) // Checked by Run
+		}
+		tb.strategies = append(tb.strategies, s)
+	}
+	for tb.hand < cfg.Hands {
+		tb.hand++
+		tb.playHand()
+	}
+	return tb.report
+}
+
+// seatID returns the player ID of a seat.
+func seatID(seat int) string {
+	return fmt.Sprintf("seat%d", seat)
+}
+
+// seatOf returns the seat of a player ID.
+func seatOf(id string) int {
+	var seat int
+	fmt.Sscanf(id, "seat%d", &seat)
+	return seat
+}
+
+// deal starts the next hand, on a new game once the last one ended.
+func (tb *table) deal() error {
+	if tb.t != nil && tb.t.GetGame() != nil {
+		return tb.t.StartNextHand()
+	}
+
+	tb.games++
+	gameSeed := tb.seed*1_000_003 + int64(tb.games)
+	if gameSeed == 0 {
+		gameSeed = 1 // Zero asks the game for a random seed
+	}
+	seats := len(tb.strategies)
+	tb.t = poker.NewTable(poker.TableConfig{
+		ID:            fmt.Sprintf("sim-%d-%d", tb.seed, tb.games),
+		Log:           slog.Disabled,
+		GameLog:       slog.Disabled,
+		HostID:        seatID(0),
+		MinPlayers:    2,
+		MaxPlayers:    seats,
+		SmallBlind:    tb.cfg.SmallBlind,
+		BigBlind:      tb.cfg.BigBlind,
+		StartingChips: tb.cfg.StartingChips,
+		Seed:          gameSeed,
+	})
+	for seat := 0; seat < seats; seat++ {
+		if _, err := tb.t.AddNewUser(seatID(seat), seatID(seat), 0, seat); err != nil {
+			return err
+		}
+		if err := tb.t.SetPlayerReady(seatID(seat), true); err != nil {
+			return err
+		}
+	}
+	if !tb.t.CheckAllPlayersReady() {
+		return errors.New("players not ready")
+	}
+	return tb.t.StartGame()
+}
+
+// playHand deals and plays one hand, recording what it finds. A hand that
+// breaks the game abandons its table.
+func (tb *table) playHand() {
+	defer func() {
+		if r := recover(); r != nil {
+			tb.report.violation(tb.seed, tb.hand, "panic: %v", r)
+			tb.t = nil
+		}
+	}()
+
+	if err := tb.deal(); err != nil {
+		tb.report.violation(tb.seed, tb.hand, "cannot deal: %v", err)
+		tb.t = nil
+		return
+	}
+	game := tb.t.GetGame()
+	if game == nil {
+		// All-in blinds ran the hand out and ended the game
+		tb.report.Hands++
+		return
+	}
+	players := game.GetPlayers()
+	if err := game.CheckInvariants(); err != nil {
+		tb.report.violation(tb.seed, tb.hand, "after the deal: %v", err)
+		tb.t = nil
+		return
+	}
+
+	phase := game.GetPhase()
+	var paid map[string]int64 // Chips each player put in, by the last action
+	for actions := 0; ; actions++ {
+		if tb.t.GetGame() == nil || game.GetPhase() == pokerrpc.GamePhase_SHOWDOWN {
+			break
+		}
+		if actions == maxActionsPerHand {
+			tb.report.violation(tb.seed, tb.hand, "hand not over after %d actions", actions)
+			tb.t = nil
+			return
+		}
+
+		cur := game.GetCurrentPlayerObject()
+		v := tb.view(game, cur)
+		action := v.Legal(tb.strategies[seatOf(cur.ID)].Decide(v))
+		paid = contributions(players)
+		paid[cur.ID] += cost(v, action)
+		if err := apply(tb.t, cur.ID, action); err != nil {
+			tb.report.violation(tb.seed, tb.hand, "%s %v rejected: %v", cur.ID, action, err)
+			tb.t = nil
+			return
+		}
+		tb.report.Actions++
+
+		if err := game.CheckInvariants(); err != nil {
+			tb.report.violation(tb.seed, tb.hand, "after %s %v: %v", cur.ID, action, err)
+			tb.t = nil
+			return
+		}
+		if next := game.GetPhase(); next != pokerrpc.GamePhase_SHOWDOWN {
+			if next < phase {
+				tb.report.violation(tb.seed, tb.hand, "phase went back from %v to %v", phase, next)
+			}
+			if want := boardSize[next]; len(game.GetCommunityCards()) != want {
+				tb.report.violation(tb.seed, tb.hand, "%d board cards in %v", len(game.GetCommunityCards()), next)
+			}
+			phase = next
+		}
+	}
+
+	tb.settle(game, players, paid)
+}
+
+// boardSize is how many community cards each betting phase shows.
+var boardSize = map[pokerrpc.GamePhase]int{
+	pokerrpc.GamePhase_PRE_FLOP: 0,
+	pokerrpc.GamePhase_FLOP:     3,
+	pokerrpc.GamePhase_TURN:     4,
+	pokerrpc.GamePhase_RIVER:    5,
+}
+
+// contributions returns the chips each player put in the pot so far.
+func contributions(players []*poker.Player) map[string]int64 {
+	paid := make(map[string]int64, len(players))
+	for _, p := range players {
+		paid[p.ID] = p.StartingBalance - p.Balance
+	}
+	return paid
+}
+
+// cost returns the chips an action puts in the pot.
+func cost(v strategy.View, a strategy.Action) int64 {
+	self := v.Self()
+	var chips int64
+	switch a.Kind {
+	case strategy.Call:
+		chips = v.Update.CurrentBet - self.CurrentBet
+	case strategy.Bet:
+		chips = a.Amount - self.CurrentBet
+	}
+	if chips > self.Balance {
+		chips = self.Balance
+	}
+	return chips
+}
+
+// view shows the game to the player to act as the server would.
+func (tb *table) view(game *poker.Game, cur *poker.Player) strategy.View {
+	players := game.GetPlayers()
+	update := &pokerrpc.GameUpdate{
+		Phase:          game.GetPhase(),
+		Players:        make([]*pokerrpc.Player, 0, len(players)),
+		CommunityCards: poker.CreateHandFromCards(game.GetCommunityCards()),
+		Pot:            game.GetPot(),
+		CurrentBet:     game.GetCurrentBet(),
+		CurrentPlayer:  cur.ID,
+		GameStarted:    true,
+	}
+	for _, p := range players {
+		rp := &pokerrpc.Player{
+			Id:         p.ID,
+			Balance:    p.Balance,
+			CurrentBet: p.HasBet,
+			Folded:     p.GetCurrentStateString() == "FOLDED",
+			IsAllIn:    p.GetCurrentStateString() == "ALL_IN",
+			IsTurn:     p == cur,
+		}
+		if p == cur {
+			rp.Hand = poker.CreateHandFromCards(p.Hand)
+		}
+		update.Players = append(update.Players, rp)
+	}
+	return strategy.View{Update: update, PlayerID: cur.ID, BigBlind: tb.cfg.BigBlind}
+}
+
+// apply takes action for playerID at the table.
+func apply(t *poker.Table, playerID string, a strategy.Action) error {
+	switch a.Kind {
+	case strategy.Fold:
+		return t.HandleFold(playerID)
+	case strategy.Check:
+		return t.HandleCheck(playerID)
+	case strategy.Call:
+		return t.HandleCall(playerID)
+	case strategy.Bet:
+		return t.MakeBet(playerID, a.Amount)
+	}
+	return fmt.Errorf("unknown action %v", a)
+}
+
+// settle checks the showdown of a finished hand against the reference
+// evaluator and payouts, and credits the strategies.
+func (tb *table) settle(game *poker.Game, players []*poker.Player, paid map[string]int64) {
+	tb.report.Hands++
+
+	var total int64
+	for _, p := range players {
+		total += p.Balance
+		net := p.Balance - p.StartingBalance
+		res := tb.report.Strategies[tb.cfg.Strategies[seatOf(p.ID)]]
+		if res == nil {
+			res = &Result{}
+			tb.report.Strategies[tb.cfg.Strategies[seatOf(p.ID)]] = res
+		}
+		res.Hands++
+		res.Net += net
+		if net > 0 {
+			res.Won++
+		}
+	}
+	if pot := game.GetPot(); pot != 0 {
+		tb.report.violation(tb.seed, tb.hand, "%d chips left in the pot after the hand", pot)
+	}
+
+	var alive []*poker.Player
+	for _, p := range players {
+		if p.GetCurrentStateString() != "FOLDED" {
+			alive = append(alive, p)
+		}
+	}
+	board := game.GetCommunityCards()
+	scores := make(map[string]handScore, len(alive))
+	if len(alive) > 1 {
+		if len(board) != 5 {
+			tb.report.violation(tb.seed, tb.hand, "showdown with %d board cards", len(board))
+			return
+		}
+		for _, p := range alive {
+			scores[p.ID] = bestScore(append(append([]poker.Card{}, p.Hand...), board...))
+		}
+		tb.compareEvaluators(alive, board, scores)
+	}
+	if paid == nil {
+		return // Nobody acted: the blinds settled the hand
+	}
+	tb.comparePayouts(players, paid, scores)
+}
+
+// compareEvaluators reports where the game ranks the showdown hands
+// differently from the reference evaluator.
+func (tb *table) compareEvaluators(alive []*poker.Player, board []poker.Card, scores map[string]handScore) {
+	for i, a := range alive {
+		if a.HandValue == nil {
+			tb.report.disagreement(tb.seed, tb.hand, "%s %v on %v was not evaluated", a.ID, a.Hand, board)
+			return
+		}
+		rank := a.HandValue.Rank
+		if rank == poker.RoyalFlush {
+			rank = poker.StraightFlush
+		}
+		if want := categoryRanks[scores[a.ID][0]]; rank != want {
+			tb.report.disagreement(tb.seed, tb.hand, "%v on %v: game says rank %d, reference %d", a.Hand, board, rank, want)
+		}
+		for _, b := range alive[i+1:] {
+			if b.HandValue == nil {
+				continue
+			}
+			got := poker.CompareHands(*a.HandValue, *b.HandValue)
+			want := 0
+			switch {
+			case scores[b.ID].less(scores[a.ID]):
+				want = 1
+			case scores[a.ID].less(scores[b.ID]):
+				want = -1
+			}
+			if got != want {
+				tb.report.disagreement(tb.seed, tb.hand, "%v vs %v on %v: game says %d, reference %d",
+					a.Hand, b.Hand, board, got, want)
+			}
+		}
+	}
+}
+
+// comparePayouts reports stacks that differ from a reference settlement:
+// the pot is split in layers at every player's total contribution, each
+// layer going to the best reference hands among the players still in who
+// reached it, the odd chip to the first of them by seat.
+func (tb *table) comparePayouts(players []*poker.Player, paid map[string]int64, scores map[string]handScore) {
+	levels := make([]int64, 0, len(players))
+	for _, c := range paid {
+		if c > 0 {
+			levels = append(levels, c)
+		}
+	}
+	sort.Slice(levels, func(i, j int) bool { return levels[i] < levels[j] })
+
+	want := make(map[string]int64, len(players))
+	for _, p := range players {
+		want[p.ID] = p.StartingBalance - paid[p.ID]
+	}
+	var prev int64
+	for _, lvl := range levels {
+		if lvl == prev {
+			continue
+		}
+		var amount int64
+		var eligible []*poker.Player
+		for _, p := range players {
+			c := paid[p.ID]
+			if c > prev {
+				amount += min(c, lvl) - prev
+			}
+			if c >= lvl && p.GetCurrentStateString() != "FOLDED" {
+				eligible = append(eligible, p)
+			}
+		}
+		prev = lvl
+		if len(eligible) == 0 {
+			tb.report.violation(tb.seed, tb.hand, "%d chips nobody still in can win", amount)
+			continue
+		}
+
+		winners := eligible[:1]
+		if len(eligible) > 1 {
+			winners = nil
+			var best handScore
+			for _, p := range eligible {
+				switch s := scores[p.ID]; {
+				case winners == nil || best.less(s):
+					best, winners = s, []*poker.Player{p}
+				case !s.less(best):
+					winners = append(winners, p)
+				}
+			}
+		}
+		share := amount / int64(len(winners))
+		for i, w := range winners {
+			want[w.ID] += share
+			if i == 0 {
+				want[w.ID] += amount % int64(len(winners))
+			}
+		}
+	}
+
+	for _, p := range players {
+		if p.Balance != want[p.ID] {
+			tb.report.violation(tb.seed, tb.hand, "%s ends with %d chips, reference payout %d", p.ID, p.Balance, want[p.ID])
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+)
+
+func TestRun(t *testing.T) {
+	report, err := Run(Config{
+		Seeds:      []int64{1, 2, 3, 4},
+		Strategies: []string{"tag", "random", "random"},
+		Hands:      50,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), report.Hands)
+	assert.NotZero(t, report.Actions)
+	assert.Zero(t, report.ViolationCount, "%v", report)
+	assert.Zero(t, report.DisagreementCount, "%v", report)
+
+	// Money only changes hands.
+	var net int64
+	for _, name := range []string{"tag", "random"} {
+		require.Contains(t, report.Strategies, name)
+		net += report.Strategies[name].Net
+	}
+	assert.Zero(t, net)
+	// Busted seats sit out the rest of their game.
+	assert.LessOrEqual(t, report.Strategies["tag"].Hands, int64(200))
+	assert.LessOrEqual(t, report.Strategies["random"].Hands, int64(400))
+	assert.Positive(t, report.Strategies["tag"].Hands)
+}
+
+func TestRunConfig(t *testing.T) {
+	_, err := Run(Config{Strategies: []string{"tag"}})
+	assert.Error(t, err)
+	_, err = Run(Config{Strategies: []string{"tag", "nosuch"}})
+	assert.Error(t, err)
+}
+
+func TestBestScore(t *testing.T) {
+	tests := []struct {
+		name        string
+		a, b        string
+		aWins, tied bool
+	}{
+		{"wheel loses to six high straight", "As2d3c4h5s9dKc", "6s2d3c4h5s9dKc", false, false},
+		{"flush beats straight", "2h5h9hJhKh3c4d", "9c8dTs7hJd2c3c", true, false},
+		{"kicker", "AsAdKc7h5s3d2c", "AhAcQc7h5s3d2c", true, false},
+		{"board plays", "2c3dAsKsQsJsTs", "4c5dAsKsQsJsTs", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := bestScore(parseCards(t, tt.a)), bestScore(parseCards(t, tt.b))
+			assert.Equal(t, tt.aWins, b.less(a))
+			assert.Equal(t, tt.tied, !a.less(b) && !b.less(a))
+		})
+	}
+}
+
+// parseCards parses cards written like "AsTd2c".
+func parseCards(t *testing.T, s string) []poker.Card {
+	t.Helper()
+	suits := map[byte]poker.Suit{'s': poker.Spades, 'h': poker.Hearts, 'd': poker.Diamonds, 'c': poker.Clubs}
+	var cards []poker.Card
+	for i := 0; i+1 < len(s); i += 2 {
+		value := poker.Value(s[i : i+1])
+		if value == "T" {
+			value = poker.Ten
+		}
+		suit, ok := suits[s[i+1]]
+		require.True(t, ok, "bad suit in %q", s)
+		cards = append(cards, poker.NewCardFromSuitValue(suit, value))
+	}
+	return cards
+}
+
+func FuzzStateMachine(f *testing.F) {
+	for seed := int64(0); seed < 8; seed++ {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		report := Fuzz(seed, 20)
+		if report.ViolationCount > 0 || report.DisagreementCount > 0 {
+			t.Fatalf("seed %d:\n%v", seed, report)
+		}
+	})
+}
@@ -0,0 +1,180 @@
+package poker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// DefaultSitOutAfterTimeouts is how many actions in a row a player may miss
+// before the table sits them out, when TableConfig.SitOutAfterTimeouts is
+// zero.
+const DefaultSitOutAfterTimeouts = 2
+
+var (
+	// ErrNotEnoughSittingIn is returned when a new game or hand would start
+	// with fewer than two players sitting in.
+	ErrNotEnoughSittingIn = errors.New("not enough players sitting in")
+	// ErrAlreadySittingOut is returned when a player sitting out would sit
+	// out.
+	ErrAlreadySittingOut = errors.New("already sitting out")
+	// ErrNotSittingOut is returned when a player sitting in would sit in.
+	ErrNotSittingOut = errors.New("not sitting out")
+)
+
+// SitOutEvent is the payload of the PLAYER_SAT_OUT and PLAYER_SAT_IN table
+// events.
+type SitOutEvent struct {
+	PlayerID string
+	// Set when the table sat the player out for missing actions
+	Auto bool
+}
+
+// SitOut keeps a player's seat but leaves them out of the following hands:
+// they are not dealt in and post no blinds. A hand they are playing goes on,
+// with their turns checked or folded right away.
+func (t *Table) SitOut(userID string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	u := t.users[userID]
+	if u == nil {
+		return fmt.Errorf("user not at table")
+	}
+	if u.SittingOut {
+		return ErrAlreadySittingOut
+	}
+	t.sitOut(u, false)
+	if p := t.turnPlayer(); p != nil && p.ID == userID {
+		t.startTurn()
+	}
+	return nil
+}
+
+// sitOut sits a user out, assuming the lock is held.
+func (t *Table) sitOut(u *User, auto bool) {
+	u.SittingOut = true
+	u.SatOutAt = time.Now()
+	if p := t.gamePlayer(u.ID); p != nil {
+		p.SittingOut = true
+	}
+	t.log.Debugf("Player %s sat out (auto=%v)", u.ID, auto)
+	t.PublishEvent(pokerrpc.NotificationType_PLAYER_SAT_OUT, t.config.ID, SitOutEvent{PlayerID: u.ID, Auto: auto})
+}
+
+// SitIn deals a player who sat out back in from the next hand. A game that
+// stalled for want of players sitting in starts its next hand after the
+// auto-start delay.
+func (t *Table) SitIn(userID string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	u := t.users[userID]
+	if u == nil {
+		return fmt.Errorf("user not at table")
+	}
+	if !u.SittingOut {
+		return ErrNotSittingOut
+	}
+	u.SittingOut = false
+	u.SatOutAt = time.Time{}
+	u.MissedActions = 0
+	if p := t.gamePlayer(userID); p != nil {
+		p.SittingOut = false
+	}
+	t.log.Debugf("Player %s sat in", userID)
+	t.PublishEvent(pokerrpc.NotificationType_PLAYER_SAT_IN, t.config.ID, SitOutEvent{PlayerID: userID})
+
+	if t.game != nil && t.game.phase == pokerrpc.GamePhase_SHOWDOWN &&
+		t.config.AutoStartDelay > 0 && t.holdErr() == nil && t.sittingIn() >= 2 {
+		t.game.ScheduleAutoStart()
+	}
+	return nil
+}
+
+// ExpiredSitOuts returns the players who have sat out for longer than
+// TableConfig.SitOutLimit. The host is never among them.
+func (t *Table) ExpiredSitOuts() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.config.SitOutLimit <= 0 {
+		return nil
+	}
+	var expired []string
+	for _, u := range t.users {
+		if u.SittingOut && u.ID != t.config.HostID && time.Since(u.SatOutAt) >= t.config.SitOutLimit {
+			expired = append(expired, u.ID)
+		}
+	}
+	return expired
+}
+
+// sittingIn returns how many users are sitting in. Assumes the lock is held.
+func (t *Table) sittingIn() int {
+	n := 0
+	for _, u := range t.users {
+		if !u.SittingOut {
+			n++
+		}
+	}
+	return n
+}
+
+// sitOutAfter returns how many actions in a row a player may miss before
+// they are sat out.
+func (t *Table) sitOutAfter() int {
+	if t.config.SitOutAfterTimeouts > 0 {
+		return t.config.SitOutAfterTimeouts
+	}
+	return DefaultSitOutAfterTimeouts
+}
+
+// acted records that a user acted on their own, resetting their count of
+// missed actions. Assumes the lock is held.
+func (t *Table) acted(userID string) {
+	if u := t.users[userID]; u != nil {
+		u.MissedActions = 0
+	}
+}
+
+// gamePlayer returns the game player of a user, or nil. Assumes the lock is
+// held.
+func (t *Table) gamePlayer(userID string) *Player {
+	if t.game == nil {
+		return nil
+	}
+	for _, p := range t.game.players {
+		if p.ID == userID {
+			return p
+		}
+	}
+	return nil
+}
+
+// dealOut folds the players sitting out before the cards of a new hand are
+// dealt and moves the button off them. It returns the users dealt in.
+// Assumes the lock is held.
+func (t *Table) dealOut(users []*User) []*User {
+	dealtIn := make([]*User, 0, len(users))
+	for _, u := range users {
+		if !u.SittingOut {
+			dealtIn = append(dealtIn, u)
+		}
+	}
+	for _, p := range t.game.players {
+		u := t.users[p.ID]
+		p.SittingOut = u != nil && u.SittingOut
+		p.dealtOut = p.SittingOut
+		if p.dealtOut {
+			p.stateMachine.Dispatch(playerStateFolded)
+		}
+	}
+	if t.game.dealer >= 0 && t.game.dealer < len(t.game.players) && t.game.players[t.game.dealer].dealtOut {
+		t.game.dealer = t.game.nextDealtIn(t.game.dealer)
+		t.game.markDealer()
+	}
+	return dealtIn
+}
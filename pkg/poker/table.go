@@ -18,6 +18,11 @@ type TableEvent struct {
 	Type    pokerrpc.NotificationType
 	TableID string
 	Payload interface{}
+
+	// Set for events that settle a hand: its number and the chips of each
+	// player once settled.
+	Hand   int
+	Stacks map[string]int64
 }
 
 // TableStateFn represents a table state function following Rob Pike's pattern
@@ -32,6 +37,11 @@ type User struct {
 	IsReady           bool  // Ready to start/continue games
 	JoinedAt          time.Time
 	IsDisconnected    bool // Whether the user is disconnected
+	SittingOut        bool // Keeps the seat but is not dealt in
+	SatOutAt          time.Time
+	MissedActions     int // Turns timed out in a row
+	// Extra time held in reserve, drawn on with UseTimeBank
+	TimeBank time.Duration
 }
 
 // NewUser creates a new user
@@ -55,12 +65,30 @@ type TableConfig struct {
 	BuyIn          int64 // DCR amount required to join table (in atoms)
 	MinPlayers     int
 	MaxPlayers     int
-	SmallBlind     int64 // Poker chips amount for small blind
-	BigBlind       int64 // Poker chips amount for big blind
-	MinBalance     int64 // Minimum DCR account balance required (in atoms)
-	StartingChips  int64 // Poker chips each player starts with in the game
-	TimeBank       time.Duration
+	SmallBlind     int64         // Poker chips amount for small blind
+	BigBlind       int64         // Poker chips amount for big blind
+	MinBalance     int64         // Minimum DCR account balance required (in atoms)
+	StartingChips  int64         // Poker chips each player starts with in the game
+	TimeBank       time.Duration // Base time of every action, restarted on each turn
 	AutoStartDelay time.Duration // Delay before automatically starting next hand after showdown
+	Seed           int64         // Optional seed of the shuffles, for deterministic games
+	// Missed actions in a row after which a player is sat out; zero means
+	// DefaultSitOutAfterTimeouts
+	SitOutAfterTimeouts int
+	// How long a player may sit out before being removed; zero keeps them
+	SitOutLimit time.Duration
+	// Most time a player holds in their time bank and how much of it comes
+	// back every hand; zero means DefaultTimeBankReserve and
+	// DefaultTimeBankRefill
+	TimeBankReserve time.Duration
+	TimeBankRefill  time.Duration
+	// Most spectators watching the table at once, zero for no limit, and
+	// how far behind the game their view runs; zero means the server default
+	MaxSpectators  int
+	SpectatorDelay time.Duration
+	// Debug mode: check the game invariants after every action, logging
+	// violations
+	CheckInvariants bool
 }
 
 // TableEventManager handles notifications and state updates for table events
@@ -75,13 +103,13 @@ func (tem *TableEventManager) SetEventChannel(eventChannel chan<- TableEvent) {
 
 // PublishEvent publishes an event to the channel (non-blocking)
 func (tem *TableEventManager) PublishEvent(eventType pokerrpc.NotificationType, tableID string, payload interface{}) {
+	tem.publish(TableEvent{Type: eventType, TableID: tableID, Payload: payload})
+}
+
+func (tem *TableEventManager) publish(event TableEvent) {
 	if tem.eventChannel != nil {
 		select {
-		case tem.eventChannel <- TableEvent{
-			Type:    eventType,
-			TableID: tableID,
-			Payload: payload,
-		}:
+		case tem.eventChannel <- event:
 		default:
 			// Channel is full or closed, event is dropped
 			// In production, you might want to log this
@@ -118,6 +146,24 @@ type Table struct {
 	// Idempotency guard: track which hand (by game round) has been resolved
 	resolvedRound int
 
+	// Host moderation state
+	paused     bool             // Player actions, timebanks and auto-start are frozen
+	closing    bool             // No new hand starts; the table closes once the current hand ends
+	finalChips map[string]int64 // Chip counts of the last game, kept after it ends
+
+	// Set while the server drains for a restart: the current hand plays out
+	// and no new one starts
+	draining bool
+
+	// Seats held for players who have not sat down yet, by seat
+	reservations map[int]seatReservation
+
+	// Action clock of the player to act
+	turn        uint64        // Counts turns, so a stale timer knows it fired late
+	deadline    time.Time     // When the table acts for them; zero when off the clock
+	clockLeft   time.Duration // Time they had left when the table was paused
+	actionTimer *time.Timer
+
 	// State machine - Rob Pike's pattern
 	stateMachine *statemachine.StateMachine[Table]
 }
@@ -213,6 +259,10 @@ func (t *Table) StartGame() error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	if err := t.holdErr(); err != nil {
+		return err
+	}
+
 	// Check if we're in the right state
 	if t.GetTableStateString() != "PLAYERS_READY" {
 		return fmt.Errorf("cannot start game: table not in PLAYERS_READY state")
@@ -227,6 +277,9 @@ func (t *Table) StartGame() error {
 	if len(t.users) < t.config.MinPlayers {
 		return fmt.Errorf("not enough players to start game")
 	}
+	if t.sittingIn() < 2 {
+		return ErrNotEnoughSittingIn
+	}
 
 	// Reset all players for the new hand
 	activePlayers := make([]*User, 0, len(t.users))
@@ -252,12 +305,14 @@ func (t *Table) StartGame() error {
 		SmallBlind:     t.config.SmallBlind,
 		BigBlind:       t.config.BigBlind,
 		AutoStartDelay: t.config.AutoStartDelay,
+		Seed:           t.config.Seed,
 		Log:            gameLog,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create game: %w", err)
 	}
 	t.game = g
+	t.finalChips = nil
 
 	// Set up auto-start callbacks
 	t.game.SetAutoStartCallbacks(&AutoStartCallbacks{
@@ -282,6 +337,10 @@ func (t *Table) StartGame() error {
 	// Transition to game active state with broadcast callback
 	t.stateMachine.Dispatch(tableStateGameActive)
 	t.lastAction = time.Now()
+
+	// All-in blinds can leave nobody to act, running the hand out to showdown
+	t.MaybeAdvancePhase()
+	t.startTurn()
 	return nil
 }
 
@@ -332,9 +391,19 @@ func (t *Table) handleShowdown() error {
 	tableID := t.config.ID
 	amount := t.lastShowdown.TotalPot
 
-	t.PublishEvent(pokerrpc.NotificationType_SHOWDOWN_RESULT, tableID, &pokerrpc.Showdown{
-		Winners: t.lastShowdown.WinnerInfo,
-		Pot:     amount,
+	stacks := make(map[string]int64, len(t.game.players))
+	for _, p := range t.game.players {
+		stacks[p.ID] = p.Balance
+	}
+	t.eventManager.publish(TableEvent{
+		Type:    pokerrpc.NotificationType_SHOWDOWN_RESULT,
+		TableID: tableID,
+		Payload: &pokerrpc.Showdown{
+			Winners: t.lastShowdown.WinnerInfo,
+			Pot:     amount,
+		},
+		Hand:   currentRound,
+		Stacks: stacks,
 	})
 
 	// Remove busted players (0 chips) and count remaining players
@@ -359,7 +428,7 @@ func (t *Table) handleShowdown() error {
 	// This ensures all players (including losing ones) get notified
 	if t.shouldGameEnd() {
 		t.log.Infof("Game should end, calling endGame()")
-		t.endGame()
+		t.endGame("Not enough players remaining")
 		return nil
 	}
 
@@ -379,7 +448,7 @@ func (t *Table) handleShowdown() error {
 	}
 
 	// Schedule auto-start of the next hand strictly after showdown resolution
-	if t.config.AutoStartDelay > 0 {
+	if t.config.AutoStartDelay > 0 && t.holdErr() == nil {
 		t.log.Debugf("Scheduling auto-start for new hand with delay %v", t.config.AutoStartDelay)
 		// Provide callbacks if not already set
 		if t.game.autoStartCallbacks == nil {
@@ -451,11 +520,18 @@ func (t *Table) shouldGameEnd() bool {
 }
 
 // endGame ends the current game and transitions to WAITING_FOR_PLAYERS state
-func (t *Table) endGame() {
-	t.log.Infof("Ending game - not enough players remaining")
+func (t *Table) endGame(reason string) {
+	t.log.Infof("Ending game: %s", reason)
+
+	// Keep the chip counts so players can still be cashed out
+	t.finalChips = make(map[string]int64, len(t.game.players))
+	for _, p := range t.game.players {
+		t.finalChips[p.ID] = p.Balance
+	}
 
 	// Clear the game
 	t.game = nil
+	t.startTurn()
 
 	// Reset all players to not ready
 	for _, u := range t.users {
@@ -467,7 +543,7 @@ func (t *Table) endGame() {
 
 	// Publish game ended event
 	t.PublishEvent(pokerrpc.NotificationType_GAME_ENDED, t.config.ID, map[string]interface{}{
-		"reason": "Not enough players remaining",
+		"reason": reason,
 	})
 
 	t.log.Infof("Game ended, table back to WAITING_FOR_PLAYERS state")
@@ -480,6 +556,9 @@ func (t *Table) startNewHand() error {
 	// This prevents clients from observing partially-initialized new-hand state.
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	if err := t.holdErr(); err != nil {
+		return err
+	}
 	// Ensure game exists - if not, this is a bug
 	if t.game == nil {
 		return fmt.Errorf("startNewHand called but game is nil - this should not happen")
@@ -497,6 +576,9 @@ func (t *Table) startNewHand() error {
 	if playersAtTable < minRequired {
 		return fmt.Errorf("not enough players to start new hand: %d < %d", playersAtTable, minRequired)
 	}
+	if t.sittingIn() < 2 {
+		return ErrNotEnoughSittingIn
+	}
 
 	// Get active users for the new hand - include all users (they will play all-in if needed)
 	// (folded players will be reset for the new hand)
@@ -550,6 +632,8 @@ func (t *Table) startNewHand() error {
 			newPlayer.IsReady = user.IsReady
 			activePlayers = append(activePlayers, newPlayer)
 		}
+		// The button skips players sitting out
+		activePlayers[len(activePlayers)-1].dealtOut = user.SittingOut
 	}
 
 	// Update the game with the reused/reset players
@@ -569,9 +653,28 @@ func (t *Table) startNewHand() error {
 	t.stateMachine.Dispatch(tableStateGameActive)
 
 	t.lastAction = time.Now()
+
+	// All-in blinds can leave nobody to act, running the hand out to showdown
+	t.MaybeAdvancePhase()
+	t.startTurn()
 	return nil
 }
 
+// SetCheckInvariants turns the debug mode on or off in which the table
+// checks the game invariants after every action.
+func (t *Table) SetCheckInvariants(on bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.config.CheckInvariants = on
+}
+
+// StartNextHand starts the next hand of the running game right away, for
+// callers that drive the table themselves rather than through
+// AutoStartDelay.
+func (t *Table) StartNextHand() error {
+	return t.startNewHand()
+}
+
 // setupNewHand handles the complete setup process for a new hand (assumes lock is held)
 func (t *Table) setupNewHand(activePlayers []*User) error {
 	if t.game == nil {
@@ -588,9 +691,12 @@ func (t *Table) setupNewHand(activePlayers []*User) error {
 	t.game.phase = pokerrpc.GamePhase_NEW_HAND_DEALING
 	t.log.Debugf("setupNewHand: Phase 1 - Set to NEW_HAND_DEALING, setup in progress")
 
+	// Players sitting out keep their seat but sit the hand out
+	dealtIn := t.dealOut(activePlayers)
+
 	// Phase 2: Deal cards and post blinds (the actual setup work)
-	t.log.Debugf("setupNewHand: Phase 2 - Dealing cards to %d players", len(activePlayers))
-	err := t.dealCardsToPlayers(activePlayers)
+	t.log.Debugf("setupNewHand: Phase 2 - Dealing cards to %d players", len(dealtIn))
+	err := t.dealCardsToPlayers(dealtIn)
 	if err != nil {
 		return fmt.Errorf("failed to deal cards: %v", err)
 	}
@@ -608,13 +714,15 @@ func (t *Table) setupNewHand(activePlayers []*User) error {
 	t.initializeCurrentPlayer()
 	t.log.Debugf("setupNewHand: Current player set to %s (index %d)", t.currentPlayerID(), t.game.currentPlayer)
 
-	// Start the timeout clock for the first current player
 	if t.game.currentPlayer >= 0 && t.game.currentPlayer < len(t.game.players) {
 		if t.game.players[t.game.currentPlayer].GetCurrentStateString() != "FOLDED" {
 			t.game.players[t.game.currentPlayer].LastAction = time.Now()
 		}
 	}
 
+	// Players dealt in get back some of their time bank
+	t.refillTimeBanks(dealtIn)
+
 	// NO BROADCAST HERE - will be done by caller after state transition
 	return nil
 }
@@ -673,6 +781,9 @@ func (t *Table) MakeBet(userID string, amount int64) error {
 	if user == nil {
 		return fmt.Errorf("user not found")
 	}
+	if t.paused {
+		return ErrTablePaused
+	}
 
 	// Validate that it's this player's turn to act
 	if t.isGameActive() && t.game != nil {
@@ -688,9 +799,11 @@ func (t *Table) MakeBet(userID string, amount int64) error {
 		if err != nil {
 			return err
 		}
+		t.acted(userID)
 
 		// Check if this action completes the betting round
 		t.MaybeAdvancePhase()
+		t.startTurn()
 	}
 
 	t.lastAction = time.Now()
@@ -729,66 +842,6 @@ func (t *Table) GetGamePhase() pokerrpc.GamePhase {
 	return t.game.GetPhase()
 }
 
-// HandleTimeouts iterates over players and auto-checks-or-folds those whose timebank expired.
-func (t *Table) HandleTimeouts() {
-	// Only run when game is active and TimeBank is positive
-	if !t.isGameActive() || t.config.TimeBank == 0 || t.game == nil {
-		return
-	}
-
-	now := time.Now()
-
-	t.mu.Lock()
-	defer t.mu.Unlock()
-
-	// Only timeout the current player
-	currentPlayerID := ""
-	if t.game.currentPlayer >= 0 && t.game.currentPlayer < len(t.game.players) {
-		currentPlayerID = t.game.players[t.game.currentPlayer].ID
-	}
-
-	if currentPlayerID == "" {
-		return // No current player to timeout
-	}
-
-	// The current player is already in our unified player state
-	currentPlayer := t.game.players[t.game.currentPlayer]
-	if currentPlayer.GetCurrentStateString() == "FOLDED" || currentPlayer.GetCurrentStateString() == "ALL_IN" {
-		return
-	}
-
-	// Check if current player has timed out
-	if now.Sub(currentPlayer.LastAction) > t.config.TimeBank {
-		// Try to auto-check first, if not possible then auto-fold
-		currentBet := t.game.currentBet
-
-		// A check is valid if the player's current bet equals the current bet
-		// (meaning they don't need to put any additional money in)
-		if currentPlayer.HasBet == currentBet {
-			// Auto-check: essentially a bet of the current amount
-			// This doesn't change the bet amounts but advances the action
-			currentPlayer.LastAction = now
-
-			// Increment actions counter for this betting round
-			t.game.IncrementActionsInRound()
-
-			// Advance to next player after check action
-			t.advanceToNextPlayer()
-		} else {
-			// Auto-fold the current player - they cannot check because they need to call/raise
-			// This covers the case where currentPlayer.HasBet < currentBet (player needs to call)
-			currentPlayer.stateMachine.Dispatch(playerStateFolded)
-			currentPlayer.LastAction = now
-
-			// Advance to next player
-			t.advanceToNextPlayer()
-		}
-
-		// Check if this action completes the betting round
-		t.MaybeAdvancePhase()
-	}
-}
-
 // MaybeAdvancePhase delegates to Game layer for phase advancement logic
 func (t *Table) MaybeAdvancePhase() {
 	if !t.isGameActive() || t.game == nil {
@@ -805,6 +858,11 @@ func (t *Table) MaybeAdvancePhase() {
 		t.handleShowdown()
 	}
 
+	if t.config.CheckInvariants && t.game != nil {
+		if err := t.game.checkInvariants(); err != nil {
+			t.log.Errorf("table %s: hand %d broke invariants: %v", t.config.ID, t.game.round, err)
+		}
+	}
 }
 
 // GetGame returns the current game (can be nil)
@@ -877,6 +935,9 @@ func (t *Table) HandleFold(userID string) error {
 	if user == nil {
 		return fmt.Errorf("user not found")
 	}
+	if t.paused {
+		return ErrTablePaused
+	}
 
 	// Validate that it's this player's turn to act
 	if t.isGameActive() && t.game != nil {
@@ -892,9 +953,11 @@ func (t *Table) HandleFold(userID string) error {
 		if err != nil {
 			return err
 		}
+		t.acted(userID)
 
 		// Check if this action completes the betting round
 		t.MaybeAdvancePhase()
+		t.startTurn()
 	}
 
 	t.lastAction = time.Now()
@@ -910,6 +973,9 @@ func (t *Table) HandleCall(userID string) error {
 	if user == nil {
 		return fmt.Errorf("user not found")
 	}
+	if t.paused {
+		return ErrTablePaused
+	}
 
 	// Validate that it's this player's turn to act
 	if t.isGameActive() && t.game != nil {
@@ -925,11 +991,13 @@ func (t *Table) HandleCall(userID string) error {
 		if err != nil {
 			return err
 		}
+		t.acted(userID)
 
 		t.log.Debugf("HandleCall: user %s called; actionsInRound=%d currentBet=%d", userID, t.game.GetActionsInRound(), t.game.GetCurrentBet())
 
 		// Check if this action completes the betting round
 		t.MaybeAdvancePhase()
+		t.startTurn()
 	}
 
 	t.lastAction = time.Now()
@@ -945,6 +1013,9 @@ func (t *Table) HandleCheck(userID string) error {
 	if user == nil {
 		return fmt.Errorf("user not found")
 	}
+	if t.paused {
+		return ErrTablePaused
+	}
 
 	// Validate that it's this player's turn to act
 	if t.isGameActive() && t.game != nil {
@@ -960,11 +1031,13 @@ func (t *Table) HandleCheck(userID string) error {
 		if err != nil {
 			return err
 		}
+		t.acted(userID)
 
 		t.log.Debugf("HandleCheck: user %s checked; actionsInRound=%d currentBet=%d", userID, t.game.GetActionsInRound(), t.game.GetCurrentBet())
 
 		// Check if this action completes the betting round
 		t.MaybeAdvancePhase()
+		t.startTurn()
 	}
 
 	t.lastAction = time.Now()
@@ -982,14 +1055,14 @@ func (t *Table) postBlindsFromGame() error {
 		return fmt.Errorf("not enough players for blinds")
 	}
 
-	// Calculate blind positions
-	smallBlindPos := (t.game.dealer + 1) % numPlayers
-	bigBlindPos := (t.game.dealer + 2) % numPlayers
+	// Calculate blind positions; players sitting out post no blinds
+	smallBlindPos := t.game.nextDealtIn(t.game.dealer)
+	bigBlindPos := t.game.nextDealtIn(smallBlindPos)
 
 	// For heads-up (2 players), dealer posts small blind
-	if numPlayers == 2 {
+	if t.game.dealtInCount() == 2 {
 		smallBlindPos = t.game.dealer
-		bigBlindPos = (t.game.dealer + 1) % numPlayers
+		bigBlindPos = t.game.nextDealtIn(t.game.dealer)
 	}
 
 	t.log.Debugf("postBlindsFromGame: numPlayers=%d, dealer=%d, smallBlindPos=%d, bigBlindPos=%d",
@@ -1004,13 +1077,17 @@ func (t *Table) postBlindsFromGame() error {
 		if smallBlindAmount > player.Balance {
 			// Player cannot cover small blind - treat as all-in of remaining balance
 			smallBlindAmount = player.Balance
-			player.stateMachine.Dispatch(playerStateAllIn)
 			t.log.Debugf("Player %s all-in for small blind: posting %d (had %d)", player.ID, smallBlindAmount, player.Balance)
 		}
 
 		player.Balance -= smallBlindAmount
+		if player.Balance == 0 {
+			// Dispatch once the chips are in, or the state bounces back to IN_GAME
+			player.stateMachine.Dispatch(playerStateAllIn)
+		}
 		player.HasBet = smallBlindAmount
 		t.game.potManager.AddBet(smallBlindPos, smallBlindAmount, t.game.players)
+		t.game.currentBet = smallBlindAmount
 
 		// Send small blind notification
 		// DISABLED: Notification callbacks cause deadlocks - server handles notifications directly
@@ -1026,14 +1103,21 @@ func (t *Table) postBlindsFromGame() error {
 		if bigBlindAmount > player.Balance {
 			// Player cannot cover big blind - treat as all-in of remaining balance
 			bigBlindAmount = player.Balance
-			player.stateMachine.Dispatch(playerStateAllIn)
 			t.log.Debugf("Player %s all-in for big blind: posting %d (had %d)", player.ID, bigBlindAmount, player.Balance)
 		}
 
 		player.Balance -= bigBlindAmount
+		if player.Balance == 0 {
+			// Dispatch once the chips are in, or the state bounces back to IN_GAME
+			player.stateMachine.Dispatch(playerStateAllIn)
+		}
 		player.HasBet = bigBlindAmount
 		t.game.potManager.AddBet(bigBlindPos, bigBlindAmount, t.game.players)
-		t.game.currentBet = bigBlindAmount // Set current bet to big blind amount
+		// Set current bet to big blind amount, or the small blind when an
+		// all-in big blind posted less
+		if bigBlindAmount > t.game.currentBet {
+			t.game.currentBet = bigBlindAmount
+		}
 
 		// Send big blind notification
 	}
@@ -1082,7 +1166,7 @@ func (t *Table) AddUser(user *User) error {
 
 	// Check if table is full
 	if len(t.users) >= t.config.MaxPlayers {
-		return fmt.Errorf("table is full")
+		return ErrNoFreeSeat
 	}
 
 	// Check if user already at table
@@ -1090,6 +1174,12 @@ func (t *Table) AddUser(user *User) error {
 		return fmt.Errorf("user already at table")
 	}
 
+	if err := t.seatErr(user.ID, user.TableSeat); err != nil {
+		return err
+	}
+	t.releaseSeat(user.ID)
+
+	user.TimeBank = t.timeBankReserve()
 	t.users[user.ID] = user
 	t.lastAction = time.Now()
 	return nil
@@ -1257,4 +1347,5 @@ func (t *Table) RestoreGame(g *Game) {
 	// Ensure the table state reflects that an active game is in progress so
 	// that other table methods (IsGameStarted, etc.) behave correctly.
 	t.stateMachine.Dispatch(tableStateGameActive)
+	t.startTurn()
 }
@@ -0,0 +1,381 @@
+package poker
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// newHeadsUpTable returns a heads-up 10/20 table on its second hand, dealt
+// with the small blind (the dealer) holding sbStack chips and the big blind
+// bbStack.
+func newHeadsUpTable(t *testing.T, sbStack, bbStack int64) (table *Table, sb, bb *Player) {
+	t.Helper()
+	table = NewTable(TableConfig{
+		ID:            "heads-up",
+		Log:           createTestLogger(),
+		GameLog:       createTestLogger(),
+		HostID:        "p0",
+		MinPlayers:    2,
+		MaxPlayers:    2,
+		SmallBlind:    10,
+		BigBlind:      20,
+		StartingChips: 1000,
+	})
+	for i := 0; i < 2; i++ {
+		id := fmt.Sprintf("p%d", i)
+		_, err := table.AddNewUser(id, id, 0, i)
+		require.NoError(t, err)
+		require.NoError(t, table.SetPlayerReady(id, true))
+	}
+	require.True(t, table.CheckAllPlayersReady())
+	require.NoError(t, table.StartGame())
+
+	// The dealer moves on to the other seat for the next hand, which
+	// reuses the players.
+	game := table.GetGame()
+	next := (game.GetDealer() + 1) % 2
+	sb, bb = game.players[next], game.players[1-next]
+	sb.Balance = sbStack
+	bb.Balance = bbStack
+	require.NoError(t, table.startNewHand())
+	return table, sb, bb
+}
+
+// requireHandRunOut checks the hand was run out to a showdown on a full
+// board without creating or losing chips.
+func requireHandRunOut(t *testing.T, table *Table, total int64) {
+	t.Helper()
+	require.NotNil(t, table.GetLastShowdown(), "hand not settled")
+	game := table.GetGame()
+	if game == nil {
+		return // The showdown busted a player and ended the game
+	}
+	assert.Equal(t, pokerrpc.GamePhase_SHOWDOWN, game.GetPhase())
+	assert.Len(t, game.GetCommunityCards(), 5)
+	var stacks int64
+	for _, p := range game.GetPlayers() {
+		stacks += p.Balance
+	}
+	assert.Equal(t, total, stacks)
+}
+
+func TestBlindForWholeStackIsAllIn(t *testing.T) {
+	// A big blind of exactly the stack, and one short of it.
+	for _, bbStack := range []int64{20, 15} {
+		table, sb, bb := newHeadsUpTable(t, 1000, bbStack)
+		assert.Zero(t, bb.Balance)
+		assert.Equal(t, "ALL_IN", bb.GetCurrentStateString(), "big blind of %d", bbStack)
+
+		// Only the small blind can act, and calling ends the betting.
+		require.Equal(t, sb.ID, table.GetCurrentPlayerID())
+		require.NoError(t, table.HandleCall(sb.ID))
+		requireHandRunOut(t, table, 1000+bbStack)
+	}
+}
+
+func TestShortBigBlindKeepsSmallBlindAsCurrentBet(t *testing.T) {
+	// The big blind is all-in for less than the small blind: the small
+	// blind has nothing to call, so the hand runs out at once.
+	table, sb, bb := newHeadsUpTable(t, 1000, 5)
+	assert.Equal(t, "ALL_IN", bb.GetCurrentStateString())
+	assert.Equal(t, int64(10), sb.HasBet)
+	requireHandRunOut(t, table, 1005)
+}
+
+func TestAllInBlindsRunOutAtDeal(t *testing.T) {
+	// Both blinds are all-in before anyone acts.
+	table, _, _ := newHeadsUpTable(t, 5, 5)
+	requireHandRunOut(t, table, 10)
+}
+
+func TestAllInPreFlopRunsOutBoard(t *testing.T) {
+	table, sb, bb := newHeadsUpTable(t, 1000, 1000)
+	require.NoError(t, table.MakeBet(sb.ID, 1000))
+	require.NoError(t, table.HandleCall(bb.ID))
+	requireHandRunOut(t, table, 2000)
+}
+
+func TestAllInCalledByLastActivePlayerRunsOut(t *testing.T) {
+	// The big blind shoves over the small blind's raise; once the small
+	// blind calls for less than everything there is nobody left to bet
+	// against, so the board is dealt out.
+	table, sb, bb := newHeadsUpTable(t, 1000, 300)
+	require.NoError(t, table.MakeBet(sb.ID, 60))
+	require.NoError(t, table.MakeBet(bb.ID, 300))
+	assert.Equal(t, "ALL_IN", bb.GetCurrentStateString())
+	require.Equal(t, sb.ID, table.GetCurrentPlayerID())
+	require.NoError(t, table.HandleCall(sb.ID))
+	requireHandRunOut(t, table, 1300)
+}
+
+func TestSeatSelectionAndReservation(t *testing.T) {
+	table := NewTable(TableConfig{
+		ID:            "seats",
+		Log:           createTestLogger(),
+		GameLog:       createTestLogger(),
+		HostID:        "a",
+		MinPlayers:    2,
+		MaxPlayers:    4,
+		SmallBlind:    10,
+		BigBlind:      20,
+		StartingChips: 1000,
+	})
+
+	_, err := table.AddNewUser("a", "a", 0, 2)
+	require.NoError(t, err)
+	_, err = table.AddNewUser("b", "b", 0, 2)
+	require.ErrorIs(t, err, ErrSeatTaken)
+	_, err = table.AddNewUser("b", "b", 0, 4)
+	require.Error(t, err, "seats are numbered up to MaxPlayers-1")
+
+	// A reserved seat is kept for its holder only.
+	_, err = table.ReserveSeat("c", 2, time.Minute)
+	require.ErrorIs(t, err, ErrSeatTaken)
+	_, err = table.ReserveSeat("c", 3, time.Minute)
+	require.NoError(t, err)
+	_, err = table.ReserveSeat("d", 3, time.Minute)
+	require.ErrorIs(t, err, ErrSeatReserved)
+	_, err = table.AddNewUser("d", "d", 0, 3)
+	require.ErrorIs(t, err, ErrSeatReserved)
+	require.Len(t, table.Reservations(), 1)
+
+	seat, err := table.FreeSeat("d")
+	require.NoError(t, err)
+	assert.Equal(t, 0, seat)
+	seat, err = table.FreeSeat("c")
+	require.NoError(t, err)
+	assert.Equal(t, 3, seat)
+
+	// Sitting down uses up the reservation.
+	_, err = table.AddNewUser("c", "c", 0, 3)
+	require.NoError(t, err)
+	assert.Empty(t, table.Reservations())
+
+	// An expired reservation holds nothing.
+	_, err = table.ReserveSeat("d", 1, -time.Second)
+	require.NoError(t, err)
+	_, err = table.AddNewUser("e", "e", 0, 1)
+	require.NoError(t, err)
+	_, ok := table.ReservedSeat("d")
+	assert.False(t, ok)
+
+	_, err = table.AddNewUser("f", "f", 0, 0)
+	require.NoError(t, err)
+	_, err = table.FreeSeat("g")
+	require.ErrorIs(t, err, ErrNoFreeSeat)
+}
+
+func TestButtonFollowsSeatOrder(t *testing.T) {
+	table := NewTable(TableConfig{
+		ID:            "button",
+		Log:           createTestLogger(),
+		GameLog:       createTestLogger(),
+		HostID:        "s1",
+		MinPlayers:    3,
+		MaxPlayers:    6,
+		SmallBlind:    10,
+		BigBlind:      20,
+		StartingChips: 1000,
+	})
+	for _, seat := range []int{1, 3, 5} {
+		id := fmt.Sprintf("s%d", seat)
+		_, err := table.AddNewUser(id, id, 0, seat)
+		require.NoError(t, err)
+		require.NoError(t, table.SetPlayerReady(id, true))
+	}
+	require.True(t, table.CheckAllPlayersReady())
+	require.NoError(t, table.StartGame())
+
+	// buttonSeat returns the seat of the dealer, checking that only they
+	// are flagged and that the next seats post the blinds.
+	buttonSeat := func() int {
+		t.Helper()
+		game := table.GetGame()
+		players := game.GetPlayers()
+		dealer := game.GetDealer()
+		for i, p := range players {
+			assert.Equal(t, i == dealer, p.IsDealer, "seat %d", p.TableSeat)
+		}
+		n := len(players)
+		assert.Equal(t, int64(10), players[(dealer+1)%n].HasBet, "small blind")
+		assert.Equal(t, int64(20), players[(dealer+2)%n].HasBet, "big blind")
+		return players[dealer].TableSeat
+	}
+
+	assert.Equal(t, 1, buttonSeat())
+	require.NoError(t, table.startNewHand())
+	assert.Equal(t, 3, buttonSeat())
+
+	// A player sitting down right after the button gets it next.
+	_, err := table.AddNewUser("s4", "s4", 0, 4)
+	require.NoError(t, err)
+	require.NoError(t, table.startNewHand())
+	assert.Equal(t, 4, buttonSeat())
+
+	// The button skips a player who left, and wraps around the table.
+	require.NoError(t, table.RemoveUser("s5"))
+	require.NoError(t, table.startNewHand())
+	assert.Equal(t, 1, buttonSeat())
+}
+
+func TestSitOut(t *testing.T) {
+	table := NewTable(TableConfig{
+		ID:                  "sit-out",
+		Log:                 createTestLogger(),
+		GameLog:             createTestLogger(),
+		HostID:              "s0",
+		MinPlayers:          2,
+		MaxPlayers:          4,
+		SmallBlind:          10,
+		BigBlind:            20,
+		StartingChips:       1000,
+		TimeBank:            time.Minute,
+		SitOutAfterTimeouts: 1,
+		SitOutLimit:         time.Hour,
+	})
+	events := make(chan TableEvent, 16)
+	table.SetEventChannel(events)
+	for seat := 0; seat < 4; seat++ {
+		id := fmt.Sprintf("s%d", seat)
+		_, err := table.AddNewUser(id, id, 0, seat)
+		require.NoError(t, err)
+		require.NoError(t, table.SetPlayerReady(id, true))
+	}
+	require.True(t, table.CheckAllPlayersReady())
+	require.NoError(t, table.StartGame())
+
+	// s1 sits out, keeping the seat, and is left out of the next hand: the
+	// button skips them and the next players dealt in post the blinds.
+	require.NoError(t, table.SitOut("s1"))
+	require.ErrorIs(t, table.SitOut("s1"), ErrAlreadySittingOut)
+	ev := <-events
+	assert.Equal(t, pokerrpc.NotificationType_PLAYER_SAT_OUT, ev.Type)
+	assert.Equal(t, SitOutEvent{PlayerID: "s1"}, ev.Payload)
+	require.NoError(t, table.startNewHand())
+
+	game := table.GetGame()
+	players := game.GetPlayers()
+	require.Len(t, players, 4)
+	bets := make(map[int]int64)
+	for _, p := range players {
+		bets[p.TableSeat] = p.HasBet
+	}
+	sittingOut := players[1]
+	assert.True(t, sittingOut.SittingOut)
+	assert.Equal(t, "FOLDED", sittingOut.GetCurrentStateString())
+	assert.Empty(t, sittingOut.Hand)
+	assert.Equal(t, 2, players[game.GetDealer()].TableSeat)
+	assert.Equal(t, map[int]int64{0: 20, 1: 0, 2: 0, 3: 10}, bets)
+	assert.Equal(t, "s2", table.GetCurrentPlayerID())
+
+	// A player who lets their clock run out is sat out.
+	table.mu.Lock()
+	table.deadline = time.Now()
+	turn := table.turn
+	table.mu.Unlock()
+	table.expireTurn(turn)
+	assert.True(t, table.GetUser("s2").SittingOut)
+	ev = <-events
+	assert.Equal(t, pokerrpc.NotificationType_PLAYER_SAT_OUT, ev.Type)
+	assert.Equal(t, SitOutEvent{PlayerID: "s2", Auto: true}, ev.Payload)
+
+	// With one player sitting in, no hand starts.
+	require.NoError(t, table.SitOut("s3"))
+	require.ErrorIs(t, table.startNewHand(), ErrNotEnoughSittingIn)
+
+	require.NoError(t, table.SitIn("s1"))
+	require.ErrorIs(t, table.SitIn("s1"), ErrNotSittingOut)
+	require.NoError(t, table.startNewHand())
+	assert.Len(t, table.GetGame().GetPlayers()[1].Hand, 2)
+
+	// Players sitting out past the limit are due for removal; the host
+	// never is.
+	assert.Empty(t, table.ExpiredSitOuts())
+	table.mu.Lock()
+	table.config.SitOutLimit = time.Nanosecond
+	table.mu.Unlock()
+	require.NoError(t, table.SitOut("s0"))
+	assert.ElementsMatch(t, []string{"s2", "s3"}, table.ExpiredSitOuts())
+}
+
+func TestActionClock(t *testing.T) {
+	table := NewTable(TableConfig{
+		ID:              "clock",
+		Log:             createTestLogger(),
+		GameLog:         createTestLogger(),
+		HostID:          "p0",
+		MinPlayers:      2,
+		MaxPlayers:      3,
+		SmallBlind:      10,
+		BigBlind:        20,
+		StartingChips:   1000,
+		TimeBank:        100 * time.Millisecond,
+		TimeBankReserve: 200 * time.Millisecond,
+		TimeBankRefill:  50 * time.Millisecond,
+	})
+	events := make(chan TableEvent, 16)
+	table.SetEventChannel(events)
+	for seat := 0; seat < 3; seat++ {
+		id := fmt.Sprintf("p%d", seat)
+		_, err := table.AddNewUser(id, id, 0, seat)
+		require.NoError(t, err)
+		require.NoError(t, table.SetPlayerReady(id, true))
+	}
+	require.True(t, table.CheckAllPlayersReady())
+	require.NoError(t, table.StartGame())
+
+	// The player to act is on the clock, with a full time bank.
+	current := table.GetCurrentPlayerID()
+	deadline := table.ActionDeadline()
+	require.False(t, deadline.IsZero())
+	assert.Equal(t, 200*time.Millisecond, table.TimeBankLeft(current))
+
+	// Only they can draw on their time bank, which adds it all to their
+	// clock.
+	for _, id := range []string{"p0", "p1", "p2"} {
+		if id != current {
+			_, err := table.UseTimeBank(id)
+			assert.Error(t, err)
+		}
+	}
+	extended, err := table.UseTimeBank(current)
+	require.NoError(t, err)
+	assert.Equal(t, deadline.Add(200*time.Millisecond), extended)
+	assert.Equal(t, extended, table.ActionDeadline())
+	assert.Zero(t, table.TimeBankLeft(current))
+	ev := <-events
+	assert.Equal(t, pokerrpc.NotificationType_TIME_BANK_USED, ev.Type)
+	assert.Equal(t, TimeBankEvent{PlayerID: current, Added: 200 * time.Millisecond, Deadline: extended}, ev.Payload)
+	_, err = table.UseTimeBank(current)
+	assert.ErrorIs(t, err, ErrTimeBankEmpty)
+
+	// The clock stops while the table is paused.
+	require.NoError(t, table.Pause())
+	time.Sleep(400 * time.Millisecond)
+	assert.Equal(t, current, table.GetCurrentPlayerID())
+	require.NoError(t, table.Resume())
+
+	// Once it runs out, the table folds for them and the next player is on
+	// the clock. The clock is per turn: they get the full base time again,
+	// though the street has not changed.
+	ev = <-events
+	assert.Equal(t, pokerrpc.NotificationType_PLAYER_FOLDED, ev.Type)
+	assert.Equal(t, AutoActionEvent{PlayerID: current}, ev.Payload)
+	next := table.GetCurrentPlayerID()
+	assert.NotEqual(t, current, next)
+	assert.Equal(t, pokerrpc.GamePhase_PRE_FLOP, table.GetGamePhase())
+	assert.True(t, table.ActionDeadline().After(extended))
+	assert.WithinDuration(t, time.Now().Add(100*time.Millisecond), table.ActionDeadline(), 50*time.Millisecond)
+	table.StopClock()
+
+	// Every new hand gives back some time bank.
+	require.NoError(t, table.startNewHand())
+	assert.Equal(t, 50*time.Millisecond, table.TimeBankLeft(current))
+	assert.Equal(t, 200*time.Millisecond, table.TimeBankLeft(next))
+	table.StopClock()
+}
@@ -109,6 +109,18 @@ const (
 	NotificationType_CARDS_SHOWN        NotificationType = 20
 	NotificationType_CARDS_HIDDEN       NotificationType = 21
 	NotificationType_NEW_HAND_STARTED   NotificationType = 22
+	NotificationType_PLAYER_KICKED      NotificationType = 23
+	NotificationType_PLAYER_BANNED      NotificationType = 24
+	NotificationType_GAME_PAUSED        NotificationType = 25
+	NotificationType_GAME_RESUMED       NotificationType = 26
+	NotificationType_TABLE_CLOSED       NotificationType = 27
+	NotificationType_SERVER_MESSAGE     NotificationType = 28
+	NotificationType_SERVER_DRAINING    NotificationType = 29 // countdown holds the most seconds left before the restart
+	NotificationType_SEAT_OFFERED       NotificationType = 30 // countdown holds the seconds left to take the seat
+	NotificationType_PLAYER_SAT_OUT     NotificationType = 31
+	NotificationType_PLAYER_SAT_IN      NotificationType = 32
+	NotificationType_TIME_BANK_USED     NotificationType = 33 // countdown holds the seconds left to act
+	NotificationType_CHAT_MESSAGE       NotificationType = 34 // chat holds the message sent, or the recent chat of the table on join
 )
 
 // Enum value maps for NotificationType.
@@ -137,6 +149,18 @@ var (
 		20: "CARDS_SHOWN",
 		21: "CARDS_HIDDEN",
 		22: "NEW_HAND_STARTED",
+		23: "PLAYER_KICKED",
+		24: "PLAYER_BANNED",
+		25: "GAME_PAUSED",
+		26: "GAME_RESUMED",
+		27: "TABLE_CLOSED",
+		28: "SERVER_MESSAGE",
+		29: "SERVER_DRAINING",
+		30: "SEAT_OFFERED",
+		31: "PLAYER_SAT_OUT",
+		32: "PLAYER_SAT_IN",
+		33: "TIME_BANK_USED",
+		34: "CHAT_MESSAGE",
 	}
 	NotificationType_value = map[string]int32{
 		"UNKNOWN":            0,
@@ -162,6 +186,18 @@ var (
 		"CARDS_SHOWN":        20,
 		"CARDS_HIDDEN":       21,
 		"NEW_HAND_STARTED":   22,
+		"PLAYER_KICKED":      23,
+		"PLAYER_BANNED":      24,
+		"GAME_PAUSED":        25,
+		"GAME_RESUMED":       26,
+		"TABLE_CLOSED":       27,
+		"SERVER_MESSAGE":     28,
+		"SERVER_DRAINING":    29,
+		"SEAT_OFFERED":       30,
+		"PLAYER_SAT_OUT":     31,
+		"PLAYER_SAT_IN":      32,
+		"TIME_BANK_USED":     33,
+		"CHAT_MESSAGE":       34,
 	}
 )
 
@@ -267,6 +303,7 @@ type StartGameStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Spectate      bool                   `protobuf:"varint,3,opt,name=spectate,proto3" json:"spectate,omitempty"` // Watch the table's delayed public view without a seat
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -315,23 +352,32 @@ func (x *StartGameStreamRequest) GetTableId() string {
 	return ""
 }
 
+func (x *StartGameStreamRequest) GetSpectate() bool {
+	if x != nil {
+		return x.Spectate
+	}
+	return false
+}
+
 type GameUpdate struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	TableId         string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	Phase           GamePhase              `protobuf:"varint,2,opt,name=phase,proto3,enum=poker.GamePhase" json:"phase,omitempty"`
-	Players         []*Player              `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
-	CommunityCards  []*Card                `protobuf:"bytes,4,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"`
-	Pot             int64                  `protobuf:"varint,5,opt,name=pot,proto3" json:"pot,omitempty"`                                 // Total poker chips in the pot
-	CurrentBet      int64                  `protobuf:"varint,6,opt,name=current_bet,json=currentBet,proto3" json:"current_bet,omitempty"` // Current poker chips bet amount in this round
-	CurrentPlayer   string                 `protobuf:"bytes,7,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`
-	MinRaise        int64                  `protobuf:"varint,8,opt,name=min_raise,json=minRaise,proto3" json:"min_raise,omitempty"` // Minimum poker chips raise amount
-	MaxRaise        int64                  `protobuf:"varint,9,opt,name=max_raise,json=maxRaise,proto3" json:"max_raise,omitempty"` // Maximum poker chips raise amount
-	GameStarted     bool                   `protobuf:"varint,10,opt,name=game_started,json=gameStarted,proto3" json:"game_started,omitempty"`
-	PlayersRequired int32                  `protobuf:"varint,11,opt,name=players_required,json=playersRequired,proto3" json:"players_required,omitempty"`
-	PlayersJoined   int32                  `protobuf:"varint,12,opt,name=players_joined,json=playersJoined,proto3" json:"players_joined,omitempty"`
-	PhaseName       string                 `protobuf:"bytes,13,opt,name=phase_name,json=phaseName,proto3" json:"phase_name,omitempty"` // Human-readable name of the current phase
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TableId              string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Phase                GamePhase              `protobuf:"varint,2,opt,name=phase,proto3,enum=poker.GamePhase" json:"phase,omitempty"`
+	Players              []*Player              `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	CommunityCards       []*Card                `protobuf:"bytes,4,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"`
+	Pot                  int64                  `protobuf:"varint,5,opt,name=pot,proto3" json:"pot,omitempty"`                                 // Total poker chips in the pot
+	CurrentBet           int64                  `protobuf:"varint,6,opt,name=current_bet,json=currentBet,proto3" json:"current_bet,omitempty"` // Current poker chips bet amount in this round
+	CurrentPlayer        string                 `protobuf:"bytes,7,opt,name=current_player,json=currentPlayer,proto3" json:"current_player,omitempty"`
+	MinRaise             int64                  `protobuf:"varint,8,opt,name=min_raise,json=minRaise,proto3" json:"min_raise,omitempty"` // Minimum poker chips raise amount
+	MaxRaise             int64                  `protobuf:"varint,9,opt,name=max_raise,json=maxRaise,proto3" json:"max_raise,omitempty"` // Maximum poker chips raise amount
+	GameStarted          bool                   `protobuf:"varint,10,opt,name=game_started,json=gameStarted,proto3" json:"game_started,omitempty"`
+	PlayersRequired      int32                  `protobuf:"varint,11,opt,name=players_required,json=playersRequired,proto3" json:"players_required,omitempty"`
+	PlayersJoined        int32                  `protobuf:"varint,12,opt,name=players_joined,json=playersJoined,proto3" json:"players_joined,omitempty"`
+	PhaseName            string                 `protobuf:"bytes,13,opt,name=phase_name,json=phaseName,proto3" json:"phase_name,omitempty"`                                       // Human-readable name of the current phase
+	ActionDeadlineUnixMs int64                  `protobuf:"varint,14,opt,name=action_deadline_unix_ms,json=actionDeadlineUnixMs,proto3" json:"action_deadline_unix_ms,omitempty"` // When the table acts for current_player (0 = no clock)
+	Spectators           int32                  `protobuf:"varint,15,opt,name=spectators,proto3" json:"spectators,omitempty"`                                                     // Number of spectators watching the table
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *GameUpdate) Reset() {
@@ -455,6 +501,20 @@ func (x *GameUpdate) GetPhaseName() string {
 	return ""
 }
 
+func (x *GameUpdate) GetActionDeadlineUnixMs() int64 {
+	if x != nil {
+		return x.ActionDeadlineUnixMs
+	}
+	return 0
+}
+
+func (x *GameUpdate) GetSpectators() int32 {
+	if x != nil {
+		return x.Spectators
+	}
+	return 0
+}
+
 type MakeBetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
@@ -783,6 +843,238 @@ func (x *CheckBetResponse) GetMessage() string {
 	return ""
 }
 
+type UseTimeBankRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UseTimeBankRequest) Reset() {
+	*x = UseTimeBankRequest{}
+	mi := &file_poker_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UseTimeBankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseTimeBankRequest) ProtoMessage() {}
+
+func (x *UseTimeBankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseTimeBankRequest.ProtoReflect.Descriptor instead.
+func (*UseTimeBankRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UseTimeBankRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *UseTimeBankRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type UseTimeBankResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Success              bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message              string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ActionDeadlineUnixMs int64                  `protobuf:"varint,3,opt,name=action_deadline_unix_ms,json=actionDeadlineUnixMs,proto3" json:"action_deadline_unix_ms,omitempty"` // New deadline of the player's turn
+	TimeBankSeconds      int32                  `protobuf:"varint,4,opt,name=time_bank_seconds,json=timeBankSeconds,proto3" json:"time_bank_seconds,omitempty"`                  // Time bank left
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UseTimeBankResponse) Reset() {
+	*x = UseTimeBankResponse{}
+	mi := &file_poker_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UseTimeBankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseTimeBankResponse) ProtoMessage() {}
+
+func (x *UseTimeBankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseTimeBankResponse.ProtoReflect.Descriptor instead.
+func (*UseTimeBankResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UseTimeBankResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UseTimeBankResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UseTimeBankResponse) GetActionDeadlineUnixMs() int64 {
+	if x != nil {
+		return x.ActionDeadlineUnixMs
+	}
+	return 0
+}
+
+func (x *UseTimeBankResponse) GetTimeBankSeconds() int32 {
+	if x != nil {
+		return x.TimeBankSeconds
+	}
+	return 0
+}
+
+type SendChatMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Player seated at the table
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatMessageRequest) Reset() {
+	*x = SendChatMessageRequest{}
+	mi := &file_poker_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageRequest) ProtoMessage() {}
+
+func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendChatMessageRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SendChatMessageRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SendChatMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatMessageResponse) Reset() {
+	*x = SendChatMessageResponse{}
+	mi := &file_poker_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageResponse) ProtoMessage() {}
+
+func (x *SendChatMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendChatMessageResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SendChatMessageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendChatMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type CallBetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
@@ -793,7 +1085,7 @@ type CallBetRequest struct {
 
 func (x *CallBetRequest) Reset() {
 	*x = CallBetRequest{}
-	mi := &file_poker_proto_msgTypes[8]
+	mi := &file_poker_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -805,7 +1097,7 @@ func (x *CallBetRequest) String() string {
 func (*CallBetRequest) ProtoMessage() {}
 
 func (x *CallBetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[8]
+	mi := &file_poker_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -818,7 +1110,7 @@ func (x *CallBetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallBetRequest.ProtoReflect.Descriptor instead.
 func (*CallBetRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{8}
+	return file_poker_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CallBetRequest) GetPlayerId() string {
@@ -845,7 +1137,7 @@ type CallBetResponse struct {
 
 func (x *CallBetResponse) Reset() {
 	*x = CallBetResponse{}
-	mi := &file_poker_proto_msgTypes[9]
+	mi := &file_poker_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -857,7 +1149,7 @@ func (x *CallBetResponse) String() string {
 func (*CallBetResponse) ProtoMessage() {}
 
 func (x *CallBetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[9]
+	mi := &file_poker_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,7 +1162,7 @@ func (x *CallBetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CallBetResponse.ProtoReflect.Descriptor instead.
 func (*CallBetResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{9}
+	return file_poker_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CallBetResponse) GetSuccess() bool {
@@ -888,15 +1180,17 @@ func (x *CallBetResponse) GetMessage() string {
 }
 
 type GetGameStateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TableId string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// Player asking for the state; only players seated at the table get it.
+	PlayerId      string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetGameStateRequest) Reset() {
 	*x = GetGameStateRequest{}
-	mi := &file_poker_proto_msgTypes[10]
+	mi := &file_poker_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -908,7 +1202,7 @@ func (x *GetGameStateRequest) String() string {
 func (*GetGameStateRequest) ProtoMessage() {}
 
 func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[10]
+	mi := &file_poker_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,7 +1215,7 @@ func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGameStateRequest.ProtoReflect.Descriptor instead.
 func (*GetGameStateRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{10}
+	return file_poker_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetGameStateRequest) GetTableId() string {
@@ -931,6 +1225,13 @@ func (x *GetGameStateRequest) GetTableId() string {
 	return ""
 }
 
+func (x *GetGameStateRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
 type GetGameStateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameState     *GameUpdate            `protobuf:"bytes,1,opt,name=game_state,json=gameState,proto3" json:"game_state,omitempty"`
@@ -940,7 +1241,7 @@ type GetGameStateResponse struct {
 
 func (x *GetGameStateResponse) Reset() {
 	*x = GetGameStateResponse{}
-	mi := &file_poker_proto_msgTypes[11]
+	mi := &file_poker_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -952,7 +1253,7 @@ func (x *GetGameStateResponse) String() string {
 func (*GetGameStateResponse) ProtoMessage() {}
 
 func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[11]
+	mi := &file_poker_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,7 +1266,7 @@ func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGameStateResponse.ProtoReflect.Descriptor instead.
 func (*GetGameStateResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{11}
+	return file_poker_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetGameStateResponse) GetGameState() *GameUpdate {
@@ -984,7 +1285,7 @@ type EvaluateHandRequest struct {
 
 func (x *EvaluateHandRequest) Reset() {
 	*x = EvaluateHandRequest{}
-	mi := &file_poker_proto_msgTypes[12]
+	mi := &file_poker_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -996,7 +1297,7 @@ func (x *EvaluateHandRequest) String() string {
 func (*EvaluateHandRequest) ProtoMessage() {}
 
 func (x *EvaluateHandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[12]
+	mi := &file_poker_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,7 +1310,7 @@ func (x *EvaluateHandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvaluateHandRequest.ProtoReflect.Descriptor instead.
 func (*EvaluateHandRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{12}
+	return file_poker_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *EvaluateHandRequest) GetCards() []*Card {
@@ -1030,7 +1331,7 @@ type EvaluateHandResponse struct {
 
 func (x *EvaluateHandResponse) Reset() {
 	*x = EvaluateHandResponse{}
-	mi := &file_poker_proto_msgTypes[13]
+	mi := &file_poker_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1042,7 +1343,7 @@ func (x *EvaluateHandResponse) String() string {
 func (*EvaluateHandResponse) ProtoMessage() {}
 
 func (x *EvaluateHandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[13]
+	mi := &file_poker_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1055,7 +1356,7 @@ func (x *EvaluateHandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvaluateHandResponse.ProtoReflect.Descriptor instead.
 func (*EvaluateHandResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{13}
+	return file_poker_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *EvaluateHandResponse) GetRank() HandRank {
@@ -1088,7 +1389,7 @@ type GetLastWinnersRequest struct {
 
 func (x *GetLastWinnersRequest) Reset() {
 	*x = GetLastWinnersRequest{}
-	mi := &file_poker_proto_msgTypes[14]
+	mi := &file_poker_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1100,7 +1401,7 @@ func (x *GetLastWinnersRequest) String() string {
 func (*GetLastWinnersRequest) ProtoMessage() {}
 
 func (x *GetLastWinnersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[14]
+	mi := &file_poker_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1113,7 +1414,7 @@ func (x *GetLastWinnersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastWinnersRequest.ProtoReflect.Descriptor instead.
 func (*GetLastWinnersRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{14}
+	return file_poker_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetLastWinnersRequest) GetTableId() string {
@@ -1132,7 +1433,7 @@ type GetLastWinnersResponse struct {
 
 func (x *GetLastWinnersResponse) Reset() {
 	*x = GetLastWinnersResponse{}
-	mi := &file_poker_proto_msgTypes[15]
+	mi := &file_poker_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1144,7 +1445,7 @@ func (x *GetLastWinnersResponse) String() string {
 func (*GetLastWinnersResponse) ProtoMessage() {}
 
 func (x *GetLastWinnersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[15]
+	mi := &file_poker_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1157,7 +1458,7 @@ func (x *GetLastWinnersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastWinnersResponse.ProtoReflect.Descriptor instead.
 func (*GetLastWinnersResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{15}
+	return file_poker_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetLastWinnersResponse) GetWinners() []*Winner {
@@ -1179,7 +1480,7 @@ type Winner struct {
 
 func (x *Winner) Reset() {
 	*x = Winner{}
-	mi := &file_poker_proto_msgTypes[16]
+	mi := &file_poker_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1191,7 +1492,7 @@ func (x *Winner) String() string {
 func (*Winner) ProtoMessage() {}
 
 func (x *Winner) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[16]
+	mi := &file_poker_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,7 +1505,7 @@ func (x *Winner) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Winner.ProtoReflect.Descriptor instead.
 func (*Winner) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{16}
+	return file_poker_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Winner) GetPlayerId() string {
@@ -1237,24 +1538,31 @@ func (x *Winner) GetWinnings() int64 {
 
 // Lobby Messages
 type CreateTableRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId        string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	SmallBlind      int64                  `protobuf:"varint,2,opt,name=small_blind,json=smallBlind,proto3" json:"small_blind,omitempty"` // Poker chips amount for small blind
-	BigBlind        int64                  `protobuf:"varint,3,opt,name=big_blind,json=bigBlind,proto3" json:"big_blind,omitempty"`       // Poker chips amount for big blind
-	MaxPlayers      int32                  `protobuf:"varint,4,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
-	MinPlayers      int32                  `protobuf:"varint,5,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
-	MinBalance      int64                  `protobuf:"varint,6,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"`                  // Minimum DCR balance required (in atoms)
-	BuyIn           int64                  `protobuf:"varint,7,opt,name=buy_in,json=buyIn,proto3" json:"buy_in,omitempty"`                                 // DCR amount to join table (in atoms)
-	StartingChips   int64                  `protobuf:"varint,8,opt,name=starting_chips,json=startingChips,proto3" json:"starting_chips,omitempty"`         // Poker chips each player starts with
-	TimeBankSeconds int32                  `protobuf:"varint,9,opt,name=time_bank_seconds,json=timeBankSeconds,proto3" json:"time_bank_seconds,omitempty"` // Player timeout in seconds (default: 30)
-	AutoStartMs     int32                  `protobuf:"varint,10,opt,name=auto_start_ms,json=autoStartMs,proto3" json:"auto_start_ms,omitempty"`            // Auto-start delay between hands in ms (0 = disabled)
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId               string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	SmallBlind             int64                  `protobuf:"varint,2,opt,name=small_blind,json=smallBlind,proto3" json:"small_blind,omitempty"` // Poker chips amount for small blind
+	BigBlind               int64                  `protobuf:"varint,3,opt,name=big_blind,json=bigBlind,proto3" json:"big_blind,omitempty"`       // Poker chips amount for big blind
+	MaxPlayers             int32                  `protobuf:"varint,4,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	MinPlayers             int32                  `protobuf:"varint,5,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
+	MinBalance             int64                  `protobuf:"varint,6,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"`                                          // Minimum DCR balance required (in atoms)
+	BuyIn                  int64                  `protobuf:"varint,7,opt,name=buy_in,json=buyIn,proto3" json:"buy_in,omitempty"`                                                         // DCR amount to join table (in atoms)
+	StartingChips          int64                  `protobuf:"varint,8,opt,name=starting_chips,json=startingChips,proto3" json:"starting_chips,omitempty"`                                 // Poker chips each player starts with
+	TimeBankSeconds        int32                  `protobuf:"varint,9,opt,name=time_bank_seconds,json=timeBankSeconds,proto3" json:"time_bank_seconds,omitempty"`                         // Base time of every action in seconds (default: 30)
+	AutoStartMs            int32                  `protobuf:"varint,10,opt,name=auto_start_ms,json=autoStartMs,proto3" json:"auto_start_ms,omitempty"`                                    // Auto-start delay between hands in ms (0 = disabled)
+	Private                bool                   `protobuf:"varint,11,opt,name=private,proto3" json:"private,omitempty"`                                                                 // Hidden from GetTables and joined by invitation
+	Password               string                 `protobuf:"bytes,12,opt,name=password,proto3" json:"password,omitempty"`                                                                // Password required to join (empty = none)
+	SitOutAfterTimeouts    int32                  `protobuf:"varint,13,opt,name=sit_out_after_timeouts,json=sitOutAfterTimeouts,proto3" json:"sit_out_after_timeouts,omitempty"`          // Missed actions in a row before a player is sat out (default: 2)
+	SitOutLimitSeconds     int32                  `protobuf:"varint,14,opt,name=sit_out_limit_seconds,json=sitOutLimitSeconds,proto3" json:"sit_out_limit_seconds,omitempty"`             // Seconds a player may sit out before being removed (default: 600)
+	TimeBankReserveSeconds int32                  `protobuf:"varint,15,opt,name=time_bank_reserve_seconds,json=timeBankReserveSeconds,proto3" json:"time_bank_reserve_seconds,omitempty"` // Most time bank a player holds (default: 30)
+	MaxSpectators          int32                  `protobuf:"varint,16,opt,name=max_spectators,json=maxSpectators,proto3" json:"max_spectators,omitempty"`                                // Most spectators watching at once (0 = unlimited)
+	SpectatorDelaySeconds  int32                  `protobuf:"varint,17,opt,name=spectator_delay_seconds,json=spectatorDelaySeconds,proto3" json:"spectator_delay_seconds,omitempty"`      // Delay of the spectator stream (default: 30)
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *CreateTableRequest) Reset() {
 	*x = CreateTableRequest{}
-	mi := &file_poker_proto_msgTypes[17]
+	mi := &file_poker_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1266,7 +1574,7 @@ func (x *CreateTableRequest) String() string {
 func (*CreateTableRequest) ProtoMessage() {}
 
 func (x *CreateTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[17]
+	mi := &file_poker_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1279,7 +1587,7 @@ func (x *CreateTableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTableRequest.ProtoReflect.Descriptor instead.
 func (*CreateTableRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{17}
+	return file_poker_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CreateTableRequest) GetPlayerId() string {
@@ -1352,6 +1660,55 @@ func (x *CreateTableRequest) GetAutoStartMs() int32 {
 	return 0
 }
 
+func (x *CreateTableRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *CreateTableRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateTableRequest) GetSitOutAfterTimeouts() int32 {
+	if x != nil {
+		return x.SitOutAfterTimeouts
+	}
+	return 0
+}
+
+func (x *CreateTableRequest) GetSitOutLimitSeconds() int32 {
+	if x != nil {
+		return x.SitOutLimitSeconds
+	}
+	return 0
+}
+
+func (x *CreateTableRequest) GetTimeBankReserveSeconds() int32 {
+	if x != nil {
+		return x.TimeBankReserveSeconds
+	}
+	return 0
+}
+
+func (x *CreateTableRequest) GetMaxSpectators() int32 {
+	if x != nil {
+		return x.MaxSpectators
+	}
+	return 0
+}
+
+func (x *CreateTableRequest) GetSpectatorDelaySeconds() int32 {
+	if x != nil {
+		return x.SpectatorDelaySeconds
+	}
+	return 0
+}
+
 type CreateTableResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
@@ -1361,7 +1718,7 @@ type CreateTableResponse struct {
 
 func (x *CreateTableResponse) Reset() {
 	*x = CreateTableResponse{}
-	mi := &file_poker_proto_msgTypes[18]
+	mi := &file_poker_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1373,7 +1730,7 @@ func (x *CreateTableResponse) String() string {
 func (*CreateTableResponse) ProtoMessage() {}
 
 func (x *CreateTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[18]
+	mi := &file_poker_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,7 +1743,7 @@ func (x *CreateTableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTableResponse.ProtoReflect.Descriptor instead.
 func (*CreateTableResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{18}
+	return file_poker_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CreateTableResponse) GetTableId() string {
@@ -1400,13 +1757,16 @@ type JoinTableRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`                       // Join password of password-protected tables
+	InviteCode    string                 `protobuf:"bytes,4,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"` // Invite code issued by the table host
+	Seat          int32                  `protobuf:"varint,5,opt,name=seat,proto3" json:"seat,omitempty"`                              // Seat to sit in, from 1 to max_players (0 = the reserved or first free seat)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *JoinTableRequest) Reset() {
 	*x = JoinTableRequest{}
-	mi := &file_poker_proto_msgTypes[19]
+	mi := &file_poker_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1418,7 +1778,7 @@ func (x *JoinTableRequest) String() string {
 func (*JoinTableRequest) ProtoMessage() {}
 
 func (x *JoinTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[19]
+	mi := &file_poker_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1431,7 +1791,7 @@ func (x *JoinTableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinTableRequest.ProtoReflect.Descriptor instead.
 func (*JoinTableRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{19}
+	return file_poker_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *JoinTableRequest) GetPlayerId() string {
@@ -1448,18 +1808,40 @@ func (x *JoinTableRequest) GetTableId() string {
 	return ""
 }
 
+func (x *JoinTableRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *JoinTableRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *JoinTableRequest) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
 type JoinTableResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	NewBalance    int64                  `protobuf:"varint,3,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	Seat          int32                  `protobuf:"varint,4,opt,name=seat,proto3" json:"seat,omitempty"` // Seat taken, from 1 to max_players
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *JoinTableResponse) Reset() {
 	*x = JoinTableResponse{}
-	mi := &file_poker_proto_msgTypes[20]
+	mi := &file_poker_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1471,7 +1853,7 @@ func (x *JoinTableResponse) String() string {
 func (*JoinTableResponse) ProtoMessage() {}
 
 func (x *JoinTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[20]
+	mi := &file_poker_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1484,7 +1866,7 @@ func (x *JoinTableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinTableResponse.ProtoReflect.Descriptor instead.
 func (*JoinTableResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{20}
+	return file_poker_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *JoinTableResponse) GetSuccess() bool {
@@ -1508,29 +1890,43 @@ func (x *JoinTableResponse) GetNewBalance() int64 {
 	return 0
 }
 
-type LeaveTableRequest struct {
+func (x *JoinTableResponse) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+// ReserveSeatRequest holds a seat for a player who cannot join yet, such as
+// while they top up their balance for the buy-in. The seat is taken when they
+// join, and freed when the reservation expires or they leave the table.
+type ReserveSeatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Seat          int32                  `protobuf:"varint,3,opt,name=seat,proto3" json:"seat,omitempty"`                               // Seat to hold, from 1 to max_players (0 = first free seat)
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`                        // Join password of password-protected tables
+	InviteCode    string                 `protobuf:"bytes,5,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`  // Invite code issued by the table host
+	TtlSeconds    int64                  `protobuf:"varint,6,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // Reservation lifetime (0 = 5 minutes, at most 15 minutes)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaveTableRequest) Reset() {
-	*x = LeaveTableRequest{}
-	mi := &file_poker_proto_msgTypes[21]
+func (x *ReserveSeatRequest) Reset() {
+	*x = ReserveSeatRequest{}
+	mi := &file_poker_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaveTableRequest) String() string {
+func (x *ReserveSeatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveTableRequest) ProtoMessage() {}
+func (*ReserveSeatRequest) ProtoMessage() {}
 
-func (x *LeaveTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[21]
+func (x *ReserveSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1541,48 +1937,78 @@ func (x *LeaveTableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveTableRequest.ProtoReflect.Descriptor instead.
-func (*LeaveTableRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ReserveSeatRequest.ProtoReflect.Descriptor instead.
+func (*ReserveSeatRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *LeaveTableRequest) GetPlayerId() string {
+func (x *ReserveSeatRequest) GetPlayerId() string {
 	if x != nil {
 		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *LeaveTableRequest) GetTableId() string {
+func (x *ReserveSeatRequest) GetTableId() string {
 	if x != nil {
 		return x.TableId
 	}
 	return ""
 }
 
-type LeaveTableResponse struct {
+func (x *ReserveSeatRequest) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *ReserveSeatRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ReserveSeatRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *ReserveSeatRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type ReserveSeatResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Seat          int32                  `protobuf:"varint,3,opt,name=seat,proto3" json:"seat,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix seconds
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeaveTableResponse) Reset() {
-	*x = LeaveTableResponse{}
-	mi := &file_poker_proto_msgTypes[22]
+func (x *ReserveSeatResponse) Reset() {
+	*x = ReserveSeatResponse{}
+	mi := &file_poker_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaveTableResponse) String() string {
+func (x *ReserveSeatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveTableResponse) ProtoMessage() {}
+func (*ReserveSeatResponse) ProtoMessage() {}
 
-func (x *LeaveTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[22]
+func (x *ReserveSeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,46 +2019,64 @@ func (x *LeaveTableResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveTableResponse.ProtoReflect.Descriptor instead.
-func (*LeaveTableResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ReserveSeatResponse.ProtoReflect.Descriptor instead.
+func (*ReserveSeatResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *LeaveTableResponse) GetSuccess() bool {
+func (x *ReserveSeatResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *LeaveTableResponse) GetMessage() string {
+func (x *ReserveSeatResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type GetTablesRequest struct {
+func (x *ReserveSeatResponse) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *ReserveSeatResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type JoinWaitlistRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`                       // Join password of password-protected tables
+	InviteCode    string                 `protobuf:"bytes,4,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"` // Invite code issued by the table host
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTablesRequest) Reset() {
-	*x = GetTablesRequest{}
-	mi := &file_poker_proto_msgTypes[23]
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	mi := &file_poker_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTablesRequest) String() string {
+func (x *JoinWaitlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTablesRequest) ProtoMessage() {}
+func (*JoinWaitlistRequest) ProtoMessage() {}
 
-func (x *GetTablesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[23]
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1643,33 +2087,63 @@ func (x *GetTablesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTablesRequest.ProtoReflect.Descriptor instead.
-func (*GetTablesRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{27}
 }
 
-type GetTablesResponse struct {
+func (x *JoinWaitlistRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type JoinWaitlistResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tables        []*Table               `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"` // Place in the waitlist, from 1
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTablesResponse) Reset() {
-	*x = GetTablesResponse{}
-	mi := &file_poker_proto_msgTypes[24]
+func (x *JoinWaitlistResponse) Reset() {
+	*x = JoinWaitlistResponse{}
+	mi := &file_poker_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTablesResponse) String() string {
+func (x *JoinWaitlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTablesResponse) ProtoMessage() {}
+func (*JoinWaitlistResponse) ProtoMessage() {}
 
-func (x *GetTablesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[24]
+func (x *JoinWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1680,52 +2154,55 @@ func (x *GetTablesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTablesResponse.ProtoReflect.Descriptor instead.
-func (*GetTablesResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use JoinWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetTablesResponse) GetTables() []*Table {
+func (x *JoinWaitlistResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Tables
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type Table struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	HostId          string                 `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Players         []*Player              `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
-	SmallBlind      int64                  `protobuf:"varint,4,opt,name=small_blind,json=smallBlind,proto3" json:"small_blind,omitempty"` // Poker chips amount for small blind
-	BigBlind        int64                  `protobuf:"varint,5,opt,name=big_blind,json=bigBlind,proto3" json:"big_blind,omitempty"`       // Poker chips amount for big blind
-	MaxPlayers      int32                  `protobuf:"varint,6,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
-	MinPlayers      int32                  `protobuf:"varint,7,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
-	CurrentPlayers  int32                  `protobuf:"varint,8,opt,name=current_players,json=currentPlayers,proto3" json:"current_players,omitempty"`
-	MinBalance      int64                  `protobuf:"varint,9,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"` // Minimum DCR balance required (in atoms)
-	BuyIn           int64                  `protobuf:"varint,10,opt,name=buy_in,json=buyIn,proto3" json:"buy_in,omitempty"`               // DCR amount to join table (in atoms)
-	Phase           GamePhase              `protobuf:"varint,11,opt,name=phase,proto3,enum=poker.GamePhase" json:"phase,omitempty"`
-	GameStarted     bool                   `protobuf:"varint,12,opt,name=game_started,json=gameStarted,proto3" json:"game_started,omitempty"`
-	AllPlayersReady bool                   `protobuf:"varint,13,opt,name=all_players_ready,json=allPlayersReady,proto3" json:"all_players_ready,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *JoinWaitlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *Table) Reset() {
-	*x = Table{}
-	mi := &file_poker_proto_msgTypes[25]
+func (x *JoinWaitlistResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type LeaveWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistRequest) Reset() {
+	*x = LeaveWaitlistRequest{}
+	mi := &file_poker_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Table) String() string {
+func (x *LeaveWaitlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Table) ProtoMessage() {}
+func (*LeaveWaitlistRequest) ProtoMessage() {}
 
-func (x *Table) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[25]
+func (x *LeaveWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1736,124 +2213,2937 @@ func (x *Table) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Table.ProtoReflect.Descriptor instead.
-func (*Table) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use LeaveWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Table) GetId() string {
+func (x *LeaveWaitlistRequest) GetPlayerId() string {
 	if x != nil {
-		return x.Id
+		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *Table) GetHostId() string {
+func (x *LeaveWaitlistRequest) GetTableId() string {
 	if x != nil {
-		return x.HostId
+		return x.TableId
 	}
 	return ""
 }
 
-func (x *Table) GetPlayers() []*Player {
-	if x != nil {
-		return x.Players
-	}
-	return nil
+type LeaveWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistResponse) Reset() {
+	*x = LeaveWaitlistResponse{}
+	mi := &file_poker_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistResponse) ProtoMessage() {}
+
+func (x *LeaveWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LeaveWaitlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LeaveWaitlistResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LeaveTableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveTableRequest) Reset() {
+	*x = LeaveTableRequest{}
+	mi := &file_poker_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveTableRequest) ProtoMessage() {}
+
+func (x *LeaveTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveTableRequest.ProtoReflect.Descriptor instead.
+func (*LeaveTableRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LeaveTableRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *LeaveTableRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type LeaveTableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveTableResponse) Reset() {
+	*x = LeaveTableResponse{}
+	mi := &file_poker_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveTableResponse) ProtoMessage() {}
+
+func (x *LeaveTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveTableResponse.ProtoReflect.Descriptor instead.
+func (*LeaveTableResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LeaveTableResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LeaveTableResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetTablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Private tables are only listed for their host, players and invitees
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTablesRequest) Reset() {
+	*x = GetTablesRequest{}
+	mi := &file_poker_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTablesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTablesRequest) ProtoMessage() {}
+
+func (x *GetTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTablesRequest.ProtoReflect.Descriptor instead.
+func (*GetTablesRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTablesRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetTablesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tables        []*Table               `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTablesResponse) Reset() {
+	*x = GetTablesResponse{}
+	mi := &file_poker_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTablesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTablesResponse) ProtoMessage() {}
+
+func (x *GetTablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTablesResponse.ProtoReflect.Descriptor instead.
+func (*GetTablesResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetTablesResponse) GetTables() []*Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type Table struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HostId            string                 `protobuf:"bytes,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Players           []*Player              `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	SmallBlind        int64                  `protobuf:"varint,4,opt,name=small_blind,json=smallBlind,proto3" json:"small_blind,omitempty"` // Poker chips amount for small blind
+	BigBlind          int64                  `protobuf:"varint,5,opt,name=big_blind,json=bigBlind,proto3" json:"big_blind,omitempty"`       // Poker chips amount for big blind
+	MaxPlayers        int32                  `protobuf:"varint,6,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	MinPlayers        int32                  `protobuf:"varint,7,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
+	CurrentPlayers    int32                  `protobuf:"varint,8,opt,name=current_players,json=currentPlayers,proto3" json:"current_players,omitempty"`
+	MinBalance        int64                  `protobuf:"varint,9,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"` // Minimum DCR balance required (in atoms)
+	BuyIn             int64                  `protobuf:"varint,10,opt,name=buy_in,json=buyIn,proto3" json:"buy_in,omitempty"`               // DCR amount to join table (in atoms)
+	Phase             GamePhase              `protobuf:"varint,11,opt,name=phase,proto3,enum=poker.GamePhase" json:"phase,omitempty"`
+	GameStarted       bool                   `protobuf:"varint,12,opt,name=game_started,json=gameStarted,proto3" json:"game_started,omitempty"`
+	AllPlayersReady   bool                   `protobuf:"varint,13,opt,name=all_players_ready,json=allPlayersReady,proto3" json:"all_players_ready,omitempty"`
+	Private           bool                   `protobuf:"varint,14,opt,name=private,proto3" json:"private,omitempty"`
+	PasswordProtected bool                   `protobuf:"varint,15,opt,name=password_protected,json=passwordProtected,proto3" json:"password_protected,omitempty"`
+	TimeBankSeconds   int32                  `protobuf:"varint,16,opt,name=time_bank_seconds,json=timeBankSeconds,proto3" json:"time_bank_seconds,omitempty"` // Time a player has to act (0 = no limit)
+	ReservedSeats     []int32                `protobuf:"varint,17,rep,packed,name=reserved_seats,json=reservedSeats,proto3" json:"reserved_seats,omitempty"`  // Seats held for players who have not joined yet
+	Waitlist          []string               `protobuf:"bytes,18,rep,name=waitlist,proto3" json:"waitlist,omitempty"`                                         // Players waiting for a seat, first in line first
+	Spectators        int32                  `protobuf:"varint,19,opt,name=spectators,proto3" json:"spectators,omitempty"`                                    // Number of spectators watching the table
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Table) Reset() {
+	*x = Table{}
+	mi := &file_poker_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Table) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Table) ProtoMessage() {}
+
+func (x *Table) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Table.ProtoReflect.Descriptor instead.
+func (*Table) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Table) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Table) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *Table) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
 }
 
 func (x *Table) GetSmallBlind() int64 {
 	if x != nil {
-		return x.SmallBlind
+		return x.SmallBlind
+	}
+	return 0
+}
+
+func (x *Table) GetBigBlind() int64 {
+	if x != nil {
+		return x.BigBlind
+	}
+	return 0
+}
+
+func (x *Table) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *Table) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *Table) GetCurrentPlayers() int32 {
+	if x != nil {
+		return x.CurrentPlayers
+	}
+	return 0
+}
+
+func (x *Table) GetMinBalance() int64 {
+	if x != nil {
+		return x.MinBalance
+	}
+	return 0
+}
+
+func (x *Table) GetBuyIn() int64 {
+	if x != nil {
+		return x.BuyIn
+	}
+	return 0
+}
+
+func (x *Table) GetPhase() GamePhase {
+	if x != nil {
+		return x.Phase
+	}
+	return GamePhase_WAITING
+}
+
+func (x *Table) GetGameStarted() bool {
+	if x != nil {
+		return x.GameStarted
+	}
+	return false
+}
+
+func (x *Table) GetAllPlayersReady() bool {
+	if x != nil {
+		return x.AllPlayersReady
+	}
+	return false
+}
+
+func (x *Table) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *Table) GetPasswordProtected() bool {
+	if x != nil {
+		return x.PasswordProtected
+	}
+	return false
+}
+
+func (x *Table) GetTimeBankSeconds() int32 {
+	if x != nil {
+		return x.TimeBankSeconds
+	}
+	return 0
+}
+
+func (x *Table) GetReservedSeats() []int32 {
+	if x != nil {
+		return x.ReservedSeats
+	}
+	return nil
+}
+
+func (x *Table) GetWaitlist() []string {
+	if x != nil {
+		return x.Waitlist
+	}
+	return nil
+}
+
+func (x *Table) GetSpectators() int32 {
+	if x != nil {
+		return x.Spectators
+	}
+	return 0
+}
+
+type CreateTableInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Table host issuing the invite
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	InviteeId     string                 `protobuf:"bytes,3,opt,name=invitee_id,json=inviteeId,proto3" json:"invitee_id,omitempty"`     // Player the invite is for (empty = anyone with the code)
+	TtlSeconds    int64                  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // Invite lifetime (0 = 24 hours)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTableInviteRequest) Reset() {
+	*x = CreateTableInviteRequest{}
+	mi := &file_poker_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTableInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTableInviteRequest) ProtoMessage() {}
+
+func (x *CreateTableInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTableInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateTableInviteRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateTableInviteRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *CreateTableInviteRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *CreateTableInviteRequest) GetInviteeId() string {
+	if x != nil {
+		return x.InviteeId
+	}
+	return ""
+}
+
+func (x *CreateTableInviteRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type CreateTableInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTableInviteResponse) Reset() {
+	*x = CreateTableInviteResponse{}
+	mi := &file_poker_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTableInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTableInviteResponse) ProtoMessage() {}
+
+func (x *CreateTableInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTableInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateTableInviteResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateTableInviteResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateTableInviteResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type KickPlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Table host
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // Player to remove from the table
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickPlayerRequest) Reset() {
+	*x = KickPlayerRequest{}
+	mi := &file_poker_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickPlayerRequest) ProtoMessage() {}
+
+func (x *KickPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickPlayerRequest.ProtoReflect.Descriptor instead.
+func (*KickPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *KickPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *KickPlayerRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *KickPlayerRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *KickPlayerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type KickPlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Refund        int64                  `protobuf:"varint,3,opt,name=refund,proto3" json:"refund,omitempty"` // Atoms credited to the kicked player for their chips
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickPlayerResponse) Reset() {
+	*x = KickPlayerResponse{}
+	mi := &file_poker_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickPlayerResponse) ProtoMessage() {}
+
+func (x *KickPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickPlayerResponse.ProtoReflect.Descriptor instead.
+func (*KickPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *KickPlayerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *KickPlayerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *KickPlayerResponse) GetRefund() int64 {
+	if x != nil {
+		return x.Refund
+	}
+	return 0
+}
+
+type MuteChatPlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Table host
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // Player whose chat messages are refused
+	Muted         bool                   `protobuf:"varint,4,opt,name=muted,proto3" json:"muted,omitempty"`                      // False lets the player chat again
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteChatPlayerRequest) Reset() {
+	*x = MuteChatPlayerRequest{}
+	mi := &file_poker_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteChatPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteChatPlayerRequest) ProtoMessage() {}
+
+func (x *MuteChatPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteChatPlayerRequest.ProtoReflect.Descriptor instead.
+func (*MuteChatPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MuteChatPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *MuteChatPlayerRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *MuteChatPlayerRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *MuteChatPlayerRequest) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+type MuteChatPlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteChatPlayerResponse) Reset() {
+	*x = MuteChatPlayerResponse{}
+	mi := &file_poker_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteChatPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteChatPlayerResponse) ProtoMessage() {}
+
+func (x *MuteChatPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteChatPlayerResponse.ProtoReflect.Descriptor instead.
+func (*MuteChatPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MuteChatPlayerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MuteChatPlayerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BanPlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Table host
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // Player barred from rejoining, kicked if seated
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanPlayerRequest) Reset() {
+	*x = BanPlayerRequest{}
+	mi := &file_poker_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanPlayerRequest) ProtoMessage() {}
+
+func (x *BanPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanPlayerRequest.ProtoReflect.Descriptor instead.
+func (*BanPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BanPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *BanPlayerRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *BanPlayerRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *BanPlayerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BanPlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Refund        int64                  `protobuf:"varint,3,opt,name=refund,proto3" json:"refund,omitempty"` // Atoms credited to the banned player if they were kicked
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanPlayerResponse) Reset() {
+	*x = BanPlayerResponse{}
+	mi := &file_poker_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanPlayerResponse) ProtoMessage() {}
+
+func (x *BanPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanPlayerResponse.ProtoReflect.Descriptor instead.
+func (*BanPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BanPlayerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BanPlayerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BanPlayerResponse) GetRefund() int64 {
+	if x != nil {
+		return x.Refund
+	}
+	return 0
+}
+
+type PauseTableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Table host
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseTableRequest) Reset() {
+	*x = PauseTableRequest{}
+	mi := &file_poker_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseTableRequest) ProtoMessage() {}
+
+func (x *PauseTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseTableRequest.ProtoReflect.Descriptor instead.
+func (*PauseTableRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PauseTableRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PauseTableRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type PauseTableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseTableResponse) Reset() {
+	*x = PauseTableResponse{}
+	mi := &file_poker_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseTableResponse) ProtoMessage() {}
+
+func (x *PauseTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseTableResponse.ProtoReflect.Descriptor instead.
+func (*PauseTableResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PauseTableResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PauseTableResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResumeTableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Table host
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTableRequest) Reset() {
+	*x = ResumeTableRequest{}
+	mi := &file_poker_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTableRequest) ProtoMessage() {}
+
+func (x *ResumeTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTableRequest.ProtoReflect.Descriptor instead.
+func (*ResumeTableRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ResumeTableRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ResumeTableRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type ResumeTableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTableResponse) Reset() {
+	*x = ResumeTableResponse{}
+	mi := &file_poker_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTableResponse) ProtoMessage() {}
+
+func (x *ResumeTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTableResponse.ProtoReflect.Descriptor instead.
+func (*ResumeTableResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ResumeTableResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResumeTableResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CloseTableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Table host
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseTableRequest) Reset() {
+	*x = CloseTableRequest{}
+	mi := &file_poker_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseTableRequest) ProtoMessage() {}
+
+func (x *CloseTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseTableRequest.ProtoReflect.Descriptor instead.
+func (*CloseTableRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CloseTableRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *CloseTableRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *CloseTableRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CloseTableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Tells whether the table closed or closes after the current hand
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseTableResponse) Reset() {
+	*x = CloseTableResponse{}
+	mi := &file_poker_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseTableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseTableResponse) ProtoMessage() {}
+
+func (x *CloseTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseTableResponse.ProtoReflect.Descriptor instead.
+func (*CloseTableResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CloseTableResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CloseTableResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AddBotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // Table host, who funds the bot's buy-in
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Strategy      string                 `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`                             // random, tag or equity
+	ThinkTimeMs   int64                  `protobuf:"varint,4,opt,name=think_time_ms,json=thinkTimeMs,proto3" json:"think_time_ms,omitempty"` // Delay before each bot action; 0 for the server default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBotRequest) Reset() {
+	*x = AddBotRequest{}
+	mi := &file_poker_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBotRequest) ProtoMessage() {}
+
+func (x *AddBotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBotRequest.ProtoReflect.Descriptor instead.
+func (*AddBotRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AddBotRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *AddBotRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *AddBotRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *AddBotRequest) GetThinkTimeMs() int64 {
+	if x != nil {
+		return x.ThinkTimeMs
+	}
+	return 0
+}
+
+type AddBotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	BotId         string                 `protobuf:"bytes,3,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"` // Player ID of the seated bot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBotResponse) Reset() {
+	*x = AddBotResponse{}
+	mi := &file_poker_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBotResponse) ProtoMessage() {}
+
+func (x *AddBotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBotResponse.ProtoReflect.Descriptor instead.
+func (*AddBotResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AddBotResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddBotResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddBotResponse) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+type GetBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_poker_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetBalanceRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balance       int64                  `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"` // DCR account balance (in atoms)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceResponse) Reset() {
+	*x = GetBalanceResponse{}
+	mi := &file_poker_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceResponse) ProtoMessage() {}
+
+func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetBalanceResponse) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type UpdateBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // DCR amount to add/subtract (in atoms, can be negative)
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBalanceRequest) Reset() {
+	*x = UpdateBalanceRequest{}
+	mi := &file_poker_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBalanceRequest) ProtoMessage() {}
+
+func (x *UpdateBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBalanceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateBalanceRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *UpdateBalanceRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateBalanceRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewBalance    int64                  `protobuf:"varint,1,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"` // New DCR account balance (in atoms)
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBalanceResponse) Reset() {
+	*x = UpdateBalanceResponse{}
+	mi := &file_poker_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBalanceResponse) ProtoMessage() {}
+
+func (x *UpdateBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBalanceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateBalanceResponse) GetNewBalance() int64 {
+	if x != nil {
+		return x.NewBalance
+	}
+	return 0
+}
+
+func (x *UpdateBalanceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ProcessTipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromPlayerId  string                 `protobuf:"bytes,1,opt,name=from_player_id,json=fromPlayerId,proto3" json:"from_player_id,omitempty"`
+	ToPlayerId    string                 `protobuf:"bytes,2,opt,name=to_player_id,json=toPlayerId,proto3" json:"to_player_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"` // DCR amount to tip (in atoms)
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessTipRequest) Reset() {
+	*x = ProcessTipRequest{}
+	mi := &file_poker_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessTipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessTipRequest) ProtoMessage() {}
+
+func (x *ProcessTipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessTipRequest.ProtoReflect.Descriptor instead.
+func (*ProcessTipRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ProcessTipRequest) GetFromPlayerId() string {
+	if x != nil {
+		return x.FromPlayerId
+	}
+	return ""
+}
+
+func (x *ProcessTipRequest) GetToPlayerId() string {
+	if x != nil {
+		return x.ToPlayerId
+	}
+	return ""
+}
+
+func (x *ProcessTipRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ProcessTipRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ProcessTipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	NewBalance    int64                  `protobuf:"varint,3,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"` // Recipient's new DCR account balance (in atoms)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessTipResponse) Reset() {
+	*x = ProcessTipResponse{}
+	mi := &file_poker_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessTipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessTipResponse) ProtoMessage() {}
+
+func (x *ProcessTipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessTipResponse.ProtoReflect.Descriptor instead.
+func (*ProcessTipResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ProcessTipResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProcessTipResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ProcessTipResponse) GetNewBalance() int64 {
+	if x != nil {
+		return x.NewBalance
+	}
+	return 0
+}
+
+type GetTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Maximum results per page (0 = server default)
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page, empty for the first
+	Types         []string               `protobuf:"bytes,4,rep,name=types,proto3" json:"types,omitempty"`                          // Only these transaction types (empty = all)
+	Since         int64                  `protobuf:"varint,5,opt,name=since,proto3" json:"since,omitempty"`                         // Unix seconds, inclusive (0 = no lower bound)
+	Until         int64                  `protobuf:"varint,6,opt,name=until,proto3" json:"until,omitempty"`                         // Unix seconds, exclusive (0 = no upper bound)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsRequest) Reset() {
+	*x = GetTransactionsRequest{}
+	mi := &file_poker_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsRequest) ProtoMessage() {}
+
+func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetTransactionsRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GetTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetTransactionsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *GetTransactionsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+type Transaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // DCR amount (in atoms, negative for debits)
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix seconds
+	PlayerId      string                 `protobuf:"bytes,6,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_poker_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Transaction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Transaction) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transaction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Transaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Transaction) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Transaction) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`                          // Newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty when there are no more pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsResponse) Reset() {
+	*x = GetTransactionsResponse{}
+	mi := &file_poker_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsResponse) ProtoMessage() {}
+
+func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RequestWithdrawalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                                   // DCR amount to withdraw (in atoms)
+	PayoutAddress string                 `protobuf:"bytes,3,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"` // Pay to this address; empty to be paid by Bison Relay tip
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestWithdrawalRequest) Reset() {
+	*x = RequestWithdrawalRequest{}
+	mi := &file_poker_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestWithdrawalRequest) ProtoMessage() {}
+
+func (x *RequestWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*RequestWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RequestWithdrawalRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *RequestWithdrawalRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RequestWithdrawalRequest) GetPayoutAddress() string {
+	if x != nil {
+		return x.PayoutAddress
+	}
+	return ""
+}
+
+type Withdrawal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                        // DCR amount (in atoms)
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`                         // "tip" or "address"
+	Destination   string                 `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`               // Payout address for the "address" method
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                         // pending, sent, confirmed or reversed
+	Detail        string                 `protobuf:"bytes,6,opt,name=detail,proto3" json:"detail,omitempty"`                         // Latest status detail
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Unix seconds
+	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Withdrawal) Reset() {
+	*x = Withdrawal{}
+	mi := &file_poker_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Withdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Withdrawal) ProtoMessage() {}
+
+func (x *Withdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Withdrawal.ProtoReflect.Descriptor instead.
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Withdrawal) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Withdrawal) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Withdrawal) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Withdrawal) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type RequestWithdrawalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Withdrawal    *Withdrawal            `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal,omitempty"`
+	NewBalance    int64                  `protobuf:"varint,2,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"` // DCR account balance after the request (in atoms)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestWithdrawalResponse) Reset() {
+	*x = RequestWithdrawalResponse{}
+	mi := &file_poker_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestWithdrawalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestWithdrawalResponse) ProtoMessage() {}
+
+func (x *RequestWithdrawalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestWithdrawalResponse.ProtoReflect.Descriptor instead.
+func (*RequestWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RequestWithdrawalResponse) GetWithdrawal() *Withdrawal {
+	if x != nil {
+		return x.Withdrawal
+	}
+	return nil
+}
+
+func (x *RequestWithdrawalResponse) GetNewBalance() int64 {
+	if x != nil {
+		return x.NewBalance
+	}
+	return 0
+}
+
+type GetWithdrawalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Maximum results (0 = server default)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWithdrawalsRequest) Reset() {
+	*x = GetWithdrawalsRequest{}
+	mi := &file_poker_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWithdrawalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWithdrawalsRequest) ProtoMessage() {}
+
+func (x *GetWithdrawalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWithdrawalsRequest.ProtoReflect.Descriptor instead.
+func (*GetWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetWithdrawalsRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GetWithdrawalsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetWithdrawalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Withdrawals   []*Withdrawal          `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`                  // Newest first
+	DailyLimit    int64                  `protobuf:"varint,2,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"` // Per-player limit over 24 hours (in atoms, 0 = none)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWithdrawalsResponse) Reset() {
+	*x = GetWithdrawalsResponse{}
+	mi := &file_poker_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWithdrawalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWithdrawalsResponse) ProtoMessage() {}
+
+func (x *GetWithdrawalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWithdrawalsResponse.ProtoReflect.Descriptor instead.
+func (*GetWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetWithdrawalsResponse) GetWithdrawals() []*Withdrawal {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
+func (x *GetWithdrawalsResponse) GetDailyLimit() int64 {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return 0
+}
+
+type StartNotificationStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartNotificationStreamRequest) Reset() {
+	*x = StartNotificationStreamRequest{}
+	mi := &file_poker_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartNotificationStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartNotificationStreamRequest) ProtoMessage() {}
+
+func (x *StartNotificationStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartNotificationStreamRequest.ProtoReflect.Descriptor instead.
+func (*StartNotificationStreamRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *StartNotificationStreamRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type Notification struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Type            NotificationType       `protobuf:"varint,1,opt,name=type,proto3,enum=poker.NotificationType" json:"type,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TableId         string                 `protobuf:"bytes,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	PlayerId        string                 `protobuf:"bytes,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Amount          int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Cards           []*Card                `protobuf:"bytes,6,rep,name=cards,proto3" json:"cards,omitempty"`
+	HandRank        HandRank               `protobuf:"varint,7,opt,name=hand_rank,json=handRank,proto3,enum=poker.HandRank" json:"hand_rank,omitempty"`
+	NewBalance      int64                  `protobuf:"varint,8,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	Table           *Table                 `protobuf:"bytes,9,opt,name=table,proto3" json:"table,omitempty"`
+	Ready           bool                   `protobuf:"varint,10,opt,name=ready,proto3" json:"ready,omitempty"`
+	Started         bool                   `protobuf:"varint,11,opt,name=started,proto3" json:"started,omitempty"`
+	GameReadyToPlay bool                   `protobuf:"varint,12,opt,name=game_ready_to_play,json=gameReadyToPlay,proto3" json:"game_ready_to_play,omitempty"`
+	Countdown       int32                  `protobuf:"varint,13,opt,name=countdown,proto3" json:"countdown,omitempty"`
+	Winners         []*Winner              `protobuf:"bytes,14,rep,name=winners,proto3" json:"winners,omitempty"`
+	Showdown        *Showdown              `protobuf:"bytes,15,opt,name=showdown,proto3" json:"showdown,omitempty"`
+	Seat            int32                  `protobuf:"varint,16,opt,name=seat,proto3" json:"seat,omitempty"` // Seat offered by SEAT_OFFERED, from 1
+	Auto            bool                   `protobuf:"varint,17,opt,name=auto,proto3" json:"auto,omitempty"` // PLAYER_SAT_OUT or PLAYER_KICKED done by the table: sat out for missed actions, removed for sitting out too long
+	Chat            []*ChatMessage         `protobuf:"bytes,18,rep,name=chat,proto3" json:"chat,omitempty"`  // CHAT_MESSAGE messages, oldest first
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_poker_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Notification) GetType() NotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationType_UNKNOWN
+}
+
+func (x *Notification) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Notification) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *Notification) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *Notification) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Notification) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *Notification) GetHandRank() HandRank {
+	if x != nil {
+		return x.HandRank
+	}
+	return HandRank_HIGH_CARD
+}
+
+func (x *Notification) GetNewBalance() int64 {
+	if x != nil {
+		return x.NewBalance
+	}
+	return 0
+}
+
+func (x *Notification) GetTable() *Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+func (x *Notification) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Notification) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+func (x *Notification) GetGameReadyToPlay() bool {
+	if x != nil {
+		return x.GameReadyToPlay
+	}
+	return false
+}
+
+func (x *Notification) GetCountdown() int32 {
+	if x != nil {
+		return x.Countdown
+	}
+	return 0
+}
+
+func (x *Notification) GetWinners() []*Winner {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+func (x *Notification) GetShowdown() *Showdown {
+	if x != nil {
+		return x.Showdown
+	}
+	return nil
+}
+
+func (x *Notification) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *Notification) GetAuto() bool {
+	if x != nil {
+		return x.Auto
+	}
+	return false
+}
+
+func (x *Notification) GetChat() []*ChatMessage {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	SentUnixMs    int64                  `protobuf:"varint,3,opt,name=sent_unix_ms,json=sentUnixMs,proto3" json:"sent_unix_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_poker_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ChatMessage) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetSentUnixMs() int64 {
+	if x != nil {
+		return x.SentUnixMs
+	}
+	return 0
+}
+
+type Showdown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Winners       []*Winner              `protobuf:"bytes,1,rep,name=winners,proto3" json:"winners,omitempty"`
+	Pot           int64                  `protobuf:"varint,2,opt,name=pot,proto3" json:"pot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Showdown) Reset() {
+	*x = Showdown{}
+	mi := &file_poker_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Showdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Showdown) ProtoMessage() {}
+
+func (x *Showdown) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Showdown.ProtoReflect.Descriptor instead.
+func (*Showdown) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Showdown) GetWinners() []*Winner {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+func (x *Showdown) GetPot() int64 {
+	if x != nil {
+		return x.Pot
+	}
+	return 0
+}
+
+// Common Messages
+type Player struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Balance         int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"` // Poker chips balance (in-game currency)
+	Hand            []*Card                `protobuf:"bytes,4,rep,name=hand,proto3" json:"hand,omitempty"`
+	CurrentBet      int64                  `protobuf:"varint,5,opt,name=current_bet,json=currentBet,proto3" json:"current_bet,omitempty"` // Current poker chips bet in this round
+	Folded          bool                   `protobuf:"varint,6,opt,name=folded,proto3" json:"folded,omitempty"`
+	IsTurn          bool                   `protobuf:"varint,7,opt,name=is_turn,json=isTurn,proto3" json:"is_turn,omitempty"`
+	IsAllIn         bool                   `protobuf:"varint,8,opt,name=is_all_in,json=isAllIn,proto3" json:"is_all_in,omitempty"`
+	IsDealer        bool                   `protobuf:"varint,9,opt,name=is_dealer,json=isDealer,proto3" json:"is_dealer,omitempty"`
+	IsReady         bool                   `protobuf:"varint,10,opt,name=is_ready,json=isReady,proto3" json:"is_ready,omitempty"`
+	HandDescription string                 `protobuf:"bytes,11,opt,name=hand_description,json=handDescription,proto3" json:"hand_description,omitempty"`    // Hand evaluation description (available during showdown)
+	Seat            int32                  `protobuf:"varint,12,opt,name=seat,proto3" json:"seat,omitempty"`                                                // Seat at the table, from 1 to max_players
+	SittingOut      bool                   `protobuf:"varint,13,opt,name=sitting_out,json=sittingOut,proto3" json:"sitting_out,omitempty"`                  // Keeps the seat but is not dealt in
+	TimeBankSeconds int32                  `protobuf:"varint,14,opt,name=time_bank_seconds,json=timeBankSeconds,proto3" json:"time_bank_seconds,omitempty"` // Time bank left to draw on with UseTimeBank
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	mi := &file_poker_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Player) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Player) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Player) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
 	}
 	return 0
 }
 
-func (x *Table) GetBigBlind() int64 {
+func (x *Player) GetHand() []*Card {
 	if x != nil {
-		return x.BigBlind
+		return x.Hand
 	}
-	return 0
+	return nil
 }
 
-func (x *Table) GetMaxPlayers() int32 {
+func (x *Player) GetCurrentBet() int64 {
 	if x != nil {
-		return x.MaxPlayers
+		return x.CurrentBet
 	}
 	return 0
 }
 
-func (x *Table) GetMinPlayers() int32 {
+func (x *Player) GetFolded() bool {
 	if x != nil {
-		return x.MinPlayers
+		return x.Folded
 	}
-	return 0
+	return false
 }
 
-func (x *Table) GetCurrentPlayers() int32 {
+func (x *Player) GetIsTurn() bool {
 	if x != nil {
-		return x.CurrentPlayers
+		return x.IsTurn
 	}
-	return 0
+	return false
 }
 
-func (x *Table) GetMinBalance() int64 {
+func (x *Player) GetIsAllIn() bool {
 	if x != nil {
-		return x.MinBalance
+		return x.IsAllIn
+	}
+	return false
+}
+
+func (x *Player) GetIsDealer() bool {
+	if x != nil {
+		return x.IsDealer
+	}
+	return false
+}
+
+func (x *Player) GetIsReady() bool {
+	if x != nil {
+		return x.IsReady
+	}
+	return false
+}
+
+func (x *Player) GetHandDescription() string {
+	if x != nil {
+		return x.HandDescription
+	}
+	return ""
+}
+
+func (x *Player) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
 	}
 	return 0
 }
 
-func (x *Table) GetBuyIn() int64 {
+func (x *Player) GetSittingOut() bool {
 	if x != nil {
-		return x.BuyIn
+		return x.SittingOut
+	}
+	return false
+}
+
+func (x *Player) GetTimeBankSeconds() int32 {
+	if x != nil {
+		return x.TimeBankSeconds
 	}
 	return 0
 }
 
-func (x *Table) GetPhase() GamePhase {
+type Card struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suit          string                 `protobuf:"bytes,1,opt,name=suit,proto3" json:"suit,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Card) Reset() {
+	*x = Card{}
+	mi := &file_poker_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Card) ProtoMessage() {}
+
+func (x *Card) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[71]
 	if x != nil {
-		return x.Phase
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return GamePhase_WAITING
+	return mi.MessageOf(x)
 }
 
-func (x *Table) GetGameStarted() bool {
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Card) GetSuit() string {
 	if x != nil {
-		return x.GameStarted
+		return x.Suit
+	}
+	return ""
+}
+
+func (x *Card) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SetPlayerReadyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPlayerReadyRequest) Reset() {
+	*x = SetPlayerReadyRequest{}
+	mi := &file_poker_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlayerReadyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerReadyRequest) ProtoMessage() {}
+
+func (x *SetPlayerReadyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerReadyRequest.ProtoReflect.Descriptor instead.
+func (*SetPlayerReadyRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SetPlayerReadyRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SetPlayerReadyRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type SetPlayerReadyResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	AllPlayersReady bool                   `protobuf:"varint,3,opt,name=all_players_ready,json=allPlayersReady,proto3" json:"all_players_ready,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetPlayerReadyResponse) Reset() {
+	*x = SetPlayerReadyResponse{}
+	mi := &file_poker_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPlayerReadyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPlayerReadyResponse) ProtoMessage() {}
+
+func (x *SetPlayerReadyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPlayerReadyResponse.ProtoReflect.Descriptor instead.
+func (*SetPlayerReadyResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SetPlayerReadyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
 	}
 	return false
 }
 
-func (x *Table) GetAllPlayersReady() bool {
+func (x *SetPlayerReadyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetPlayerReadyResponse) GetAllPlayersReady() bool {
 	if x != nil {
 		return x.AllPlayersReady
 	}
 	return false
 }
 
-type GetBalanceRequest struct {
+type SetPlayerUnreadyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBalanceRequest) Reset() {
-	*x = GetBalanceRequest{}
-	mi := &file_poker_proto_msgTypes[26]
+func (x *SetPlayerUnreadyRequest) Reset() {
+	*x = SetPlayerUnreadyRequest{}
+	mi := &file_poker_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBalanceRequest) String() string {
+func (x *SetPlayerUnreadyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBalanceRequest) ProtoMessage() {}
+func (*SetPlayerUnreadyRequest) ProtoMessage() {}
 
-func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[26]
+func (x *SetPlayerUnreadyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1864,40 +5154,48 @@ func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
-func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use SetPlayerUnreadyRequest.ProtoReflect.Descriptor instead.
+func (*SetPlayerUnreadyRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetBalanceRequest) GetPlayerId() string {
+func (x *SetPlayerUnreadyRequest) GetPlayerId() string {
 	if x != nil {
 		return x.PlayerId
 	}
 	return ""
 }
 
-type GetBalanceResponse struct {
+func (x *SetPlayerUnreadyRequest) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type SetPlayerUnreadyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Balance       int64                  `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"` // DCR account balance (in atoms)
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBalanceResponse) Reset() {
-	*x = GetBalanceResponse{}
-	mi := &file_poker_proto_msgTypes[27]
+func (x *SetPlayerUnreadyResponse) Reset() {
+	*x = SetPlayerUnreadyResponse{}
+	mi := &file_poker_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBalanceResponse) String() string {
+func (x *SetPlayerUnreadyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBalanceResponse) ProtoMessage() {}
+func (*SetPlayerUnreadyResponse) ProtoMessage() {}
 
-func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[27]
+func (x *SetPlayerUnreadyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1908,42 +5206,48 @@ func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
-func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SetPlayerUnreadyResponse.ProtoReflect.Descriptor instead.
+func (*SetPlayerUnreadyResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetBalanceResponse) GetBalance() int64 {
+func (x *SetPlayerUnreadyResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Balance
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-type UpdateBalanceRequest struct {
+func (x *SetPlayerUnreadyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SitOutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // DCR amount to add/subtract (in atoms, can be negative)
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBalanceRequest) Reset() {
-	*x = UpdateBalanceRequest{}
-	mi := &file_poker_proto_msgTypes[28]
+func (x *SitOutRequest) Reset() {
+	*x = SitOutRequest{}
+	mi := &file_poker_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBalanceRequest) String() string {
+func (x *SitOutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBalanceRequest) ProtoMessage() {}
+func (*SitOutRequest) ProtoMessage() {}
 
-func (x *UpdateBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[28]
+func (x *SitOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1954,55 +5258,48 @@ func (x *UpdateBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBalanceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use SitOutRequest.ProtoReflect.Descriptor instead.
+func (*SitOutRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *UpdateBalanceRequest) GetPlayerId() string {
+func (x *SitOutRequest) GetPlayerId() string {
 	if x != nil {
 		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *UpdateBalanceRequest) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *UpdateBalanceRequest) GetDescription() string {
+func (x *SitOutRequest) GetTableId() string {
 	if x != nil {
-		return x.Description
+		return x.TableId
 	}
 	return ""
 }
 
-type UpdateBalanceResponse struct {
+type SitOutResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NewBalance    int64                  `protobuf:"varint,1,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"` // New DCR account balance (in atoms)
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBalanceResponse) Reset() {
-	*x = UpdateBalanceResponse{}
-	mi := &file_poker_proto_msgTypes[29]
+func (x *SitOutResponse) Reset() {
+	*x = SitOutResponse{}
+	mi := &file_poker_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBalanceResponse) String() string {
+func (x *SitOutResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBalanceResponse) ProtoMessage() {}
+func (*SitOutResponse) ProtoMessage() {}
 
-func (x *UpdateBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[29]
+func (x *SitOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2013,50 +5310,48 @@ func (x *UpdateBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBalanceResponse.ProtoReflect.Descriptor instead.
-func (*UpdateBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use SitOutResponse.ProtoReflect.Descriptor instead.
+func (*SitOutResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *UpdateBalanceResponse) GetNewBalance() int64 {
+func (x *SitOutResponse) GetSuccess() bool {
 	if x != nil {
-		return x.NewBalance
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *UpdateBalanceResponse) GetMessage() string {
+func (x *SitOutResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type ProcessTipRequest struct {
+type SitInRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromPlayerId  string                 `protobuf:"bytes,1,opt,name=from_player_id,json=fromPlayerId,proto3" json:"from_player_id,omitempty"`
-	ToPlayerId    string                 `protobuf:"bytes,2,opt,name=to_player_id,json=toPlayerId,proto3" json:"to_player_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"` // DCR amount to tip (in atoms)
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessTipRequest) Reset() {
-	*x = ProcessTipRequest{}
-	mi := &file_poker_proto_msgTypes[30]
+func (x *SitInRequest) Reset() {
+	*x = SitInRequest{}
+	mi := &file_poker_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessTipRequest) String() string {
+func (x *SitInRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessTipRequest) ProtoMessage() {}
+func (*SitInRequest) ProtoMessage() {}
 
-func (x *ProcessTipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[30]
+func (x *SitInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2067,63 +5362,48 @@ func (x *ProcessTipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessTipRequest.ProtoReflect.Descriptor instead.
-func (*ProcessTipRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *ProcessTipRequest) GetFromPlayerId() string {
-	if x != nil {
-		return x.FromPlayerId
-	}
-	return ""
+// Deprecated: Use SitInRequest.ProtoReflect.Descriptor instead.
+func (*SitInRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ProcessTipRequest) GetToPlayerId() string {
+func (x *SitInRequest) GetPlayerId() string {
 	if x != nil {
-		return x.ToPlayerId
+		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *ProcessTipRequest) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *ProcessTipRequest) GetMessage() string {
+func (x *SitInRequest) GetTableId() string {
 	if x != nil {
-		return x.Message
+		return x.TableId
 	}
 	return ""
 }
 
-type ProcessTipResponse struct {
+type SitInResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	NewBalance    int64                  `protobuf:"varint,3,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"` // Recipient's new DCR account balance (in atoms)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessTipResponse) Reset() {
-	*x = ProcessTipResponse{}
-	mi := &file_poker_proto_msgTypes[31]
+func (x *SitInResponse) Reset() {
+	*x = SitInResponse{}
+	mi := &file_poker_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessTipResponse) String() string {
+func (x *SitInResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessTipResponse) ProtoMessage() {}
+func (*SitInResponse) ProtoMessage() {}
 
-func (x *ProcessTipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[31]
+func (x *SitInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2134,54 +5414,47 @@ func (x *ProcessTipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessTipResponse.ProtoReflect.Descriptor instead.
-func (*ProcessTipResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SitInResponse.ProtoReflect.Descriptor instead.
+func (*SitInResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ProcessTipResponse) GetSuccess() bool {
+func (x *SitInResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ProcessTipResponse) GetMessage() string {
+func (x *SitInResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ProcessTipResponse) GetNewBalance() int64 {
-	if x != nil {
-		return x.NewBalance
-	}
-	return 0
-}
-
-type StartNotificationStreamRequest struct {
+type GetPlayerCurrentTableRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartNotificationStreamRequest) Reset() {
-	*x = StartNotificationStreamRequest{}
-	mi := &file_poker_proto_msgTypes[32]
+func (x *GetPlayerCurrentTableRequest) Reset() {
+	*x = GetPlayerCurrentTableRequest{}
+	mi := &file_poker_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartNotificationStreamRequest) String() string {
+func (x *GetPlayerCurrentTableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartNotificationStreamRequest) ProtoMessage() {}
+func (*GetPlayerCurrentTableRequest) ProtoMessage() {}
 
-func (x *StartNotificationStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[32]
+func (x *GetPlayerCurrentTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2192,54 +5465,40 @@ func (x *StartNotificationStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartNotificationStreamRequest.ProtoReflect.Descriptor instead.
-func (*StartNotificationStreamRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use GetPlayerCurrentTableRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerCurrentTableRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *StartNotificationStreamRequest) GetPlayerId() string {
+func (x *GetPlayerCurrentTableRequest) GetPlayerId() string {
 	if x != nil {
 		return x.PlayerId
 	}
 	return ""
 }
 
-type Notification struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Type            NotificationType       `protobuf:"varint,1,opt,name=type,proto3,enum=poker.NotificationType" json:"type,omitempty"`
-	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	TableId         string                 `protobuf:"bytes,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	PlayerId        string                 `protobuf:"bytes,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	Amount          int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Cards           []*Card                `protobuf:"bytes,6,rep,name=cards,proto3" json:"cards,omitempty"`
-	HandRank        HandRank               `protobuf:"varint,7,opt,name=hand_rank,json=handRank,proto3,enum=poker.HandRank" json:"hand_rank,omitempty"`
-	NewBalance      int64                  `protobuf:"varint,8,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
-	Table           *Table                 `protobuf:"bytes,9,opt,name=table,proto3" json:"table,omitempty"`
-	Ready           bool                   `protobuf:"varint,10,opt,name=ready,proto3" json:"ready,omitempty"`
-	Started         bool                   `protobuf:"varint,11,opt,name=started,proto3" json:"started,omitempty"`
-	GameReadyToPlay bool                   `protobuf:"varint,12,opt,name=game_ready_to_play,json=gameReadyToPlay,proto3" json:"game_ready_to_play,omitempty"`
-	Countdown       int32                  `protobuf:"varint,13,opt,name=countdown,proto3" json:"countdown,omitempty"`
-	Winners         []*Winner              `protobuf:"bytes,14,rep,name=winners,proto3" json:"winners,omitempty"`
-	Showdown        *Showdown              `protobuf:"bytes,15,opt,name=showdown,proto3" json:"showdown,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetPlayerCurrentTableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Notification) Reset() {
-	*x = Notification{}
-	mi := &file_poker_proto_msgTypes[33]
+func (x *GetPlayerCurrentTableResponse) Reset() {
+	*x = GetPlayerCurrentTableResponse{}
+	mi := &file_poker_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Notification) String() string {
+func (x *GetPlayerCurrentTableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Notification) ProtoMessage() {}
+func (*GetPlayerCurrentTableResponse) ProtoMessage() {}
 
-func (x *Notification) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[33]
+func (x *GetPlayerCurrentTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2250,139 +5509,197 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
-func (*Notification) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GetPlayerCurrentTableResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerCurrentTableResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *Notification) GetType() NotificationType {
+func (x *GetPlayerCurrentTableResponse) GetTableId() string {
 	if x != nil {
-		return x.Type
+		return x.TableId
 	}
-	return NotificationType_UNKNOWN
+	return ""
 }
 
-func (x *Notification) GetMessage() string {
+type ShowCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowCardsRequest) Reset() {
+	*x = ShowCardsRequest{}
+	mi := &file_poker_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowCardsRequest) ProtoMessage() {}
+
+func (x *ShowCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[82]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Notification) GetTableId() string {
+// Deprecated: Use ShowCardsRequest.ProtoReflect.Descriptor instead.
+func (*ShowCardsRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ShowCardsRequest) GetPlayerId() string {
 	if x != nil {
-		return x.TableId
+		return x.PlayerId
 	}
 	return ""
 }
 
-func (x *Notification) GetPlayerId() string {
+func (x *ShowCardsRequest) GetTableId() string {
 	if x != nil {
-		return x.PlayerId
+		return x.TableId
 	}
 	return ""
 }
 
-func (x *Notification) GetAmount() int64 {
+type ShowCardsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowCardsResponse) Reset() {
+	*x = ShowCardsResponse{}
+	mi := &file_poker_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowCardsResponse) ProtoMessage() {}
+
+func (x *ShowCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[83]
 	if x != nil {
-		return x.Amount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Notification) GetCards() []*Card {
-	if x != nil {
-		return x.Cards
-	}
-	return nil
+// Deprecated: Use ShowCardsResponse.ProtoReflect.Descriptor instead.
+func (*ShowCardsResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *Notification) GetHandRank() HandRank {
+func (x *ShowCardsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.HandRank
+		return x.Success
 	}
-	return HandRank_HIGH_CARD
+	return false
 }
 
-func (x *Notification) GetNewBalance() int64 {
+func (x *ShowCardsResponse) GetMessage() string {
 	if x != nil {
-		return x.NewBalance
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *Notification) GetTable() *Table {
-	if x != nil {
-		return x.Table
-	}
-	return nil
+type HideCardsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Notification) GetReady() bool {
-	if x != nil {
-		return x.Ready
-	}
-	return false
+func (x *HideCardsRequest) Reset() {
+	*x = HideCardsRequest{}
+	mi := &file_poker_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Notification) GetStarted() bool {
-	if x != nil {
-		return x.Started
-	}
-	return false
+func (x *HideCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Notification) GetGameReadyToPlay() bool {
+func (*HideCardsRequest) ProtoMessage() {}
+
+func (x *HideCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[84]
 	if x != nil {
-		return x.GameReadyToPlay
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Notification) GetCountdown() int32 {
-	if x != nil {
-		return x.Countdown
-	}
-	return 0
+// Deprecated: Use HideCardsRequest.ProtoReflect.Descriptor instead.
+func (*HideCardsRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *Notification) GetWinners() []*Winner {
+func (x *HideCardsRequest) GetPlayerId() string {
 	if x != nil {
-		return x.Winners
+		return x.PlayerId
 	}
-	return nil
+	return ""
 }
 
-func (x *Notification) GetShowdown() *Showdown {
+func (x *HideCardsRequest) GetTableId() string {
 	if x != nil {
-		return x.Showdown
+		return x.TableId
 	}
-	return nil
+	return ""
 }
 
-type Showdown struct {
+type HideCardsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Winners       []*Winner              `protobuf:"bytes,1,rep,name=winners,proto3" json:"winners,omitempty"`
-	Pot           int64                  `protobuf:"varint,2,opt,name=pot,proto3" json:"pot,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Showdown) Reset() {
-	*x = Showdown{}
-	mi := &file_poker_proto_msgTypes[34]
+func (x *HideCardsResponse) Reset() {
+	*x = HideCardsResponse{}
+	mi := &file_poker_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Showdown) String() string {
+func (x *HideCardsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Showdown) ProtoMessage() {}
+func (*HideCardsResponse) ProtoMessage() {}
 
-func (x *Showdown) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[34]
+func (x *HideCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2393,58 +5710,46 @@ func (x *Showdown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Showdown.ProtoReflect.Descriptor instead.
-func (*Showdown) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use HideCardsResponse.ProtoReflect.Descriptor instead.
+func (*HideCardsResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *Showdown) GetWinners() []*Winner {
+func (x *HideCardsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Winners
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *Showdown) GetPot() int64 {
+func (x *HideCardsResponse) GetMessage() string {
 	if x != nil {
-		return x.Pot
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-// Common Messages
-type Player struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Balance         int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"` // Poker chips balance (in-game currency)
-	Hand            []*Card                `protobuf:"bytes,4,rep,name=hand,proto3" json:"hand,omitempty"`
-	CurrentBet      int64                  `protobuf:"varint,5,opt,name=current_bet,json=currentBet,proto3" json:"current_bet,omitempty"` // Current poker chips bet in this round
-	Folded          bool                   `protobuf:"varint,6,opt,name=folded,proto3" json:"folded,omitempty"`
-	IsTurn          bool                   `protobuf:"varint,7,opt,name=is_turn,json=isTurn,proto3" json:"is_turn,omitempty"`
-	IsAllIn         bool                   `protobuf:"varint,8,opt,name=is_all_in,json=isAllIn,proto3" json:"is_all_in,omitempty"`
-	IsDealer        bool                   `protobuf:"varint,9,opt,name=is_dealer,json=isDealer,proto3" json:"is_dealer,omitempty"`
-	IsReady         bool                   `protobuf:"varint,10,opt,name=is_ready,json=isReady,proto3" json:"is_ready,omitempty"`
-	HandDescription string                 `protobuf:"bytes,11,opt,name=hand_description,json=handDescription,proto3" json:"hand_description,omitempty"` // Hand evaluation description (available during showdown)
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type AdminListTablesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Player) Reset() {
-	*x = Player{}
-	mi := &file_poker_proto_msgTypes[35]
+func (x *AdminListTablesRequest) Reset() {
+	*x = AdminListTablesRequest{}
+	mi := &file_poker_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Player) String() string {
+func (x *AdminListTablesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Player) ProtoMessage() {}
+func (*AdminListTablesRequest) ProtoMessage() {}
 
-func (x *Player) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[35]
+func (x *AdminListTablesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2455,111 +5760,118 @@ func (x *Player) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Player.ProtoReflect.Descriptor instead.
-func (*Player) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use AdminListTablesRequest.ProtoReflect.Descriptor instead.
+func (*AdminListTablesRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *Player) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+type AdminTable struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Table          *Table                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Chips          map[string]int64       `protobuf:"bytes,2,rep,name=chips,proto3" json:"chips,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Chips of each seated player
+	HandInProgress bool                   `protobuf:"varint,3,opt,name=hand_in_progress,json=handInProgress,proto3" json:"hand_in_progress,omitempty"`
+	Paused         bool                   `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	Closing        bool                   `protobuf:"varint,5,opt,name=closing,proto3" json:"closing,omitempty"`
+	LastAction     int64                  `protobuf:"varint,6,opt,name=last_action,json=lastAction,proto3" json:"last_action,omitempty"` // Unix seconds
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Player) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *AdminTable) Reset() {
+	*x = AdminTable{}
+	mi := &file_poker_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Player) GetBalance() int64 {
-	if x != nil {
-		return x.Balance
-	}
-	return 0
+func (x *AdminTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Player) GetHand() []*Card {
+func (*AdminTable) ProtoMessage() {}
+
+func (x *AdminTable) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[87]
 	if x != nil {
-		return x.Hand
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Player) GetCurrentBet() int64 {
-	if x != nil {
-		return x.CurrentBet
-	}
-	return 0
+// Deprecated: Use AdminTable.ProtoReflect.Descriptor instead.
+func (*AdminTable) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *Player) GetFolded() bool {
+func (x *AdminTable) GetTable() *Table {
 	if x != nil {
-		return x.Folded
+		return x.Table
 	}
-	return false
+	return nil
 }
 
-func (x *Player) GetIsTurn() bool {
+func (x *AdminTable) GetChips() map[string]int64 {
 	if x != nil {
-		return x.IsTurn
+		return x.Chips
 	}
-	return false
+	return nil
 }
 
-func (x *Player) GetIsAllIn() bool {
+func (x *AdminTable) GetHandInProgress() bool {
 	if x != nil {
-		return x.IsAllIn
+		return x.HandInProgress
 	}
 	return false
 }
 
-func (x *Player) GetIsDealer() bool {
+func (x *AdminTable) GetPaused() bool {
 	if x != nil {
-		return x.IsDealer
+		return x.Paused
 	}
 	return false
 }
 
-func (x *Player) GetIsReady() bool {
+func (x *AdminTable) GetClosing() bool {
 	if x != nil {
-		return x.IsReady
+		return x.Closing
 	}
 	return false
 }
 
-func (x *Player) GetHandDescription() string {
+func (x *AdminTable) GetLastAction() int64 {
 	if x != nil {
-		return x.HandDescription
+		return x.LastAction
 	}
-	return ""
+	return 0
 }
 
-type Card struct {
+type AdminListTablesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Suit          string                 `protobuf:"bytes,1,opt,name=suit,proto3" json:"suit,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Tables        []*AdminTable          `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	Draining      bool                   `protobuf:"varint,2,opt,name=draining,proto3" json:"draining,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Card) Reset() {
-	*x = Card{}
-	mi := &file_poker_proto_msgTypes[36]
+func (x *AdminListTablesResponse) Reset() {
+	*x = AdminListTablesResponse{}
+	mi := &file_poker_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Card) String() string {
+func (x *AdminListTablesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Card) ProtoMessage() {}
+func (*AdminListTablesResponse) ProtoMessage() {}
 
-func (x *Card) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[36]
+func (x *AdminListTablesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2570,48 +5882,48 @@ func (x *Card) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Card.ProtoReflect.Descriptor instead.
-func (*Card) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use AdminListTablesResponse.ProtoReflect.Descriptor instead.
+func (*AdminListTablesResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *Card) GetSuit() string {
+func (x *AdminListTablesResponse) GetTables() []*AdminTable {
 	if x != nil {
-		return x.Suit
+		return x.Tables
 	}
-	return ""
+	return nil
 }
 
-func (x *Card) GetValue() string {
+func (x *AdminListTablesResponse) GetDraining() bool {
 	if x != nil {
-		return x.Value
+		return x.Draining
 	}
-	return ""
+	return false
 }
 
-type SetPlayerReadyRequest struct {
+type AdminEndGameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetPlayerReadyRequest) Reset() {
-	*x = SetPlayerReadyRequest{}
-	mi := &file_poker_proto_msgTypes[37]
+func (x *AdminEndGameRequest) Reset() {
+	*x = AdminEndGameRequest{}
+	mi := &file_poker_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPlayerReadyRequest) String() string {
+func (x *AdminEndGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPlayerReadyRequest) ProtoMessage() {}
+func (*AdminEndGameRequest) ProtoMessage() {}
 
-func (x *SetPlayerReadyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[37]
+func (x *AdminEndGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2622,49 +5934,47 @@ func (x *SetPlayerReadyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPlayerReadyRequest.ProtoReflect.Descriptor instead.
-func (*SetPlayerReadyRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use AdminEndGameRequest.ProtoReflect.Descriptor instead.
+func (*AdminEndGameRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *SetPlayerReadyRequest) GetPlayerId() string {
+func (x *AdminEndGameRequest) GetTableId() string {
 	if x != nil {
-		return x.PlayerId
+		return x.TableId
 	}
 	return ""
 }
 
-func (x *SetPlayerReadyRequest) GetTableId() string {
+func (x *AdminEndGameRequest) GetReason() string {
 	if x != nil {
-		return x.TableId
+		return x.Reason
 	}
 	return ""
 }
 
-type SetPlayerReadyResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	AllPlayersReady bool                   `protobuf:"varint,3,opt,name=all_players_ready,json=allPlayersReady,proto3" json:"all_players_ready,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type AdminEndGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetPlayerReadyResponse) Reset() {
-	*x = SetPlayerReadyResponse{}
-	mi := &file_poker_proto_msgTypes[38]
+func (x *AdminEndGameResponse) Reset() {
+	*x = AdminEndGameResponse{}
+	mi := &file_poker_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPlayerReadyResponse) String() string {
+func (x *AdminEndGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPlayerReadyResponse) ProtoMessage() {}
-
-func (x *SetPlayerReadyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[38]
+func (*AdminEndGameResponse) ProtoMessage() {}
+
+func (x *AdminEndGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2675,55 +5985,41 @@ func (x *SetPlayerReadyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPlayerReadyResponse.ProtoReflect.Descriptor instead.
-func (*SetPlayerReadyResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *SetPlayerReadyResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use AdminEndGameResponse.ProtoReflect.Descriptor instead.
+func (*AdminEndGameResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *SetPlayerReadyResponse) GetMessage() string {
+func (x *AdminEndGameResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *SetPlayerReadyResponse) GetAllPlayersReady() bool {
-	if x != nil {
-		return x.AllPlayersReady
-	}
-	return false
-}
-
-type SetPlayerUnreadyRequest struct {
+type AdminDeleteTableRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetPlayerUnreadyRequest) Reset() {
-	*x = SetPlayerUnreadyRequest{}
-	mi := &file_poker_proto_msgTypes[39]
+func (x *AdminDeleteTableRequest) Reset() {
+	*x = AdminDeleteTableRequest{}
+	mi := &file_poker_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPlayerUnreadyRequest) String() string {
+func (x *AdminDeleteTableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPlayerUnreadyRequest) ProtoMessage() {}
+func (*AdminDeleteTableRequest) ProtoMessage() {}
 
-func (x *SetPlayerUnreadyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[39]
+func (x *AdminDeleteTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2734,48 +6030,47 @@ func (x *SetPlayerUnreadyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPlayerUnreadyRequest.ProtoReflect.Descriptor instead.
-func (*SetPlayerUnreadyRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use AdminDeleteTableRequest.ProtoReflect.Descriptor instead.
+func (*AdminDeleteTableRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *SetPlayerUnreadyRequest) GetPlayerId() string {
+func (x *AdminDeleteTableRequest) GetTableId() string {
 	if x != nil {
-		return x.PlayerId
+		return x.TableId
 	}
 	return ""
 }
 
-func (x *SetPlayerUnreadyRequest) GetTableId() string {
+func (x *AdminDeleteTableRequest) GetReason() string {
 	if x != nil {
-		return x.TableId
+		return x.Reason
 	}
 	return ""
 }
 
-type SetPlayerUnreadyResponse struct {
+type AdminDeleteTableResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetPlayerUnreadyResponse) Reset() {
-	*x = SetPlayerUnreadyResponse{}
-	mi := &file_poker_proto_msgTypes[40]
+func (x *AdminDeleteTableResponse) Reset() {
+	*x = AdminDeleteTableResponse{}
+	mi := &file_poker_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetPlayerUnreadyResponse) String() string {
+func (x *AdminDeleteTableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetPlayerUnreadyResponse) ProtoMessage() {}
+func (*AdminDeleteTableResponse) ProtoMessage() {}
 
-func (x *SetPlayerUnreadyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[40]
+func (x *AdminDeleteTableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2786,47 +6081,42 @@ func (x *SetPlayerUnreadyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetPlayerUnreadyResponse.ProtoReflect.Descriptor instead.
-func (*SetPlayerUnreadyResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *SetPlayerUnreadyResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use AdminDeleteTableResponse.ProtoReflect.Descriptor instead.
+func (*AdminDeleteTableResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *SetPlayerUnreadyResponse) GetMessage() string {
+func (x *AdminDeleteTableResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type GetPlayerCurrentTableRequest struct {
+type AdjustBalanceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // DCR amount to add/subtract (in atoms, can be negative)
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`  // Required; recorded in the ledger
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPlayerCurrentTableRequest) Reset() {
-	*x = GetPlayerCurrentTableRequest{}
-	mi := &file_poker_proto_msgTypes[41]
+func (x *AdjustBalanceRequest) Reset() {
+	*x = AdjustBalanceRequest{}
+	mi := &file_poker_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlayerCurrentTableRequest) String() string {
+func (x *AdjustBalanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlayerCurrentTableRequest) ProtoMessage() {}
+func (*AdjustBalanceRequest) ProtoMessage() {}
 
-func (x *GetPlayerCurrentTableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[41]
+func (x *AdjustBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2837,40 +6127,54 @@ func (x *GetPlayerCurrentTableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlayerCurrentTableRequest.ProtoReflect.Descriptor instead.
-func (*GetPlayerCurrentTableRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use AdjustBalanceRequest.ProtoReflect.Descriptor instead.
+func (*AdjustBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetPlayerCurrentTableRequest) GetPlayerId() string {
+func (x *AdjustBalanceRequest) GetPlayerId() string {
 	if x != nil {
 		return x.PlayerId
 	}
 	return ""
 }
 
-type GetPlayerCurrentTableResponse struct {
+func (x *AdjustBalanceRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AdjustBalanceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdjustBalanceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TableId       string                 `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	NewBalance    int64                  `protobuf:"varint,1,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"` // New DCR account balance (in atoms)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPlayerCurrentTableResponse) Reset() {
-	*x = GetPlayerCurrentTableResponse{}
-	mi := &file_poker_proto_msgTypes[42]
+func (x *AdjustBalanceResponse) Reset() {
+	*x = AdjustBalanceResponse{}
+	mi := &file_poker_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlayerCurrentTableResponse) String() string {
+func (x *AdjustBalanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlayerCurrentTableResponse) ProtoMessage() {}
+func (*AdjustBalanceResponse) ProtoMessage() {}
 
-func (x *GetPlayerCurrentTableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[42]
+func (x *AdjustBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2881,41 +6185,40 @@ func (x *GetPlayerCurrentTableResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlayerCurrentTableResponse.ProtoReflect.Descriptor instead.
-func (*GetPlayerCurrentTableResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use AdjustBalanceResponse.ProtoReflect.Descriptor instead.
+func (*AdjustBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *GetPlayerCurrentTableResponse) GetTableId() string {
+func (x *AdjustBalanceResponse) GetNewBalance() int64 {
 	if x != nil {
-		return x.TableId
+		return x.NewBalance
 	}
-	return ""
+	return 0
 }
 
-type ShowCardsRequest struct {
+type BroadcastRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShowCardsRequest) Reset() {
-	*x = ShowCardsRequest{}
-	mi := &file_poker_proto_msgTypes[43]
+func (x *BroadcastRequest) Reset() {
+	*x = BroadcastRequest{}
+	mi := &file_poker_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShowCardsRequest) String() string {
+func (x *BroadcastRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShowCardsRequest) ProtoMessage() {}
+func (*BroadcastRequest) ProtoMessage() {}
 
-func (x *ShowCardsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[43]
+func (x *BroadcastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2926,48 +6229,40 @@ func (x *ShowCardsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShowCardsRequest.ProtoReflect.Descriptor instead.
-func (*ShowCardsRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *ShowCardsRequest) GetPlayerId() string {
-	if x != nil {
-		return x.PlayerId
-	}
-	return ""
+// Deprecated: Use BroadcastRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *ShowCardsRequest) GetTableId() string {
+func (x *BroadcastRequest) GetMessage() string {
 	if x != nil {
-		return x.TableId
+		return x.Message
 	}
 	return ""
 }
 
-type ShowCardsResponse struct {
+type BroadcastResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Recipients    int32                  `protobuf:"varint,1,opt,name=recipients,proto3" json:"recipients,omitempty"` // Notification streams the message was sent to
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShowCardsResponse) Reset() {
-	*x = ShowCardsResponse{}
-	mi := &file_poker_proto_msgTypes[44]
+func (x *BroadcastResponse) Reset() {
+	*x = BroadcastResponse{}
+	mi := &file_poker_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShowCardsResponse) String() string {
+func (x *BroadcastResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShowCardsResponse) ProtoMessage() {}
+func (*BroadcastResponse) ProtoMessage() {}
 
-func (x *ShowCardsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[44]
+func (x *BroadcastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2978,48 +6273,41 @@ func (x *ShowCardsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShowCardsResponse.ProtoReflect.Descriptor instead.
-func (*ShowCardsResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *ShowCardsResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use BroadcastResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *ShowCardsResponse) GetMessage() string {
+func (x *BroadcastResponse) GetRecipients() int32 {
 	if x != nil {
-		return x.Message
+		return x.Recipients
 	}
-	return ""
+	return 0
 }
 
-type HideCardsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	TableId       string                 `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DrainRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Message        string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`                                      // Announced to every notification stream when set
+	TimeoutSeconds int64                  `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // Longest wait for running hands to finish (0 = server default)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *HideCardsRequest) Reset() {
-	*x = HideCardsRequest{}
-	mi := &file_poker_proto_msgTypes[45]
+func (x *DrainRequest) Reset() {
+	*x = DrainRequest{}
+	mi := &file_poker_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HideCardsRequest) String() string {
+func (x *DrainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HideCardsRequest) ProtoMessage() {}
+func (*DrainRequest) ProtoMessage() {}
 
-func (x *HideCardsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[45]
+func (x *DrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3030,48 +6318,49 @@ func (x *HideCardsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HideCardsRequest.ProtoReflect.Descriptor instead.
-func (*HideCardsRequest) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use DrainRequest.ProtoReflect.Descriptor instead.
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *HideCardsRequest) GetPlayerId() string {
+func (x *DrainRequest) GetMessage() string {
 	if x != nil {
-		return x.PlayerId
+		return x.Message
 	}
 	return ""
 }
 
-func (x *HideCardsRequest) GetTableId() string {
+func (x *DrainRequest) GetTimeoutSeconds() int64 {
 	if x != nil {
-		return x.TableId
+		return x.TimeoutSeconds
 	}
-	return ""
+	return 0
 }
 
-type HideCardsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DrainResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ActiveTables    int32                  `protobuf:"varint,1,opt,name=active_tables,json=activeTables,proto3" json:"active_tables,omitempty"`
+	HandsInProgress int32                  `protobuf:"varint,2,opt,name=hands_in_progress,json=handsInProgress,proto3" json:"hands_in_progress,omitempty"`
+	Deadline        int64                  `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"` // Unix seconds by which the server is drained
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *HideCardsResponse) Reset() {
-	*x = HideCardsResponse{}
-	mi := &file_poker_proto_msgTypes[46]
+func (x *DrainResponse) Reset() {
+	*x = DrainResponse{}
+	mi := &file_poker_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HideCardsResponse) String() string {
+func (x *DrainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HideCardsResponse) ProtoMessage() {}
+func (*DrainResponse) ProtoMessage() {}
 
-func (x *HideCardsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_poker_proto_msgTypes[46]
+func (x *DrainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poker_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3082,33 +6371,41 @@ func (x *HideCardsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HideCardsResponse.ProtoReflect.Descriptor instead.
-func (*HideCardsResponse) Descriptor() ([]byte, []int) {
-	return file_poker_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use DrainResponse.ProtoReflect.Descriptor instead.
+func (*DrainResponse) Descriptor() ([]byte, []int) {
+	return file_poker_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *HideCardsResponse) GetSuccess() bool {
+func (x *DrainResponse) GetActiveTables() int32 {
 	if x != nil {
-		return x.Success
+		return x.ActiveTables
 	}
-	return false
+	return 0
 }
 
-func (x *HideCardsResponse) GetMessage() string {
+func (x *DrainResponse) GetHandsInProgress() int32 {
 	if x != nil {
-		return x.Message
+		return x.HandsInProgress
 	}
-	return ""
+	return 0
+}
+
+func (x *DrainResponse) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
 }
 
 var File_poker_proto protoreflect.FileDescriptor
 
 const file_poker_proto_rawDesc = "" +
 	"\n" +
-	"\vpoker.proto\x12\x05poker\"P\n" +
+	"\vpoker.proto\x12\x05poker\"l\n" +
 	"\x16StartGameStreamRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
-	"\btable_id\x18\x02 \x01(\tR\atableId\"\xd6\x03\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x1a\n" +
+	"\bspectate\x18\x03 \x01(\bR\bspectate\"\xad\x04\n" +
 	"\n" +
 	"GameUpdate\x12\x19\n" +
 	"\btable_id\x18\x01 \x01(\tR\atableId\x12&\n" +
@@ -3126,7 +6423,11 @@ const file_poker_proto_rawDesc = "" +
 	"\x10players_required\x18\v \x01(\x05R\x0fplayersRequired\x12%\n" +
 	"\x0eplayers_joined\x18\f \x01(\x05R\rplayersJoined\x12\x1d\n" +
 	"\n" +
-	"phase_name\x18\r \x01(\tR\tphaseName\"`\n" +
+	"phase_name\x18\r \x01(\tR\tphaseName\x125\n" +
+	"\x17action_deadline_unix_ms\x18\x0e \x01(\x03R\x14actionDeadlineUnixMs\x12\x1e\n" +
+	"\n" +
+	"spectators\x18\x0f \x01(\x05R\n" +
+	"spectators\"`\n" +
 	"\x0eMakeBetRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
 	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x16\n" +
@@ -3147,15 +6448,31 @@ const file_poker_proto_rawDesc = "" +
 	"\btable_id\x18\x02 \x01(\tR\atableId\"F\n" +
 	"\x10CheckBetResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"L\n" +
+	"\x12UseTimeBankRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\"\xac\x01\n" +
+	"\x13UseTimeBankResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\x17action_deadline_unix_ms\x18\x03 \x01(\x03R\x14actionDeadlineUnixMs\x12*\n" +
+	"\x11time_bank_seconds\x18\x04 \x01(\x05R\x0ftimeBankSeconds\"d\n" +
+	"\x16SendChatMessageRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"M\n" +
+	"\x17SendChatMessageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"H\n" +
 	"\x0eCallBetRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
 	"\btable_id\x18\x02 \x01(\tR\atableId\"E\n" +
 	"\x0fCallBetResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"0\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"M\n" +
 	"\x13GetGameStateRequest\x12\x19\n" +
-	"\btable_id\x18\x01 \x01(\tR\atableId\"H\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"H\n" +
 	"\x14GetGameStateResponse\x120\n" +
 	"\n" +
 	"game_state\x18\x01 \x01(\v2\x11.poker.GameUpdateR\tgameState\"8\n" +
@@ -3173,7 +6490,7 @@ const file_poker_proto_rawDesc = "" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12,\n" +
 	"\thand_rank\x18\x02 \x01(\x0e2\x0f.poker.HandRankR\bhandRank\x12(\n" +
 	"\tbest_hand\x18\x03 \x03(\v2\v.poker.CardR\bbestHand\x12\x1a\n" +
-	"\bwinnings\x18\x04 \x01(\x03R\bwinnings\"\xe0\x02\n" +
+	"\bwinnings\x18\x04 \x01(\x03R\bwinnings\"\x98\x05\n" +
 	"\x12CreateTableRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
 	"\vsmall_blind\x18\x02 \x01(\x03R\n" +
@@ -3189,26 +6506,70 @@ const file_poker_proto_rawDesc = "" +
 	"\x0estarting_chips\x18\b \x01(\x03R\rstartingChips\x12*\n" +
 	"\x11time_bank_seconds\x18\t \x01(\x05R\x0ftimeBankSeconds\x12\"\n" +
 	"\rauto_start_ms\x18\n" +
-	" \x01(\x05R\vautoStartMs\"0\n" +
+	" \x01(\x05R\vautoStartMs\x12\x18\n" +
+	"\aprivate\x18\v \x01(\bR\aprivate\x12\x1a\n" +
+	"\bpassword\x18\f \x01(\tR\bpassword\x123\n" +
+	"\x16sit_out_after_timeouts\x18\r \x01(\x05R\x13sitOutAfterTimeouts\x121\n" +
+	"\x15sit_out_limit_seconds\x18\x0e \x01(\x05R\x12sitOutLimitSeconds\x129\n" +
+	"\x19time_bank_reserve_seconds\x18\x0f \x01(\x05R\x16timeBankReserveSeconds\x12%\n" +
+	"\x0emax_spectators\x18\x10 \x01(\x05R\rmaxSpectators\x126\n" +
+	"\x17spectator_delay_seconds\x18\x11 \x01(\x05R\x15spectatorDelaySeconds\"0\n" +
 	"\x13CreateTableResponse\x12\x19\n" +
-	"\btable_id\x18\x01 \x01(\tR\atableId\"J\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\"\x9b\x01\n" +
 	"\x10JoinTableRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
-	"\btable_id\x18\x02 \x01(\tR\atableId\"h\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vinvite_code\x18\x04 \x01(\tR\n" +
+	"inviteCode\x12\x12\n" +
+	"\x04seat\x18\x05 \x01(\x05R\x04seat\"|\n" +
 	"\x11JoinTableResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
 	"\vnew_balance\x18\x03 \x01(\x03R\n" +
-	"newBalance\"K\n" +
+	"newBalance\x12\x12\n" +
+	"\x04seat\x18\x04 \x01(\x05R\x04seat\"\xbe\x01\n" +
+	"\x12ReserveSeatRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x12\n" +
+	"\x04seat\x18\x03 \x01(\x05R\x04seat\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vinvite_code\x18\x05 \x01(\tR\n" +
+	"inviteCode\x12\x1f\n" +
+	"\vttl_seconds\x18\x06 \x01(\x03R\n" +
+	"ttlSeconds\"|\n" +
+	"\x13ReserveSeatResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04seat\x18\x03 \x01(\x05R\x04seat\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\"\x8a\x01\n" +
+	"\x13JoinWaitlistRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vinvite_code\x18\x04 \x01(\tR\n" +
+	"inviteCode\"f\n" +
+	"\x14JoinWaitlistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\"N\n" +
+	"\x14LeaveWaitlistRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\"K\n" +
+	"\x15LeaveWaitlistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"K\n" +
 	"\x11LeaveTableRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
 	"\btable_id\x18\x02 \x01(\tR\atableId\"H\n" +
 	"\x12LeaveTableResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x12\n" +
-	"\x10GetTablesRequest\"9\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"/\n" +
+	"\x10GetTablesRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\"9\n" +
 	"\x11GetTablesResponse\x12$\n" +
-	"\x06tables\x18\x01 \x03(\v2\f.poker.TableR\x06tables\"\xb1\x03\n" +
+	"\x06tables\x18\x01 \x03(\v2\f.poker.TableR\x06tables\"\x89\x05\n" +
 	"\x05Table\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\ahost_id\x18\x02 \x01(\tR\x06hostId\x12'\n" +
@@ -3227,7 +6588,80 @@ const file_poker_proto_rawDesc = "" +
 	" \x01(\x03R\x05buyIn\x12&\n" +
 	"\x05phase\x18\v \x01(\x0e2\x10.poker.GamePhaseR\x05phase\x12!\n" +
 	"\fgame_started\x18\f \x01(\bR\vgameStarted\x12*\n" +
-	"\x11all_players_ready\x18\r \x01(\bR\x0fallPlayersReady\"0\n" +
+	"\x11all_players_ready\x18\r \x01(\bR\x0fallPlayersReady\x12\x18\n" +
+	"\aprivate\x18\x0e \x01(\bR\aprivate\x12-\n" +
+	"\x12password_protected\x18\x0f \x01(\bR\x11passwordProtected\x12*\n" +
+	"\x11time_bank_seconds\x18\x10 \x01(\x05R\x0ftimeBankSeconds\x12%\n" +
+	"\x0ereserved_seats\x18\x11 \x03(\x05R\rreservedSeats\x12\x1a\n" +
+	"\bwaitlist\x18\x12 \x03(\tR\bwaitlist\x12\x1e\n" +
+	"\n" +
+	"spectators\x18\x13 \x01(\x05R\n" +
+	"spectators\"\x92\x01\n" +
+	"\x18CreateTableInviteRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x1d\n" +
+	"\n" +
+	"invitee_id\x18\x03 \x01(\tR\tinviteeId\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x03R\n" +
+	"ttlSeconds\"N\n" +
+	"\x19CreateTableInviteResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\"\x80\x01\n" +
+	"\x11KickPlayerRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"`\n" +
+	"\x12KickPlayerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06refund\x18\x03 \x01(\x03R\x06refund\"\x82\x01\n" +
+	"\x15MuteChatPlayerRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x14\n" +
+	"\x05muted\x18\x04 \x01(\bR\x05muted\"L\n" +
+	"\x16MuteChatPlayerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x7f\n" +
+	"\x10BanPlayerRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"_\n" +
+	"\x11BanPlayerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06refund\x18\x03 \x01(\x03R\x06refund\"K\n" +
+	"\x11PauseTableRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\"H\n" +
+	"\x12PauseTableResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"L\n" +
+	"\x12ResumeTableRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\"I\n" +
+	"\x13ResumeTableResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"c\n" +
+	"\x11CloseTableRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"H\n" +
+	"\x12CloseTableResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x87\x01\n" +
+	"\rAddBotRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\x12\x1a\n" +
+	"\bstrategy\x18\x03 \x01(\tR\bstrategy\x12\"\n" +
+	"\rthink_time_ms\x18\x04 \x01(\x03R\vthinkTimeMs\"[\n" +
+	"\x0eAddBotResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x15\n" +
+	"\x06bot_id\x18\x03 \x01(\tR\x05botId\"0\n" +
 	"\x11GetBalanceRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\".\n" +
 	"\x12GetBalanceResponse\x12\x18\n" +
@@ -3250,9 +6684,57 @@ const file_poker_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
 	"\vnew_balance\x18\x03 \x01(\x03R\n" +
-	"newBalance\"=\n" +
+	"newBalance\"\xb3\x01\n" +
+	"\x16GetTransactionsRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x14\n" +
+	"\x05types\x18\x04 \x03(\tR\x05types\x12\x14\n" +
+	"\x05since\x18\x05 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x06 \x01(\x03R\x05until\"\xa7\x01\n" +
+	"\vTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1b\n" +
+	"\tplayer_id\x18\x06 \x01(\tR\bplayerId\"y\n" +
+	"\x17GetTransactionsResponse\x126\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x12.poker.TransactionR\ftransactions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"v\n" +
+	"\x18RequestWithdrawalRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12%\n" +
+	"\x0epayout_address\x18\x03 \x01(\tR\rpayoutAddress\"\xdc\x01\n" +
+	"\n" +
+	"Withdrawal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12 \n" +
+	"\vdestination\x18\x04 \x01(\tR\vdestination\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x16\n" +
+	"\x06detail\x18\x06 \x01(\tR\x06detail\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\"o\n" +
+	"\x19RequestWithdrawalResponse\x121\n" +
+	"\n" +
+	"withdrawal\x18\x01 \x01(\v2\x11.poker.WithdrawalR\n" +
+	"withdrawal\x12\x1f\n" +
+	"\vnew_balance\x18\x02 \x01(\x03R\n" +
+	"newBalance\"J\n" +
+	"\x15GetWithdrawalsRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"n\n" +
+	"\x16GetWithdrawalsResponse\x123\n" +
+	"\vwithdrawals\x18\x01 \x03(\v2\x11.poker.WithdrawalR\vwithdrawals\x12\x1f\n" +
+	"\vdaily_limit\x18\x02 \x01(\x03R\n" +
+	"dailyLimit\"=\n" +
 	"\x1eStartNotificationStreamRequest\x12\x1b\n" +
-	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\"\x8c\x04\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\"\xdc\x04\n" +
 	"\fNotification\x12+\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x17.poker.NotificationTypeR\x04type\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x19\n" +
@@ -3270,10 +6752,18 @@ const file_poker_proto_rawDesc = "" +
 	"\x12game_ready_to_play\x18\f \x01(\bR\x0fgameReadyToPlay\x12\x1c\n" +
 	"\tcountdown\x18\r \x01(\x05R\tcountdown\x12'\n" +
 	"\awinners\x18\x0e \x03(\v2\r.poker.WinnerR\awinners\x12+\n" +
-	"\bshowdown\x18\x0f \x01(\v2\x0f.poker.ShowdownR\bshowdown\"E\n" +
+	"\bshowdown\x18\x0f \x01(\v2\x0f.poker.ShowdownR\bshowdown\x12\x12\n" +
+	"\x04seat\x18\x10 \x01(\x05R\x04seat\x12\x12\n" +
+	"\x04auto\x18\x11 \x01(\bR\x04auto\x12&\n" +
+	"\x04chat\x18\x12 \x03(\v2\x12.poker.ChatMessageR\x04chat\"`\n" +
+	"\vChatMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12 \n" +
+	"\fsent_unix_ms\x18\x03 \x01(\x03R\n" +
+	"sentUnixMs\"E\n" +
 	"\bShowdown\x12'\n" +
 	"\awinners\x18\x01 \x03(\v2\r.poker.WinnerR\awinners\x12\x10\n" +
-	"\x03pot\x18\x02 \x01(\x03R\x03pot\"\xb8\x02\n" +
+	"\x03pot\x18\x02 \x01(\x03R\x03pot\"\x99\x03\n" +
 	"\x06Player\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
@@ -3287,7 +6777,11 @@ const file_poker_proto_rawDesc = "" +
 	"\tis_dealer\x18\t \x01(\bR\bisDealer\x12\x19\n" +
 	"\bis_ready\x18\n" +
 	" \x01(\bR\aisReady\x12)\n" +
-	"\x10hand_description\x18\v \x01(\tR\x0fhandDescription\"0\n" +
+	"\x10hand_description\x18\v \x01(\tR\x0fhandDescription\x12\x12\n" +
+	"\x04seat\x18\f \x01(\x05R\x04seat\x12\x1f\n" +
+	"\vsitting_out\x18\r \x01(\bR\n" +
+	"sittingOut\x12*\n" +
+	"\x11time_bank_seconds\x18\x0e \x01(\x05R\x0ftimeBankSeconds\"0\n" +
 	"\x04Card\x12\x12\n" +
 	"\x04suit\x18\x01 \x01(\tR\x04suit\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value\"O\n" +
@@ -3303,6 +6797,18 @@ const file_poker_proto_rawDesc = "" +
 	"\btable_id\x18\x02 \x01(\tR\atableId\"N\n" +
 	"\x18SetPlayerUnreadyResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"G\n" +
+	"\rSitOutRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\"D\n" +
+	"\x0eSitOutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"F\n" +
+	"\fSitInRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x19\n" +
+	"\btable_id\x18\x02 \x01(\tR\atableId\"C\n" +
+	"\rSitInResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\";\n" +
 	"\x1cGetPlayerCurrentTableRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\":\n" +
@@ -3319,7 +6825,54 @@ const file_poker_proto_rawDesc = "" +
 	"\btable_id\x18\x02 \x01(\tR\atableId\"G\n" +
 	"\x11HideCardsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*i\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x18\n" +
+	"\x16AdminListTablesRequest\"\x9b\x02\n" +
+	"\n" +
+	"AdminTable\x12\"\n" +
+	"\x05table\x18\x01 \x01(\v2\f.poker.TableR\x05table\x122\n" +
+	"\x05chips\x18\x02 \x03(\v2\x1c.poker.AdminTable.ChipsEntryR\x05chips\x12(\n" +
+	"\x10hand_in_progress\x18\x03 \x01(\bR\x0ehandInProgress\x12\x16\n" +
+	"\x06paused\x18\x04 \x01(\bR\x06paused\x12\x18\n" +
+	"\aclosing\x18\x05 \x01(\bR\aclosing\x12\x1f\n" +
+	"\vlast_action\x18\x06 \x01(\x03R\n" +
+	"lastAction\x1a8\n" +
+	"\n" +
+	"ChipsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"`\n" +
+	"\x17AdminListTablesResponse\x12)\n" +
+	"\x06tables\x18\x01 \x03(\v2\x11.poker.AdminTableR\x06tables\x12\x1a\n" +
+	"\bdraining\x18\x02 \x01(\bR\bdraining\"H\n" +
+	"\x13AdminEndGameRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"0\n" +
+	"\x14AdminEndGameResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"L\n" +
+	"\x17AdminDeleteTableRequest\x12\x19\n" +
+	"\btable_id\x18\x01 \x01(\tR\atableId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"4\n" +
+	"\x18AdminDeleteTableResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"c\n" +
+	"\x14AdjustBalanceRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"8\n" +
+	"\x15AdjustBalanceResponse\x12\x1f\n" +
+	"\vnew_balance\x18\x01 \x01(\x03R\n" +
+	"newBalance\",\n" +
+	"\x10BroadcastRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"3\n" +
+	"\x11BroadcastResponse\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\x01 \x01(\x05R\n" +
+	"recipients\"Q\n" +
+	"\fDrainRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12'\n" +
+	"\x0ftimeout_seconds\x18\x02 \x01(\x03R\x0etimeoutSeconds\"|\n" +
+	"\rDrainResponse\x12#\n" +
+	"\ractive_tables\x18\x01 \x01(\x05R\factiveTables\x12*\n" +
+	"\x11hands_in_progress\x18\x02 \x01(\x05R\x0fhandsInProgress\x12\x1a\n" +
+	"\bdeadline\x18\x03 \x01(\x03R\bdeadline*i\n" +
 	"\tGamePhase\x12\v\n" +
 	"\aWAITING\x10\x00\x12\x14\n" +
 	"\x10NEW_HAND_DEALING\x10\x01\x12\f\n" +
@@ -3327,7 +6880,7 @@ const file_poker_proto_rawDesc = "" +
 	"\x04FLOP\x10\x03\x12\b\n" +
 	"\x04TURN\x10\x04\x12\t\n" +
 	"\x05RIVER\x10\x05\x12\f\n" +
-	"\bSHOWDOWN\x10\x06*\xba\x03\n" +
+	"\bSHOWDOWN\x10\x06*\x9d\x05\n" +
 	"\x10NotificationType\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\x11\n" +
 	"\rPLAYER_JOINED\x10\x01\x12\x0f\n" +
@@ -3354,7 +6907,19 @@ const file_poker_proto_rawDesc = "" +
 	"CHECK_MADE\x10\x13\x12\x0f\n" +
 	"\vCARDS_SHOWN\x10\x14\x12\x10\n" +
 	"\fCARDS_HIDDEN\x10\x15\x12\x14\n" +
-	"\x10NEW_HAND_STARTED\x10\x16*\xa8\x01\n" +
+	"\x10NEW_HAND_STARTED\x10\x16\x12\x11\n" +
+	"\rPLAYER_KICKED\x10\x17\x12\x11\n" +
+	"\rPLAYER_BANNED\x10\x18\x12\x0f\n" +
+	"\vGAME_PAUSED\x10\x19\x12\x10\n" +
+	"\fGAME_RESUMED\x10\x1a\x12\x10\n" +
+	"\fTABLE_CLOSED\x10\x1b\x12\x12\n" +
+	"\x0eSERVER_MESSAGE\x10\x1c\x12\x13\n" +
+	"\x0fSERVER_DRAINING\x10\x1d\x12\x10\n" +
+	"\fSEAT_OFFERED\x10\x1e\x12\x12\n" +
+	"\x0ePLAYER_SAT_OUT\x10\x1f\x12\x11\n" +
+	"\rPLAYER_SAT_IN\x10 \x12\x12\n" +
+	"\x0eTIME_BANK_USED\x10!\x12\x10\n" +
+	"\fCHAT_MESSAGE\x10\"*\xa8\x01\n" +
 	"\bHandRank\x12\r\n" +
 	"\tHIGH_CARD\x10\x00\x12\b\n" +
 	"\x04PAIR\x10\x01\x12\f\n" +
@@ -3366,7 +6931,7 @@ const file_poker_proto_rawDesc = "" +
 	"FULL_HOUSE\x10\x06\x12\x12\n" +
 	"\x0eFOUR_OF_A_KIND\x10\a\x12\x12\n" +
 	"\x0eSTRAIGHT_FLUSH\x10\b\x12\x0f\n" +
-	"\vROYAL_FLUSH\x10\t2\xb5\x05\n" +
+	"\vROYAL_FLUSH\x10\t2\xd1\x06\n" +
 	"\fPokerService\x12G\n" +
 	"\x0fStartGameStream\x12\x1d.poker.StartGameStreamRequest\x1a\x11.poker.GameUpdate\"\x000\x01\x12@\n" +
 	"\tShowCards\x12\x17.poker.ShowCardsRequest\x1a\x18.poker.ShowCardsResponse\"\x00\x12@\n" +
@@ -3374,25 +6939,55 @@ const file_poker_proto_rawDesc = "" +
 	"\aMakeBet\x12\x15.poker.MakeBetRequest\x1a\x16.poker.MakeBetResponse\"\x00\x12:\n" +
 	"\aCallBet\x12\x15.poker.CallBetRequest\x1a\x16.poker.CallBetResponse\"\x00\x12:\n" +
 	"\aFoldBet\x12\x15.poker.FoldBetRequest\x1a\x16.poker.FoldBetResponse\"\x00\x12=\n" +
-	"\bCheckBet\x12\x16.poker.CheckBetRequest\x1a\x17.poker.CheckBetResponse\"\x00\x12I\n" +
+	"\bCheckBet\x12\x16.poker.CheckBetRequest\x1a\x17.poker.CheckBetResponse\"\x00\x12F\n" +
+	"\vUseTimeBank\x12\x19.poker.UseTimeBankRequest\x1a\x1a.poker.UseTimeBankResponse\"\x00\x12R\n" +
+	"\x0fSendChatMessage\x12\x1d.poker.SendChatMessageRequest\x1a\x1e.poker.SendChatMessageResponse\"\x00\x12I\n" +
 	"\fGetGameState\x12\x1a.poker.GetGameStateRequest\x1a\x1b.poker.GetGameStateResponse\"\x00\x12I\n" +
 	"\fEvaluateHand\x12\x1a.poker.EvaluateHandRequest\x1a\x1b.poker.EvaluateHandResponse\"\x00\x12O\n" +
-	"\x0eGetLastWinners\x12\x1c.poker.GetLastWinnersRequest\x1a\x1d.poker.GetLastWinnersResponse\"\x002\xe0\x06\n" +
+	"\x0eGetLastWinners\x12\x1c.poker.GetLastWinnersRequest\x1a\x1d.poker.GetLastWinnersResponse\"\x002\xec\x0f\n" +
 	"\fLobbyService\x12F\n" +
 	"\vCreateTable\x12\x19.poker.CreateTableRequest\x1a\x1a.poker.CreateTableResponse\"\x00\x12@\n" +
 	"\tJoinTable\x12\x17.poker.JoinTableRequest\x1a\x18.poker.JoinTableResponse\"\x00\x12C\n" +
 	"\n" +
 	"LeaveTable\x12\x18.poker.LeaveTableRequest\x1a\x19.poker.LeaveTableResponse\"\x00\x12@\n" +
 	"\tGetTables\x12\x17.poker.GetTablesRequest\x1a\x18.poker.GetTablesResponse\"\x00\x12d\n" +
-	"\x15GetPlayerCurrentTable\x12#.poker.GetPlayerCurrentTableRequest\x1a$.poker.GetPlayerCurrentTableResponse\"\x00\x12C\n" +
+	"\x15GetPlayerCurrentTable\x12#.poker.GetPlayerCurrentTableRequest\x1a$.poker.GetPlayerCurrentTableResponse\"\x00\x12X\n" +
+	"\x11CreateTableInvite\x12\x1f.poker.CreateTableInviteRequest\x1a .poker.CreateTableInviteResponse\"\x00\x12F\n" +
+	"\vReserveSeat\x12\x19.poker.ReserveSeatRequest\x1a\x1a.poker.ReserveSeatResponse\"\x00\x12I\n" +
+	"\fJoinWaitlist\x12\x1a.poker.JoinWaitlistRequest\x1a\x1b.poker.JoinWaitlistResponse\"\x00\x12L\n" +
+	"\rLeaveWaitlist\x12\x1b.poker.LeaveWaitlistRequest\x1a\x1c.poker.LeaveWaitlistResponse\"\x00\x12C\n" +
+	"\n" +
+	"KickPlayer\x12\x18.poker.KickPlayerRequest\x1a\x19.poker.KickPlayerResponse\"\x00\x12@\n" +
+	"\tBanPlayer\x12\x17.poker.BanPlayerRequest\x1a\x18.poker.BanPlayerResponse\"\x00\x12C\n" +
+	"\n" +
+	"PauseTable\x12\x18.poker.PauseTableRequest\x1a\x19.poker.PauseTableResponse\"\x00\x12F\n" +
+	"\vResumeTable\x12\x19.poker.ResumeTableRequest\x1a\x1a.poker.ResumeTableResponse\"\x00\x12C\n" +
+	"\n" +
+	"CloseTable\x12\x18.poker.CloseTableRequest\x1a\x19.poker.CloseTableResponse\"\x00\x127\n" +
+	"\x06AddBot\x12\x14.poker.AddBotRequest\x1a\x15.poker.AddBotResponse\"\x00\x12O\n" +
+	"\x0eMuteChatPlayer\x12\x1c.poker.MuteChatPlayerRequest\x1a\x1d.poker.MuteChatPlayerResponse\"\x00\x12C\n" +
 	"\n" +
 	"GetBalance\x12\x18.poker.GetBalanceRequest\x1a\x19.poker.GetBalanceResponse\"\x00\x12L\n" +
 	"\rUpdateBalance\x12\x1b.poker.UpdateBalanceRequest\x1a\x1c.poker.UpdateBalanceResponse\"\x00\x12C\n" +
 	"\n" +
-	"ProcessTip\x12\x18.poker.ProcessTipRequest\x1a\x19.poker.ProcessTipResponse\"\x00\x12O\n" +
+	"ProcessTip\x12\x18.poker.ProcessTipRequest\x1a\x19.poker.ProcessTipResponse\"\x00\x12R\n" +
+	"\x0fGetTransactions\x12\x1d.poker.GetTransactionsRequest\x1a\x1e.poker.GetTransactionsResponse\"\x00\x12X\n" +
+	"\x11RequestWithdrawal\x12\x1f.poker.RequestWithdrawalRequest\x1a .poker.RequestWithdrawalResponse\"\x00\x12O\n" +
+	"\x0eGetWithdrawals\x12\x1c.poker.GetWithdrawalsRequest\x1a\x1d.poker.GetWithdrawalsResponse\"\x00\x12O\n" +
 	"\x0eSetPlayerReady\x12\x1c.poker.SetPlayerReadyRequest\x1a\x1d.poker.SetPlayerReadyResponse\"\x00\x12U\n" +
-	"\x10SetPlayerUnready\x12\x1e.poker.SetPlayerUnreadyRequest\x1a\x1f.poker.SetPlayerUnreadyResponse\"\x00\x12Y\n" +
-	"\x17StartNotificationStream\x12%.poker.StartNotificationStreamRequest\x1a\x13.poker.Notification\"\x000\x01B\x0fZ\rgrpc/pokerrpcb\x06proto3"
+	"\x10SetPlayerUnready\x12\x1e.poker.SetPlayerUnreadyRequest\x1a\x1f.poker.SetPlayerUnreadyResponse\"\x00\x127\n" +
+	"\x06SitOut\x12\x14.poker.SitOutRequest\x1a\x15.poker.SitOutResponse\"\x00\x124\n" +
+	"\x05SitIn\x12\x13.poker.SitInRequest\x1a\x14.poker.SitInResponse\"\x00\x12Y\n" +
+	"\x17StartNotificationStream\x12%.poker.StartNotificationStreamRequest\x1a\x13.poker.Notification\"\x000\x012\x89\x04\n" +
+	"\fAdminService\x12M\n" +
+	"\n" +
+	"ListTables\x12\x1d.poker.AdminListTablesRequest\x1a\x1e.poker.AdminListTablesResponse\"\x00\x12D\n" +
+	"\aEndGame\x12\x1a.poker.AdminEndGameRequest\x1a\x1b.poker.AdminEndGameResponse\"\x00\x12P\n" +
+	"\vDeleteTable\x12\x1e.poker.AdminDeleteTableRequest\x1a\x1f.poker.AdminDeleteTableResponse\"\x00\x12L\n" +
+	"\rAdjustBalance\x12\x1b.poker.AdjustBalanceRequest\x1a\x1c.poker.AdjustBalanceResponse\"\x00\x12L\n" +
+	"\tGetLedger\x12\x1d.poker.GetTransactionsRequest\x1a\x1e.poker.GetTransactionsResponse\"\x00\x12@\n" +
+	"\tBroadcast\x12\x17.poker.BroadcastRequest\x1a\x18.poker.BroadcastResponse\"\x00\x124\n" +
+	"\x05Drain\x12\x13.poker.DrainRequest\x1a\x14.poker.DrainResponse\"\x00B\x0fZ\rgrpc/pokerrpcb\x06proto3"
 
 var (
 	file_poker_proto_rawDescOnce sync.Once
@@ -3407,7 +7002,7 @@ func file_poker_proto_rawDescGZIP() []byte {
 }
 
 var file_poker_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_poker_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_poker_proto_msgTypes = make([]protoimpl.MessageInfo, 100)
 var file_poker_proto_goTypes = []any{
 	(GamePhase)(0),                         // 0: poker.GamePhase
 	(NotificationType)(0),                  // 1: poker.NotificationType
@@ -3420,115 +7015,225 @@ var file_poker_proto_goTypes = []any{
 	(*FoldBetResponse)(nil),                // 8: poker.FoldBetResponse
 	(*CheckBetRequest)(nil),                // 9: poker.CheckBetRequest
 	(*CheckBetResponse)(nil),               // 10: poker.CheckBetResponse
-	(*CallBetRequest)(nil),                 // 11: poker.CallBetRequest
-	(*CallBetResponse)(nil),                // 12: poker.CallBetResponse
-	(*GetGameStateRequest)(nil),            // 13: poker.GetGameStateRequest
-	(*GetGameStateResponse)(nil),           // 14: poker.GetGameStateResponse
-	(*EvaluateHandRequest)(nil),            // 15: poker.EvaluateHandRequest
-	(*EvaluateHandResponse)(nil),           // 16: poker.EvaluateHandResponse
-	(*GetLastWinnersRequest)(nil),          // 17: poker.GetLastWinnersRequest
-	(*GetLastWinnersResponse)(nil),         // 18: poker.GetLastWinnersResponse
-	(*Winner)(nil),                         // 19: poker.Winner
-	(*CreateTableRequest)(nil),             // 20: poker.CreateTableRequest
-	(*CreateTableResponse)(nil),            // 21: poker.CreateTableResponse
-	(*JoinTableRequest)(nil),               // 22: poker.JoinTableRequest
-	(*JoinTableResponse)(nil),              // 23: poker.JoinTableResponse
-	(*LeaveTableRequest)(nil),              // 24: poker.LeaveTableRequest
-	(*LeaveTableResponse)(nil),             // 25: poker.LeaveTableResponse
-	(*GetTablesRequest)(nil),               // 26: poker.GetTablesRequest
-	(*GetTablesResponse)(nil),              // 27: poker.GetTablesResponse
-	(*Table)(nil),                          // 28: poker.Table
-	(*GetBalanceRequest)(nil),              // 29: poker.GetBalanceRequest
-	(*GetBalanceResponse)(nil),             // 30: poker.GetBalanceResponse
-	(*UpdateBalanceRequest)(nil),           // 31: poker.UpdateBalanceRequest
-	(*UpdateBalanceResponse)(nil),          // 32: poker.UpdateBalanceResponse
-	(*ProcessTipRequest)(nil),              // 33: poker.ProcessTipRequest
-	(*ProcessTipResponse)(nil),             // 34: poker.ProcessTipResponse
-	(*StartNotificationStreamRequest)(nil), // 35: poker.StartNotificationStreamRequest
-	(*Notification)(nil),                   // 36: poker.Notification
-	(*Showdown)(nil),                       // 37: poker.Showdown
-	(*Player)(nil),                         // 38: poker.Player
-	(*Card)(nil),                           // 39: poker.Card
-	(*SetPlayerReadyRequest)(nil),          // 40: poker.SetPlayerReadyRequest
-	(*SetPlayerReadyResponse)(nil),         // 41: poker.SetPlayerReadyResponse
-	(*SetPlayerUnreadyRequest)(nil),        // 42: poker.SetPlayerUnreadyRequest
-	(*SetPlayerUnreadyResponse)(nil),       // 43: poker.SetPlayerUnreadyResponse
-	(*GetPlayerCurrentTableRequest)(nil),   // 44: poker.GetPlayerCurrentTableRequest
-	(*GetPlayerCurrentTableResponse)(nil),  // 45: poker.GetPlayerCurrentTableResponse
-	(*ShowCardsRequest)(nil),               // 46: poker.ShowCardsRequest
-	(*ShowCardsResponse)(nil),              // 47: poker.ShowCardsResponse
-	(*HideCardsRequest)(nil),               // 48: poker.HideCardsRequest
-	(*HideCardsResponse)(nil),              // 49: poker.HideCardsResponse
+	(*UseTimeBankRequest)(nil),             // 11: poker.UseTimeBankRequest
+	(*UseTimeBankResponse)(nil),            // 12: poker.UseTimeBankResponse
+	(*SendChatMessageRequest)(nil),         // 13: poker.SendChatMessageRequest
+	(*SendChatMessageResponse)(nil),        // 14: poker.SendChatMessageResponse
+	(*CallBetRequest)(nil),                 // 15: poker.CallBetRequest
+	(*CallBetResponse)(nil),                // 16: poker.CallBetResponse
+	(*GetGameStateRequest)(nil),            // 17: poker.GetGameStateRequest
+	(*GetGameStateResponse)(nil),           // 18: poker.GetGameStateResponse
+	(*EvaluateHandRequest)(nil),            // 19: poker.EvaluateHandRequest
+	(*EvaluateHandResponse)(nil),           // 20: poker.EvaluateHandResponse
+	(*GetLastWinnersRequest)(nil),          // 21: poker.GetLastWinnersRequest
+	(*GetLastWinnersResponse)(nil),         // 22: poker.GetLastWinnersResponse
+	(*Winner)(nil),                         // 23: poker.Winner
+	(*CreateTableRequest)(nil),             // 24: poker.CreateTableRequest
+	(*CreateTableResponse)(nil),            // 25: poker.CreateTableResponse
+	(*JoinTableRequest)(nil),               // 26: poker.JoinTableRequest
+	(*JoinTableResponse)(nil),              // 27: poker.JoinTableResponse
+	(*ReserveSeatRequest)(nil),             // 28: poker.ReserveSeatRequest
+	(*ReserveSeatResponse)(nil),            // 29: poker.ReserveSeatResponse
+	(*JoinWaitlistRequest)(nil),            // 30: poker.JoinWaitlistRequest
+	(*JoinWaitlistResponse)(nil),           // 31: poker.JoinWaitlistResponse
+	(*LeaveWaitlistRequest)(nil),           // 32: poker.LeaveWaitlistRequest
+	(*LeaveWaitlistResponse)(nil),          // 33: poker.LeaveWaitlistResponse
+	(*LeaveTableRequest)(nil),              // 34: poker.LeaveTableRequest
+	(*LeaveTableResponse)(nil),             // 35: poker.LeaveTableResponse
+	(*GetTablesRequest)(nil),               // 36: poker.GetTablesRequest
+	(*GetTablesResponse)(nil),              // 37: poker.GetTablesResponse
+	(*Table)(nil),                          // 38: poker.Table
+	(*CreateTableInviteRequest)(nil),       // 39: poker.CreateTableInviteRequest
+	(*CreateTableInviteResponse)(nil),      // 40: poker.CreateTableInviteResponse
+	(*KickPlayerRequest)(nil),              // 41: poker.KickPlayerRequest
+	(*KickPlayerResponse)(nil),             // 42: poker.KickPlayerResponse
+	(*MuteChatPlayerRequest)(nil),          // 43: poker.MuteChatPlayerRequest
+	(*MuteChatPlayerResponse)(nil),         // 44: poker.MuteChatPlayerResponse
+	(*BanPlayerRequest)(nil),               // 45: poker.BanPlayerRequest
+	(*BanPlayerResponse)(nil),              // 46: poker.BanPlayerResponse
+	(*PauseTableRequest)(nil),              // 47: poker.PauseTableRequest
+	(*PauseTableResponse)(nil),             // 48: poker.PauseTableResponse
+	(*ResumeTableRequest)(nil),             // 49: poker.ResumeTableRequest
+	(*ResumeTableResponse)(nil),            // 50: poker.ResumeTableResponse
+	(*CloseTableRequest)(nil),              // 51: poker.CloseTableRequest
+	(*CloseTableResponse)(nil),             // 52: poker.CloseTableResponse
+	(*AddBotRequest)(nil),                  // 53: poker.AddBotRequest
+	(*AddBotResponse)(nil),                 // 54: poker.AddBotResponse
+	(*GetBalanceRequest)(nil),              // 55: poker.GetBalanceRequest
+	(*GetBalanceResponse)(nil),             // 56: poker.GetBalanceResponse
+	(*UpdateBalanceRequest)(nil),           // 57: poker.UpdateBalanceRequest
+	(*UpdateBalanceResponse)(nil),          // 58: poker.UpdateBalanceResponse
+	(*ProcessTipRequest)(nil),              // 59: poker.ProcessTipRequest
+	(*ProcessTipResponse)(nil),             // 60: poker.ProcessTipResponse
+	(*GetTransactionsRequest)(nil),         // 61: poker.GetTransactionsRequest
+	(*Transaction)(nil),                    // 62: poker.Transaction
+	(*GetTransactionsResponse)(nil),        // 63: poker.GetTransactionsResponse
+	(*RequestWithdrawalRequest)(nil),       // 64: poker.RequestWithdrawalRequest
+	(*Withdrawal)(nil),                     // 65: poker.Withdrawal
+	(*RequestWithdrawalResponse)(nil),      // 66: poker.RequestWithdrawalResponse
+	(*GetWithdrawalsRequest)(nil),          // 67: poker.GetWithdrawalsRequest
+	(*GetWithdrawalsResponse)(nil),         // 68: poker.GetWithdrawalsResponse
+	(*StartNotificationStreamRequest)(nil), // 69: poker.StartNotificationStreamRequest
+	(*Notification)(nil),                   // 70: poker.Notification
+	(*ChatMessage)(nil),                    // 71: poker.ChatMessage
+	(*Showdown)(nil),                       // 72: poker.Showdown
+	(*Player)(nil),                         // 73: poker.Player
+	(*Card)(nil),                           // 74: poker.Card
+	(*SetPlayerReadyRequest)(nil),          // 75: poker.SetPlayerReadyRequest
+	(*SetPlayerReadyResponse)(nil),         // 76: poker.SetPlayerReadyResponse
+	(*SetPlayerUnreadyRequest)(nil),        // 77: poker.SetPlayerUnreadyRequest
+	(*SetPlayerUnreadyResponse)(nil),       // 78: poker.SetPlayerUnreadyResponse
+	(*SitOutRequest)(nil),                  // 79: poker.SitOutRequest
+	(*SitOutResponse)(nil),                 // 80: poker.SitOutResponse
+	(*SitInRequest)(nil),                   // 81: poker.SitInRequest
+	(*SitInResponse)(nil),                  // 82: poker.SitInResponse
+	(*GetPlayerCurrentTableRequest)(nil),   // 83: poker.GetPlayerCurrentTableRequest
+	(*GetPlayerCurrentTableResponse)(nil),  // 84: poker.GetPlayerCurrentTableResponse
+	(*ShowCardsRequest)(nil),               // 85: poker.ShowCardsRequest
+	(*ShowCardsResponse)(nil),              // 86: poker.ShowCardsResponse
+	(*HideCardsRequest)(nil),               // 87: poker.HideCardsRequest
+	(*HideCardsResponse)(nil),              // 88: poker.HideCardsResponse
+	(*AdminListTablesRequest)(nil),         // 89: poker.AdminListTablesRequest
+	(*AdminTable)(nil),                     // 90: poker.AdminTable
+	(*AdminListTablesResponse)(nil),        // 91: poker.AdminListTablesResponse
+	(*AdminEndGameRequest)(nil),            // 92: poker.AdminEndGameRequest
+	(*AdminEndGameResponse)(nil),           // 93: poker.AdminEndGameResponse
+	(*AdminDeleteTableRequest)(nil),        // 94: poker.AdminDeleteTableRequest
+	(*AdminDeleteTableResponse)(nil),       // 95: poker.AdminDeleteTableResponse
+	(*AdjustBalanceRequest)(nil),           // 96: poker.AdjustBalanceRequest
+	(*AdjustBalanceResponse)(nil),          // 97: poker.AdjustBalanceResponse
+	(*BroadcastRequest)(nil),               // 98: poker.BroadcastRequest
+	(*BroadcastResponse)(nil),              // 99: poker.BroadcastResponse
+	(*DrainRequest)(nil),                   // 100: poker.DrainRequest
+	(*DrainResponse)(nil),                  // 101: poker.DrainResponse
+	nil,                                    // 102: poker.AdminTable.ChipsEntry
 }
 var file_poker_proto_depIdxs = []int32{
-	0,  // 0: poker.GameUpdate.phase:type_name -> poker.GamePhase
-	38, // 1: poker.GameUpdate.players:type_name -> poker.Player
-	39, // 2: poker.GameUpdate.community_cards:type_name -> poker.Card
-	4,  // 3: poker.GetGameStateResponse.game_state:type_name -> poker.GameUpdate
-	39, // 4: poker.EvaluateHandRequest.cards:type_name -> poker.Card
-	2,  // 5: poker.EvaluateHandResponse.rank:type_name -> poker.HandRank
-	39, // 6: poker.EvaluateHandResponse.best_hand:type_name -> poker.Card
-	19, // 7: poker.GetLastWinnersResponse.winners:type_name -> poker.Winner
-	2,  // 8: poker.Winner.hand_rank:type_name -> poker.HandRank
-	39, // 9: poker.Winner.best_hand:type_name -> poker.Card
-	28, // 10: poker.GetTablesResponse.tables:type_name -> poker.Table
-	38, // 11: poker.Table.players:type_name -> poker.Player
-	0,  // 12: poker.Table.phase:type_name -> poker.GamePhase
-	1,  // 13: poker.Notification.type:type_name -> poker.NotificationType
-	39, // 14: poker.Notification.cards:type_name -> poker.Card
-	2,  // 15: poker.Notification.hand_rank:type_name -> poker.HandRank
-	28, // 16: poker.Notification.table:type_name -> poker.Table
-	19, // 17: poker.Notification.winners:type_name -> poker.Winner
-	37, // 18: poker.Notification.showdown:type_name -> poker.Showdown
-	19, // 19: poker.Showdown.winners:type_name -> poker.Winner
-	39, // 20: poker.Player.hand:type_name -> poker.Card
-	3,  // 21: poker.PokerService.StartGameStream:input_type -> poker.StartGameStreamRequest
-	46, // 22: poker.PokerService.ShowCards:input_type -> poker.ShowCardsRequest
-	48, // 23: poker.PokerService.HideCards:input_type -> poker.HideCardsRequest
-	5,  // 24: poker.PokerService.MakeBet:input_type -> poker.MakeBetRequest
-	11, // 25: poker.PokerService.CallBet:input_type -> poker.CallBetRequest
-	7,  // 26: poker.PokerService.FoldBet:input_type -> poker.FoldBetRequest
-	9,  // 27: poker.PokerService.CheckBet:input_type -> poker.CheckBetRequest
-	13, // 28: poker.PokerService.GetGameState:input_type -> poker.GetGameStateRequest
-	15, // 29: poker.PokerService.EvaluateHand:input_type -> poker.EvaluateHandRequest
-	17, // 30: poker.PokerService.GetLastWinners:input_type -> poker.GetLastWinnersRequest
-	20, // 31: poker.LobbyService.CreateTable:input_type -> poker.CreateTableRequest
-	22, // 32: poker.LobbyService.JoinTable:input_type -> poker.JoinTableRequest
-	24, // 33: poker.LobbyService.LeaveTable:input_type -> poker.LeaveTableRequest
-	26, // 34: poker.LobbyService.GetTables:input_type -> poker.GetTablesRequest
-	44, // 35: poker.LobbyService.GetPlayerCurrentTable:input_type -> poker.GetPlayerCurrentTableRequest
-	29, // 36: poker.LobbyService.GetBalance:input_type -> poker.GetBalanceRequest
-	31, // 37: poker.LobbyService.UpdateBalance:input_type -> poker.UpdateBalanceRequest
-	33, // 38: poker.LobbyService.ProcessTip:input_type -> poker.ProcessTipRequest
-	40, // 39: poker.LobbyService.SetPlayerReady:input_type -> poker.SetPlayerReadyRequest
-	42, // 40: poker.LobbyService.SetPlayerUnready:input_type -> poker.SetPlayerUnreadyRequest
-	35, // 41: poker.LobbyService.StartNotificationStream:input_type -> poker.StartNotificationStreamRequest
-	4,  // 42: poker.PokerService.StartGameStream:output_type -> poker.GameUpdate
-	47, // 43: poker.PokerService.ShowCards:output_type -> poker.ShowCardsResponse
-	49, // 44: poker.PokerService.HideCards:output_type -> poker.HideCardsResponse
-	6,  // 45: poker.PokerService.MakeBet:output_type -> poker.MakeBetResponse
-	12, // 46: poker.PokerService.CallBet:output_type -> poker.CallBetResponse
-	8,  // 47: poker.PokerService.FoldBet:output_type -> poker.FoldBetResponse
-	10, // 48: poker.PokerService.CheckBet:output_type -> poker.CheckBetResponse
-	14, // 49: poker.PokerService.GetGameState:output_type -> poker.GetGameStateResponse
-	16, // 50: poker.PokerService.EvaluateHand:output_type -> poker.EvaluateHandResponse
-	18, // 51: poker.PokerService.GetLastWinners:output_type -> poker.GetLastWinnersResponse
-	21, // 52: poker.LobbyService.CreateTable:output_type -> poker.CreateTableResponse
-	23, // 53: poker.LobbyService.JoinTable:output_type -> poker.JoinTableResponse
-	25, // 54: poker.LobbyService.LeaveTable:output_type -> poker.LeaveTableResponse
-	27, // 55: poker.LobbyService.GetTables:output_type -> poker.GetTablesResponse
-	45, // 56: poker.LobbyService.GetPlayerCurrentTable:output_type -> poker.GetPlayerCurrentTableResponse
-	30, // 57: poker.LobbyService.GetBalance:output_type -> poker.GetBalanceResponse
-	32, // 58: poker.LobbyService.UpdateBalance:output_type -> poker.UpdateBalanceResponse
-	34, // 59: poker.LobbyService.ProcessTip:output_type -> poker.ProcessTipResponse
-	41, // 60: poker.LobbyService.SetPlayerReady:output_type -> poker.SetPlayerReadyResponse
-	43, // 61: poker.LobbyService.SetPlayerUnready:output_type -> poker.SetPlayerUnreadyResponse
-	36, // 62: poker.LobbyService.StartNotificationStream:output_type -> poker.Notification
-	42, // [42:63] is the sub-list for method output_type
-	21, // [21:42] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	0,   // 0: poker.GameUpdate.phase:type_name -> poker.GamePhase
+	73,  // 1: poker.GameUpdate.players:type_name -> poker.Player
+	74,  // 2: poker.GameUpdate.community_cards:type_name -> poker.Card
+	4,   // 3: poker.GetGameStateResponse.game_state:type_name -> poker.GameUpdate
+	74,  // 4: poker.EvaluateHandRequest.cards:type_name -> poker.Card
+	2,   // 5: poker.EvaluateHandResponse.rank:type_name -> poker.HandRank
+	74,  // 6: poker.EvaluateHandResponse.best_hand:type_name -> poker.Card
+	23,  // 7: poker.GetLastWinnersResponse.winners:type_name -> poker.Winner
+	2,   // 8: poker.Winner.hand_rank:type_name -> poker.HandRank
+	74,  // 9: poker.Winner.best_hand:type_name -> poker.Card
+	38,  // 10: poker.GetTablesResponse.tables:type_name -> poker.Table
+	73,  // 11: poker.Table.players:type_name -> poker.Player
+	0,   // 12: poker.Table.phase:type_name -> poker.GamePhase
+	62,  // 13: poker.GetTransactionsResponse.transactions:type_name -> poker.Transaction
+	65,  // 14: poker.RequestWithdrawalResponse.withdrawal:type_name -> poker.Withdrawal
+	65,  // 15: poker.GetWithdrawalsResponse.withdrawals:type_name -> poker.Withdrawal
+	1,   // 16: poker.Notification.type:type_name -> poker.NotificationType
+	74,  // 17: poker.Notification.cards:type_name -> poker.Card
+	2,   // 18: poker.Notification.hand_rank:type_name -> poker.HandRank
+	38,  // 19: poker.Notification.table:type_name -> poker.Table
+	23,  // 20: poker.Notification.winners:type_name -> poker.Winner
+	72,  // 21: poker.Notification.showdown:type_name -> poker.Showdown
+	71,  // 22: poker.Notification.chat:type_name -> poker.ChatMessage
+	23,  // 23: poker.Showdown.winners:type_name -> poker.Winner
+	74,  // 24: poker.Player.hand:type_name -> poker.Card
+	38,  // 25: poker.AdminTable.table:type_name -> poker.Table
+	102, // 26: poker.AdminTable.chips:type_name -> poker.AdminTable.ChipsEntry
+	90,  // 27: poker.AdminListTablesResponse.tables:type_name -> poker.AdminTable
+	3,   // 28: poker.PokerService.StartGameStream:input_type -> poker.StartGameStreamRequest
+	85,  // 29: poker.PokerService.ShowCards:input_type -> poker.ShowCardsRequest
+	87,  // 30: poker.PokerService.HideCards:input_type -> poker.HideCardsRequest
+	5,   // 31: poker.PokerService.MakeBet:input_type -> poker.MakeBetRequest
+	15,  // 32: poker.PokerService.CallBet:input_type -> poker.CallBetRequest
+	7,   // 33: poker.PokerService.FoldBet:input_type -> poker.FoldBetRequest
+	9,   // 34: poker.PokerService.CheckBet:input_type -> poker.CheckBetRequest
+	11,  // 35: poker.PokerService.UseTimeBank:input_type -> poker.UseTimeBankRequest
+	13,  // 36: poker.PokerService.SendChatMessage:input_type -> poker.SendChatMessageRequest
+	17,  // 37: poker.PokerService.GetGameState:input_type -> poker.GetGameStateRequest
+	19,  // 38: poker.PokerService.EvaluateHand:input_type -> poker.EvaluateHandRequest
+	21,  // 39: poker.PokerService.GetLastWinners:input_type -> poker.GetLastWinnersRequest
+	24,  // 40: poker.LobbyService.CreateTable:input_type -> poker.CreateTableRequest
+	26,  // 41: poker.LobbyService.JoinTable:input_type -> poker.JoinTableRequest
+	34,  // 42: poker.LobbyService.LeaveTable:input_type -> poker.LeaveTableRequest
+	36,  // 43: poker.LobbyService.GetTables:input_type -> poker.GetTablesRequest
+	83,  // 44: poker.LobbyService.GetPlayerCurrentTable:input_type -> poker.GetPlayerCurrentTableRequest
+	39,  // 45: poker.LobbyService.CreateTableInvite:input_type -> poker.CreateTableInviteRequest
+	28,  // 46: poker.LobbyService.ReserveSeat:input_type -> poker.ReserveSeatRequest
+	30,  // 47: poker.LobbyService.JoinWaitlist:input_type -> poker.JoinWaitlistRequest
+	32,  // 48: poker.LobbyService.LeaveWaitlist:input_type -> poker.LeaveWaitlistRequest
+	41,  // 49: poker.LobbyService.KickPlayer:input_type -> poker.KickPlayerRequest
+	45,  // 50: poker.LobbyService.BanPlayer:input_type -> poker.BanPlayerRequest
+	47,  // 51: poker.LobbyService.PauseTable:input_type -> poker.PauseTableRequest
+	49,  // 52: poker.LobbyService.ResumeTable:input_type -> poker.ResumeTableRequest
+	51,  // 53: poker.LobbyService.CloseTable:input_type -> poker.CloseTableRequest
+	53,  // 54: poker.LobbyService.AddBot:input_type -> poker.AddBotRequest
+	43,  // 55: poker.LobbyService.MuteChatPlayer:input_type -> poker.MuteChatPlayerRequest
+	55,  // 56: poker.LobbyService.GetBalance:input_type -> poker.GetBalanceRequest
+	57,  // 57: poker.LobbyService.UpdateBalance:input_type -> poker.UpdateBalanceRequest
+	59,  // 58: poker.LobbyService.ProcessTip:input_type -> poker.ProcessTipRequest
+	61,  // 59: poker.LobbyService.GetTransactions:input_type -> poker.GetTransactionsRequest
+	64,  // 60: poker.LobbyService.RequestWithdrawal:input_type -> poker.RequestWithdrawalRequest
+	67,  // 61: poker.LobbyService.GetWithdrawals:input_type -> poker.GetWithdrawalsRequest
+	75,  // 62: poker.LobbyService.SetPlayerReady:input_type -> poker.SetPlayerReadyRequest
+	77,  // 63: poker.LobbyService.SetPlayerUnready:input_type -> poker.SetPlayerUnreadyRequest
+	79,  // 64: poker.LobbyService.SitOut:input_type -> poker.SitOutRequest
+	81,  // 65: poker.LobbyService.SitIn:input_type -> poker.SitInRequest
+	69,  // 66: poker.LobbyService.StartNotificationStream:input_type -> poker.StartNotificationStreamRequest
+	89,  // 67: poker.AdminService.ListTables:input_type -> poker.AdminListTablesRequest
+	92,  // 68: poker.AdminService.EndGame:input_type -> poker.AdminEndGameRequest
+	94,  // 69: poker.AdminService.DeleteTable:input_type -> poker.AdminDeleteTableRequest
+	96,  // 70: poker.AdminService.AdjustBalance:input_type -> poker.AdjustBalanceRequest
+	61,  // 71: poker.AdminService.GetLedger:input_type -> poker.GetTransactionsRequest
+	98,  // 72: poker.AdminService.Broadcast:input_type -> poker.BroadcastRequest
+	100, // 73: poker.AdminService.Drain:input_type -> poker.DrainRequest
+	4,   // 74: poker.PokerService.StartGameStream:output_type -> poker.GameUpdate
+	86,  // 75: poker.PokerService.ShowCards:output_type -> poker.ShowCardsResponse
+	88,  // 76: poker.PokerService.HideCards:output_type -> poker.HideCardsResponse
+	6,   // 77: poker.PokerService.MakeBet:output_type -> poker.MakeBetResponse
+	16,  // 78: poker.PokerService.CallBet:output_type -> poker.CallBetResponse
+	8,   // 79: poker.PokerService.FoldBet:output_type -> poker.FoldBetResponse
+	10,  // 80: poker.PokerService.CheckBet:output_type -> poker.CheckBetResponse
+	12,  // 81: poker.PokerService.UseTimeBank:output_type -> poker.UseTimeBankResponse
+	14,  // 82: poker.PokerService.SendChatMessage:output_type -> poker.SendChatMessageResponse
+	18,  // 83: poker.PokerService.GetGameState:output_type -> poker.GetGameStateResponse
+	20,  // 84: poker.PokerService.EvaluateHand:output_type -> poker.EvaluateHandResponse
+	22,  // 85: poker.PokerService.GetLastWinners:output_type -> poker.GetLastWinnersResponse
+	25,  // 86: poker.LobbyService.CreateTable:output_type -> poker.CreateTableResponse
+	27,  // 87: poker.LobbyService.JoinTable:output_type -> poker.JoinTableResponse
+	35,  // 88: poker.LobbyService.LeaveTable:output_type -> poker.LeaveTableResponse
+	37,  // 89: poker.LobbyService.GetTables:output_type -> poker.GetTablesResponse
+	84,  // 90: poker.LobbyService.GetPlayerCurrentTable:output_type -> poker.GetPlayerCurrentTableResponse
+	40,  // 91: poker.LobbyService.CreateTableInvite:output_type -> poker.CreateTableInviteResponse
+	29,  // 92: poker.LobbyService.ReserveSeat:output_type -> poker.ReserveSeatResponse
+	31,  // 93: poker.LobbyService.JoinWaitlist:output_type -> poker.JoinWaitlistResponse
+	33,  // 94: poker.LobbyService.LeaveWaitlist:output_type -> poker.LeaveWaitlistResponse
+	42,  // 95: poker.LobbyService.KickPlayer:output_type -> poker.KickPlayerResponse
+	46,  // 96: poker.LobbyService.BanPlayer:output_type -> poker.BanPlayerResponse
+	48,  // 97: poker.LobbyService.PauseTable:output_type -> poker.PauseTableResponse
+	50,  // 98: poker.LobbyService.ResumeTable:output_type -> poker.ResumeTableResponse
+	52,  // 99: poker.LobbyService.CloseTable:output_type -> poker.CloseTableResponse
+	54,  // 100: poker.LobbyService.AddBot:output_type -> poker.AddBotResponse
+	44,  // 101: poker.LobbyService.MuteChatPlayer:output_type -> poker.MuteChatPlayerResponse
+	56,  // 102: poker.LobbyService.GetBalance:output_type -> poker.GetBalanceResponse
+	58,  // 103: poker.LobbyService.UpdateBalance:output_type -> poker.UpdateBalanceResponse
+	60,  // 104: poker.LobbyService.ProcessTip:output_type -> poker.ProcessTipResponse
+	63,  // 105: poker.LobbyService.GetTransactions:output_type -> poker.GetTransactionsResponse
+	66,  // 106: poker.LobbyService.RequestWithdrawal:output_type -> poker.RequestWithdrawalResponse
+	68,  // 107: poker.LobbyService.GetWithdrawals:output_type -> poker.GetWithdrawalsResponse
+	76,  // 108: poker.LobbyService.SetPlayerReady:output_type -> poker.SetPlayerReadyResponse
+	78,  // 109: poker.LobbyService.SetPlayerUnready:output_type -> poker.SetPlayerUnreadyResponse
+	80,  // 110: poker.LobbyService.SitOut:output_type -> poker.SitOutResponse
+	82,  // 111: poker.LobbyService.SitIn:output_type -> poker.SitInResponse
+	70,  // 112: poker.LobbyService.StartNotificationStream:output_type -> poker.Notification
+	91,  // 113: poker.AdminService.ListTables:output_type -> poker.AdminListTablesResponse
+	93,  // 114: poker.AdminService.EndGame:output_type -> poker.AdminEndGameResponse
+	95,  // 115: poker.AdminService.DeleteTable:output_type -> poker.AdminDeleteTableResponse
+	97,  // 116: poker.AdminService.AdjustBalance:output_type -> poker.AdjustBalanceResponse
+	63,  // 117: poker.AdminService.GetLedger:output_type -> poker.GetTransactionsResponse
+	99,  // 118: poker.AdminService.Broadcast:output_type -> poker.BroadcastResponse
+	101, // 119: poker.AdminService.Drain:output_type -> poker.DrainResponse
+	74,  // [74:120] is the sub-list for method output_type
+	28,  // [28:74] is the sub-list for method input_type
+	28,  // [28:28] is the sub-list for extension type_name
+	28,  // [28:28] is the sub-list for extension extendee
+	0,   // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_poker_proto_init() }
@@ -3542,9 +7247,9 @@ func file_poker_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_poker_proto_rawDesc), len(file_poker_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   47,
+			NumMessages:   100,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_poker_proto_goTypes,
 		DependencyIndexes: file_poker_proto_depIdxs,
@@ -26,6 +26,8 @@ const (
 	PokerService_CallBet_FullMethodName         = "/poker.PokerService/CallBet"
 	PokerService_FoldBet_FullMethodName         = "/poker.PokerService/FoldBet"
 	PokerService_CheckBet_FullMethodName        = "/poker.PokerService/CheckBet"
+	PokerService_UseTimeBank_FullMethodName     = "/poker.PokerService/UseTimeBank"
+	PokerService_SendChatMessage_FullMethodName = "/poker.PokerService/SendChatMessage"
 	PokerService_GetGameState_FullMethodName    = "/poker.PokerService/GetGameState"
 	PokerService_EvaluateHand_FullMethodName    = "/poker.PokerService/EvaluateHand"
 	PokerService_GetLastWinners_FullMethodName  = "/poker.PokerService/GetLastWinners"
@@ -47,6 +49,10 @@ type PokerServiceClient interface {
 	CallBet(ctx context.Context, in *CallBetRequest, opts ...grpc.CallOption) (*CallBetResponse, error)
 	FoldBet(ctx context.Context, in *FoldBetRequest, opts ...grpc.CallOption) (*FoldBetResponse, error)
 	CheckBet(ctx context.Context, in *CheckBetRequest, opts ...grpc.CallOption) (*CheckBetResponse, error)
+	// Adds the player's time bank to the clock of their turn
+	UseTimeBank(ctx context.Context, in *UseTimeBankRequest, opts ...grpc.CallOption) (*UseTimeBankResponse, error)
+	// Table chat, sent to the table as CHAT_MESSAGE notifications
+	SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error)
 	// Game state
 	GetGameState(ctx context.Context, in *GetGameStateRequest, opts ...grpc.CallOption) (*GetGameStateResponse, error)
 	// Hand evaluation
@@ -142,6 +148,26 @@ func (c *pokerServiceClient) CheckBet(ctx context.Context, in *CheckBetRequest,
 	return out, nil
 }
 
+func (c *pokerServiceClient) UseTimeBank(ctx context.Context, in *UseTimeBankRequest, opts ...grpc.CallOption) (*UseTimeBankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UseTimeBankResponse)
+	err := c.cc.Invoke(ctx, PokerService_UseTimeBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*SendChatMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendChatMessageResponse)
+	err := c.cc.Invoke(ctx, PokerService_SendChatMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pokerServiceClient) GetGameState(ctx context.Context, in *GetGameStateRequest, opts ...grpc.CallOption) (*GetGameStateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetGameStateResponse)
@@ -188,6 +214,10 @@ type PokerServiceServer interface {
 	CallBet(context.Context, *CallBetRequest) (*CallBetResponse, error)
 	FoldBet(context.Context, *FoldBetRequest) (*FoldBetResponse, error)
 	CheckBet(context.Context, *CheckBetRequest) (*CheckBetResponse, error)
+	// Adds the player's time bank to the clock of their turn
+	UseTimeBank(context.Context, *UseTimeBankRequest) (*UseTimeBankResponse, error)
+	// Table chat, sent to the table as CHAT_MESSAGE notifications
+	SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageResponse, error)
 	// Game state
 	GetGameState(context.Context, *GetGameStateRequest) (*GetGameStateResponse, error)
 	// Hand evaluation
@@ -225,6 +255,12 @@ func (UnimplementedPokerServiceServer) FoldBet(context.Context, *FoldBetRequest)
 func (UnimplementedPokerServiceServer) CheckBet(context.Context, *CheckBetRequest) (*CheckBetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckBet not implemented")
 }
+func (UnimplementedPokerServiceServer) UseTimeBank(context.Context, *UseTimeBankRequest) (*UseTimeBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UseTimeBank not implemented")
+}
+func (UnimplementedPokerServiceServer) SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendChatMessage not implemented")
+}
 func (UnimplementedPokerServiceServer) GetGameState(context.Context, *GetGameStateRequest) (*GetGameStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGameState not implemented")
 }
@@ -374,6 +410,42 @@ func _PokerService_CheckBet_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PokerService_UseTimeBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UseTimeBankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).UseTimeBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerService_UseTimeBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).UseTimeBank(ctx, req.(*UseTimeBankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_SendChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendChatMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).SendChatMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PokerService_SendChatMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).SendChatMessage(ctx, req.(*SendChatMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PokerService_GetGameState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetGameStateRequest)
 	if err := dec(in); err != nil {
@@ -459,6 +531,14 @@ var PokerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckBet",
 			Handler:    _PokerService_CheckBet_Handler,
 		},
+		{
+			MethodName: "UseTimeBank",
+			Handler:    _PokerService_UseTimeBank_Handler,
+		},
+		{
+			MethodName: "SendChatMessage",
+			Handler:    _PokerService_SendChatMessage_Handler,
+		},
 		{
 			MethodName: "GetGameState",
 			Handler:    _PokerService_GetGameState_Handler,
@@ -488,11 +568,27 @@ const (
 	LobbyService_LeaveTable_FullMethodName              = "/poker.LobbyService/LeaveTable"
 	LobbyService_GetTables_FullMethodName               = "/poker.LobbyService/GetTables"
 	LobbyService_GetPlayerCurrentTable_FullMethodName   = "/poker.LobbyService/GetPlayerCurrentTable"
+	LobbyService_CreateTableInvite_FullMethodName       = "/poker.LobbyService/CreateTableInvite"
+	LobbyService_ReserveSeat_FullMethodName             = "/poker.LobbyService/ReserveSeat"
+	LobbyService_JoinWaitlist_FullMethodName            = "/poker.LobbyService/JoinWaitlist"
+	LobbyService_LeaveWaitlist_FullMethodName           = "/poker.LobbyService/LeaveWaitlist"
+	LobbyService_KickPlayer_FullMethodName              = "/poker.LobbyService/KickPlayer"
+	LobbyService_BanPlayer_FullMethodName               = "/poker.LobbyService/BanPlayer"
+	LobbyService_PauseTable_FullMethodName              = "/poker.LobbyService/PauseTable"
+	LobbyService_ResumeTable_FullMethodName             = "/poker.LobbyService/ResumeTable"
+	LobbyService_CloseTable_FullMethodName              = "/poker.LobbyService/CloseTable"
+	LobbyService_AddBot_FullMethodName                  = "/poker.LobbyService/AddBot"
+	LobbyService_MuteChatPlayer_FullMethodName          = "/poker.LobbyService/MuteChatPlayer"
 	LobbyService_GetBalance_FullMethodName              = "/poker.LobbyService/GetBalance"
 	LobbyService_UpdateBalance_FullMethodName           = "/poker.LobbyService/UpdateBalance"
 	LobbyService_ProcessTip_FullMethodName              = "/poker.LobbyService/ProcessTip"
+	LobbyService_GetTransactions_FullMethodName         = "/poker.LobbyService/GetTransactions"
+	LobbyService_RequestWithdrawal_FullMethodName       = "/poker.LobbyService/RequestWithdrawal"
+	LobbyService_GetWithdrawals_FullMethodName          = "/poker.LobbyService/GetWithdrawals"
 	LobbyService_SetPlayerReady_FullMethodName          = "/poker.LobbyService/SetPlayerReady"
 	LobbyService_SetPlayerUnready_FullMethodName        = "/poker.LobbyService/SetPlayerUnready"
+	LobbyService_SitOut_FullMethodName                  = "/poker.LobbyService/SitOut"
+	LobbyService_SitIn_FullMethodName                   = "/poker.LobbyService/SitIn"
 	LobbyService_StartNotificationStream_FullMethodName = "/poker.LobbyService/StartNotificationStream"
 )
 
@@ -508,13 +604,31 @@ type LobbyServiceClient interface {
 	LeaveTable(ctx context.Context, in *LeaveTableRequest, opts ...grpc.CallOption) (*LeaveTableResponse, error)
 	GetTables(ctx context.Context, in *GetTablesRequest, opts ...grpc.CallOption) (*GetTablesResponse, error)
 	GetPlayerCurrentTable(ctx context.Context, in *GetPlayerCurrentTableRequest, opts ...grpc.CallOption) (*GetPlayerCurrentTableResponse, error)
+	CreateTableInvite(ctx context.Context, in *CreateTableInviteRequest, opts ...grpc.CallOption) (*CreateTableInviteResponse, error)
+	ReserveSeat(ctx context.Context, in *ReserveSeatRequest, opts ...grpc.CallOption) (*ReserveSeatResponse, error)
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error)
+	LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error)
+	// Host moderation
+	KickPlayer(ctx context.Context, in *KickPlayerRequest, opts ...grpc.CallOption) (*KickPlayerResponse, error)
+	BanPlayer(ctx context.Context, in *BanPlayerRequest, opts ...grpc.CallOption) (*BanPlayerResponse, error)
+	PauseTable(ctx context.Context, in *PauseTableRequest, opts ...grpc.CallOption) (*PauseTableResponse, error)
+	ResumeTable(ctx context.Context, in *ResumeTableRequest, opts ...grpc.CallOption) (*ResumeTableResponse, error)
+	CloseTable(ctx context.Context, in *CloseTableRequest, opts ...grpc.CallOption) (*CloseTableResponse, error)
+	AddBot(ctx context.Context, in *AddBotRequest, opts ...grpc.CallOption) (*AddBotResponse, error)
+	MuteChatPlayer(ctx context.Context, in *MuteChatPlayerRequest, opts ...grpc.CallOption) (*MuteChatPlayerResponse, error)
 	// Player management
 	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
 	UpdateBalance(ctx context.Context, in *UpdateBalanceRequest, opts ...grpc.CallOption) (*UpdateBalanceResponse, error)
 	ProcessTip(ctx context.Context, in *ProcessTipRequest, opts ...grpc.CallOption) (*ProcessTipResponse, error)
+	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+	RequestWithdrawal(ctx context.Context, in *RequestWithdrawalRequest, opts ...grpc.CallOption) (*RequestWithdrawalResponse, error)
+	GetWithdrawals(ctx context.Context, in *GetWithdrawalsRequest, opts ...grpc.CallOption) (*GetWithdrawalsResponse, error)
 	// Ready state management
 	SetPlayerReady(ctx context.Context, in *SetPlayerReadyRequest, opts ...grpc.CallOption) (*SetPlayerReadyResponse, error)
 	SetPlayerUnready(ctx context.Context, in *SetPlayerUnreadyRequest, opts ...grpc.CallOption) (*SetPlayerUnreadyResponse, error)
+	// Sitting out: keep the seat without being dealt in
+	SitOut(ctx context.Context, in *SitOutRequest, opts ...grpc.CallOption) (*SitOutResponse, error)
+	SitIn(ctx context.Context, in *SitInRequest, opts ...grpc.CallOption) (*SitInResponse, error)
 	// Notification stream
 	StartNotificationStream(ctx context.Context, in *StartNotificationStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error)
 }
@@ -577,6 +691,116 @@ func (c *lobbyServiceClient) GetPlayerCurrentTable(ctx context.Context, in *GetP
 	return out, nil
 }
 
+func (c *lobbyServiceClient) CreateTableInvite(ctx context.Context, in *CreateTableInviteRequest, opts ...grpc.CallOption) (*CreateTableInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTableInviteResponse)
+	err := c.cc.Invoke(ctx, LobbyService_CreateTableInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) ReserveSeat(ctx context.Context, in *ReserveSeatRequest, opts ...grpc.CallOption) (*ReserveSeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveSeatResponse)
+	err := c.cc.Invoke(ctx, LobbyService_ReserveSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinWaitlistResponse)
+	err := c.cc.Invoke(ctx, LobbyService_JoinWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveWaitlistResponse)
+	err := c.cc.Invoke(ctx, LobbyService_LeaveWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) KickPlayer(ctx context.Context, in *KickPlayerRequest, opts ...grpc.CallOption) (*KickPlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KickPlayerResponse)
+	err := c.cc.Invoke(ctx, LobbyService_KickPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) BanPlayer(ctx context.Context, in *BanPlayerRequest, opts ...grpc.CallOption) (*BanPlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BanPlayerResponse)
+	err := c.cc.Invoke(ctx, LobbyService_BanPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) PauseTable(ctx context.Context, in *PauseTableRequest, opts ...grpc.CallOption) (*PauseTableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseTableResponse)
+	err := c.cc.Invoke(ctx, LobbyService_PauseTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) ResumeTable(ctx context.Context, in *ResumeTableRequest, opts ...grpc.CallOption) (*ResumeTableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeTableResponse)
+	err := c.cc.Invoke(ctx, LobbyService_ResumeTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) CloseTable(ctx context.Context, in *CloseTableRequest, opts ...grpc.CallOption) (*CloseTableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseTableResponse)
+	err := c.cc.Invoke(ctx, LobbyService_CloseTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) AddBot(ctx context.Context, in *AddBotRequest, opts ...grpc.CallOption) (*AddBotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBotResponse)
+	err := c.cc.Invoke(ctx, LobbyService_AddBot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) MuteChatPlayer(ctx context.Context, in *MuteChatPlayerRequest, opts ...grpc.CallOption) (*MuteChatPlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteChatPlayerResponse)
+	err := c.cc.Invoke(ctx, LobbyService_MuteChatPlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lobbyServiceClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetBalanceResponse)
@@ -607,6 +831,36 @@ func (c *lobbyServiceClient) ProcessTip(ctx context.Context, in *ProcessTipReque
 	return out, nil
 }
 
+func (c *lobbyServiceClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionsResponse)
+	err := c.cc.Invoke(ctx, LobbyService_GetTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) RequestWithdrawal(ctx context.Context, in *RequestWithdrawalRequest, opts ...grpc.CallOption) (*RequestWithdrawalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestWithdrawalResponse)
+	err := c.cc.Invoke(ctx, LobbyService_RequestWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) GetWithdrawals(ctx context.Context, in *GetWithdrawalsRequest, opts ...grpc.CallOption) (*GetWithdrawalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, LobbyService_GetWithdrawals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lobbyServiceClient) SetPlayerReady(ctx context.Context, in *SetPlayerReadyRequest, opts ...grpc.CallOption) (*SetPlayerReadyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetPlayerReadyResponse)
@@ -627,6 +881,26 @@ func (c *lobbyServiceClient) SetPlayerUnready(ctx context.Context, in *SetPlayer
 	return out, nil
 }
 
+func (c *lobbyServiceClient) SitOut(ctx context.Context, in *SitOutRequest, opts ...grpc.CallOption) (*SitOutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SitOutResponse)
+	err := c.cc.Invoke(ctx, LobbyService_SitOut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lobbyServiceClient) SitIn(ctx context.Context, in *SitInRequest, opts ...grpc.CallOption) (*SitInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SitInResponse)
+	err := c.cc.Invoke(ctx, LobbyService_SitIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lobbyServiceClient) StartNotificationStream(ctx context.Context, in *StartNotificationStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Notification], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &LobbyService_ServiceDesc.Streams[0], LobbyService_StartNotificationStream_FullMethodName, cOpts...)
@@ -658,13 +932,31 @@ type LobbyServiceServer interface {
 	LeaveTable(context.Context, *LeaveTableRequest) (*LeaveTableResponse, error)
 	GetTables(context.Context, *GetTablesRequest) (*GetTablesResponse, error)
 	GetPlayerCurrentTable(context.Context, *GetPlayerCurrentTableRequest) (*GetPlayerCurrentTableResponse, error)
+	CreateTableInvite(context.Context, *CreateTableInviteRequest) (*CreateTableInviteResponse, error)
+	ReserveSeat(context.Context, *ReserveSeatRequest) (*ReserveSeatResponse, error)
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error)
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error)
+	// Host moderation
+	KickPlayer(context.Context, *KickPlayerRequest) (*KickPlayerResponse, error)
+	BanPlayer(context.Context, *BanPlayerRequest) (*BanPlayerResponse, error)
+	PauseTable(context.Context, *PauseTableRequest) (*PauseTableResponse, error)
+	ResumeTable(context.Context, *ResumeTableRequest) (*ResumeTableResponse, error)
+	CloseTable(context.Context, *CloseTableRequest) (*CloseTableResponse, error)
+	AddBot(context.Context, *AddBotRequest) (*AddBotResponse, error)
+	MuteChatPlayer(context.Context, *MuteChatPlayerRequest) (*MuteChatPlayerResponse, error)
 	// Player management
 	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
 	UpdateBalance(context.Context, *UpdateBalanceRequest) (*UpdateBalanceResponse, error)
 	ProcessTip(context.Context, *ProcessTipRequest) (*ProcessTipResponse, error)
+	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
+	RequestWithdrawal(context.Context, *RequestWithdrawalRequest) (*RequestWithdrawalResponse, error)
+	GetWithdrawals(context.Context, *GetWithdrawalsRequest) (*GetWithdrawalsResponse, error)
 	// Ready state management
 	SetPlayerReady(context.Context, *SetPlayerReadyRequest) (*SetPlayerReadyResponse, error)
 	SetPlayerUnready(context.Context, *SetPlayerUnreadyRequest) (*SetPlayerUnreadyResponse, error)
+	// Sitting out: keep the seat without being dealt in
+	SitOut(context.Context, *SitOutRequest) (*SitOutResponse, error)
+	SitIn(context.Context, *SitInRequest) (*SitInResponse, error)
 	// Notification stream
 	StartNotificationStream(*StartNotificationStreamRequest, grpc.ServerStreamingServer[Notification]) error
 	mustEmbedUnimplementedLobbyServiceServer()
@@ -692,6 +984,39 @@ func (UnimplementedLobbyServiceServer) GetTables(context.Context, *GetTablesRequ
 func (UnimplementedLobbyServiceServer) GetPlayerCurrentTable(context.Context, *GetPlayerCurrentTableRequest) (*GetPlayerCurrentTableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerCurrentTable not implemented")
 }
+func (UnimplementedLobbyServiceServer) CreateTableInvite(context.Context, *CreateTableInviteRequest) (*CreateTableInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTableInvite not implemented")
+}
+func (UnimplementedLobbyServiceServer) ReserveSeat(context.Context, *ReserveSeatRequest) (*ReserveSeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveSeat not implemented")
+}
+func (UnimplementedLobbyServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedLobbyServiceServer) LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedLobbyServiceServer) KickPlayer(context.Context, *KickPlayerRequest) (*KickPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickPlayer not implemented")
+}
+func (UnimplementedLobbyServiceServer) BanPlayer(context.Context, *BanPlayerRequest) (*BanPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanPlayer not implemented")
+}
+func (UnimplementedLobbyServiceServer) PauseTable(context.Context, *PauseTableRequest) (*PauseTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTable not implemented")
+}
+func (UnimplementedLobbyServiceServer) ResumeTable(context.Context, *ResumeTableRequest) (*ResumeTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTable not implemented")
+}
+func (UnimplementedLobbyServiceServer) CloseTable(context.Context, *CloseTableRequest) (*CloseTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseTable not implemented")
+}
+func (UnimplementedLobbyServiceServer) AddBot(context.Context, *AddBotRequest) (*AddBotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBot not implemented")
+}
+func (UnimplementedLobbyServiceServer) MuteChatPlayer(context.Context, *MuteChatPlayerRequest) (*MuteChatPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteChatPlayer not implemented")
+}
 func (UnimplementedLobbyServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
 }
@@ -701,12 +1026,27 @@ func (UnimplementedLobbyServiceServer) UpdateBalance(context.Context, *UpdateBal
 func (UnimplementedLobbyServiceServer) ProcessTip(context.Context, *ProcessTipRequest) (*ProcessTipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessTip not implemented")
 }
+func (UnimplementedLobbyServiceServer) GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (UnimplementedLobbyServiceServer) RequestWithdrawal(context.Context, *RequestWithdrawalRequest) (*RequestWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestWithdrawal not implemented")
+}
+func (UnimplementedLobbyServiceServer) GetWithdrawals(context.Context, *GetWithdrawalsRequest) (*GetWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawals not implemented")
+}
 func (UnimplementedLobbyServiceServer) SetPlayerReady(context.Context, *SetPlayerReadyRequest) (*SetPlayerReadyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPlayerReady not implemented")
 }
 func (UnimplementedLobbyServiceServer) SetPlayerUnready(context.Context, *SetPlayerUnreadyRequest) (*SetPlayerUnreadyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPlayerUnready not implemented")
 }
+func (UnimplementedLobbyServiceServer) SitOut(context.Context, *SitOutRequest) (*SitOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SitOut not implemented")
+}
+func (UnimplementedLobbyServiceServer) SitIn(context.Context, *SitInRequest) (*SitInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SitIn not implemented")
+}
 func (UnimplementedLobbyServiceServer) StartNotificationStream(*StartNotificationStreamRequest, grpc.ServerStreamingServer[Notification]) error {
 	return status.Errorf(codes.Unimplemented, "method StartNotificationStream not implemented")
 }
@@ -821,135 +1161,467 @@ func _LobbyService_GetPlayerCurrentTable_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LobbyService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBalanceRequest)
+func _LobbyService_CreateTableInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableInviteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LobbyServiceServer).GetBalance(ctx, in)
+		return srv.(LobbyServiceServer).CreateTableInvite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LobbyService_GetBalance_FullMethodName,
+		FullMethod: LobbyService_CreateTableInvite_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LobbyServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+		return srv.(LobbyServiceServer).CreateTableInvite(ctx, req.(*CreateTableInviteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LobbyService_UpdateBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBalanceRequest)
+func _LobbyService_ReserveSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveSeatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LobbyServiceServer).UpdateBalance(ctx, in)
+		return srv.(LobbyServiceServer).ReserveSeat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LobbyService_UpdateBalance_FullMethodName,
+		FullMethod: LobbyService_ReserveSeat_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LobbyServiceServer).UpdateBalance(ctx, req.(*UpdateBalanceRequest))
+		return srv.(LobbyServiceServer).ReserveSeat(ctx, req.(*ReserveSeatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LobbyService_ProcessTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProcessTipRequest)
+func _LobbyService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LobbyServiceServer).ProcessTip(ctx, in)
+		return srv.(LobbyServiceServer).JoinWaitlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LobbyService_ProcessTip_FullMethodName,
+		FullMethod: LobbyService_JoinWaitlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LobbyServiceServer).ProcessTip(ctx, req.(*ProcessTipRequest))
+		return srv.(LobbyServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LobbyService_SetPlayerReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetPlayerReadyRequest)
+func _LobbyService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LobbyServiceServer).SetPlayerReady(ctx, in)
+		return srv.(LobbyServiceServer).LeaveWaitlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LobbyService_SetPlayerReady_FullMethodName,
+		FullMethod: LobbyService_LeaveWaitlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LobbyServiceServer).SetPlayerReady(ctx, req.(*SetPlayerReadyRequest))
+		return srv.(LobbyServiceServer).LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LobbyService_SetPlayerUnready_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetPlayerUnreadyRequest)
+func _LobbyService_KickPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickPlayerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LobbyServiceServer).SetPlayerUnready(ctx, in)
+		return srv.(LobbyServiceServer).KickPlayer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LobbyService_SetPlayerUnready_FullMethodName,
+		FullMethod: LobbyService_KickPlayer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LobbyServiceServer).SetPlayerUnready(ctx, req.(*SetPlayerUnreadyRequest))
+		return srv.(LobbyServiceServer).KickPlayer(ctx, req.(*KickPlayerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LobbyService_StartNotificationStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StartNotificationStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _LobbyService_BanPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(LobbyServiceServer).StartNotificationStream(m, &grpc.GenericServerStream[StartNotificationStreamRequest, Notification]{ServerStream: stream})
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).BanPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_BanPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).BanPlayer(ctx, req.(*BanPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type LobbyService_StartNotificationStreamServer = grpc.ServerStreamingServer[Notification]
+func _LobbyService_PauseTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).PauseTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_PauseTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).PauseTable(ctx, req.(*PauseTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// LobbyService_ServiceDesc is the grpc.ServiceDesc for LobbyService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var LobbyService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "poker.LobbyService",
-	HandlerType: (*LobbyServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateTable",
-			Handler:    _LobbyService_CreateTable_Handler,
-		},
-		{
-			MethodName: "JoinTable",
-			Handler:    _LobbyService_JoinTable_Handler,
-		},
-		{
-			MethodName: "LeaveTable",
-			Handler:    _LobbyService_LeaveTable_Handler,
-		},
-		{
-			MethodName: "GetTables",
-			Handler:    _LobbyService_GetTables_Handler,
-		},
-		{
-			MethodName: "GetPlayerCurrentTable",
-			Handler:    _LobbyService_GetPlayerCurrentTable_Handler,
-		},
-		{
+func _LobbyService_ResumeTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).ResumeTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_ResumeTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).ResumeTable(ctx, req.(*ResumeTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_CloseTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).CloseTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_CloseTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).CloseTable(ctx, req.(*CloseTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_AddBot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).AddBot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_AddBot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).AddBot(ctx, req.(*AddBotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_MuteChatPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteChatPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).MuteChatPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_MuteChatPlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).MuteChatPlayer(ctx, req.(*MuteChatPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_UpdateBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).UpdateBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_UpdateBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).UpdateBalance(ctx, req.(*UpdateBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_ProcessTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).ProcessTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_ProcessTip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).ProcessTip(ctx, req.(*ProcessTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_GetTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_RequestWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).RequestWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_RequestWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).RequestWithdrawal(ctx, req.(*RequestWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_GetWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).GetWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_GetWithdrawals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).GetWithdrawals(ctx, req.(*GetWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_SetPlayerReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlayerReadyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).SetPlayerReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_SetPlayerReady_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).SetPlayerReady(ctx, req.(*SetPlayerReadyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_SetPlayerUnready_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlayerUnreadyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).SetPlayerUnready(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_SetPlayerUnready_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).SetPlayerUnready(ctx, req.(*SetPlayerUnreadyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_SitOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).SitOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_SitOut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).SitOut(ctx, req.(*SitOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_SitIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LobbyServiceServer).SitIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LobbyService_SitIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LobbyServiceServer).SitIn(ctx, req.(*SitInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LobbyService_StartNotificationStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StartNotificationStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LobbyServiceServer).StartNotificationStream(m, &grpc.GenericServerStream[StartNotificationStreamRequest, Notification]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LobbyService_StartNotificationStreamServer = grpc.ServerStreamingServer[Notification]
+
+// LobbyService_ServiceDesc is the grpc.ServiceDesc for LobbyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LobbyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "poker.LobbyService",
+	HandlerType: (*LobbyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTable",
+			Handler:    _LobbyService_CreateTable_Handler,
+		},
+		{
+			MethodName: "JoinTable",
+			Handler:    _LobbyService_JoinTable_Handler,
+		},
+		{
+			MethodName: "LeaveTable",
+			Handler:    _LobbyService_LeaveTable_Handler,
+		},
+		{
+			MethodName: "GetTables",
+			Handler:    _LobbyService_GetTables_Handler,
+		},
+		{
+			MethodName: "GetPlayerCurrentTable",
+			Handler:    _LobbyService_GetPlayerCurrentTable_Handler,
+		},
+		{
+			MethodName: "CreateTableInvite",
+			Handler:    _LobbyService_CreateTableInvite_Handler,
+		},
+		{
+			MethodName: "ReserveSeat",
+			Handler:    _LobbyService_ReserveSeat_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _LobbyService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _LobbyService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "KickPlayer",
+			Handler:    _LobbyService_KickPlayer_Handler,
+		},
+		{
+			MethodName: "BanPlayer",
+			Handler:    _LobbyService_BanPlayer_Handler,
+		},
+		{
+			MethodName: "PauseTable",
+			Handler:    _LobbyService_PauseTable_Handler,
+		},
+		{
+			MethodName: "ResumeTable",
+			Handler:    _LobbyService_ResumeTable_Handler,
+		},
+		{
+			MethodName: "CloseTable",
+			Handler:    _LobbyService_CloseTable_Handler,
+		},
+		{
+			MethodName: "AddBot",
+			Handler:    _LobbyService_AddBot_Handler,
+		},
+		{
+			MethodName: "MuteChatPlayer",
+			Handler:    _LobbyService_MuteChatPlayer_Handler,
+		},
+		{
 			MethodName: "GetBalance",
 			Handler:    _LobbyService_GetBalance_Handler,
 		},
@@ -961,6 +1633,18 @@ var LobbyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessTip",
 			Handler:    _LobbyService_ProcessTip_Handler,
 		},
+		{
+			MethodName: "GetTransactions",
+			Handler:    _LobbyService_GetTransactions_Handler,
+		},
+		{
+			MethodName: "RequestWithdrawal",
+			Handler:    _LobbyService_RequestWithdrawal_Handler,
+		},
+		{
+			MethodName: "GetWithdrawals",
+			Handler:    _LobbyService_GetWithdrawals_Handler,
+		},
 		{
 			MethodName: "SetPlayerReady",
 			Handler:    _LobbyService_SetPlayerReady_Handler,
@@ -969,6 +1653,14 @@ var LobbyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetPlayerUnready",
 			Handler:    _LobbyService_SetPlayerUnready_Handler,
 		},
+		{
+			MethodName: "SitOut",
+			Handler:    _LobbyService_SitOut_Handler,
+		},
+		{
+			MethodName: "SitIn",
+			Handler:    _LobbyService_SitIn_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -979,3 +1671,345 @@ var LobbyService_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "poker.proto",
 }
+
+const (
+	AdminService_ListTables_FullMethodName    = "/poker.AdminService/ListTables"
+	AdminService_EndGame_FullMethodName       = "/poker.AdminService/EndGame"
+	AdminService_DeleteTable_FullMethodName   = "/poker.AdminService/DeleteTable"
+	AdminService_AdjustBalance_FullMethodName = "/poker.AdminService/AdjustBalance"
+	AdminService_GetLedger_FullMethodName     = "/poker.AdminService/GetLedger"
+	AdminService_Broadcast_FullMethodName     = "/poker.AdminService/Broadcast"
+	AdminService_Drain_FullMethodName         = "/poker.AdminService/Drain"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminService lets server operators inspect and repair the server. It is
+// only served on a local socket or behind an admin token.
+type AdminServiceClient interface {
+	// Tables
+	ListTables(ctx context.Context, in *AdminListTablesRequest, opts ...grpc.CallOption) (*AdminListTablesResponse, error)
+	EndGame(ctx context.Context, in *AdminEndGameRequest, opts ...grpc.CallOption) (*AdminEndGameResponse, error)
+	DeleteTable(ctx context.Context, in *AdminDeleteTableRequest, opts ...grpc.CallOption) (*AdminDeleteTableResponse, error)
+	// Balances
+	AdjustBalance(ctx context.Context, in *AdjustBalanceRequest, opts ...grpc.CallOption) (*AdjustBalanceResponse, error)
+	GetLedger(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+	// Server
+	Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error)
+	Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) ListTables(ctx context.Context, in *AdminListTablesRequest, opts ...grpc.CallOption) (*AdminListTablesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListTablesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListTables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) EndGame(ctx context.Context, in *AdminEndGameRequest, opts ...grpc.CallOption) (*AdminEndGameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminEndGameResponse)
+	err := c.cc.Invoke(ctx, AdminService_EndGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteTable(ctx context.Context, in *AdminDeleteTableRequest, opts ...grpc.CallOption) (*AdminDeleteTableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminDeleteTableResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdjustBalance(ctx context.Context, in *AdjustBalanceRequest, opts ...grpc.CallOption) (*AdjustBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustBalanceResponse)
+	err := c.cc.Invoke(ctx, AdminService_AdjustBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetLedger(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Broadcast(ctx context.Context, in *BroadcastRequest, opts ...grpc.CallOption) (*BroadcastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BroadcastResponse)
+	err := c.cc.Invoke(ctx, AdminService_Broadcast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrainResponse)
+	err := c.cc.Invoke(ctx, AdminService_Drain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// AdminService lets server operators inspect and repair the server. It is
+// only served on a local socket or behind an admin token.
+type AdminServiceServer interface {
+	// Tables
+	ListTables(context.Context, *AdminListTablesRequest) (*AdminListTablesResponse, error)
+	EndGame(context.Context, *AdminEndGameRequest) (*AdminEndGameResponse, error)
+	DeleteTable(context.Context, *AdminDeleteTableRequest) (*AdminDeleteTableResponse, error)
+	// Balances
+	AdjustBalance(context.Context, *AdjustBalanceRequest) (*AdjustBalanceResponse, error)
+	GetLedger(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
+	// Server
+	Broadcast(context.Context, *BroadcastRequest) (*BroadcastResponse, error)
+	Drain(context.Context, *DrainRequest) (*DrainResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) ListTables(context.Context, *AdminListTablesRequest) (*AdminListTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTables not implemented")
+}
+func (UnimplementedAdminServiceServer) EndGame(context.Context, *AdminEndGameRequest) (*AdminEndGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndGame not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteTable(context.Context, *AdminDeleteTableRequest) (*AdminDeleteTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTable not implemented")
+}
+func (UnimplementedAdminServiceServer) AdjustBalance(context.Context, *AdjustBalanceRequest) (*AdjustBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustBalance not implemented")
+}
+func (UnimplementedAdminServiceServer) GetLedger(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLedger not implemented")
+}
+func (UnimplementedAdminServiceServer) Broadcast(context.Context, *BroadcastRequest) (*BroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Broadcast not implemented")
+}
+func (UnimplementedAdminServiceServer) Drain(context.Context, *DrainRequest) (*DrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_ListTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListTables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListTables(ctx, req.(*AdminListTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_EndGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminEndGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).EndGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_EndGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).EndGame(ctx, req.(*AdminEndGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDeleteTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteTable(ctx, req.(*AdminDeleteTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdjustBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdjustBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdjustBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdjustBalance(ctx, req.(*AdjustBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetLedger(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Broadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Broadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_Broadcast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Broadcast(ctx, req.(*BroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_Drain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Drain(ctx, req.(*DrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "poker.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTables",
+			Handler:    _AdminService_ListTables_Handler,
+		},
+		{
+			MethodName: "EndGame",
+			Handler:    _AdminService_EndGame_Handler,
+		},
+		{
+			MethodName: "DeleteTable",
+			Handler:    _AdminService_DeleteTable_Handler,
+		},
+		{
+			MethodName: "AdjustBalance",
+			Handler:    _AdminService_AdjustBalance_Handler,
+		},
+		{
+			MethodName: "GetLedger",
+			Handler:    _AdminService_GetLedger_Handler,
+		},
+		{
+			MethodName: "Broadcast",
+			Handler:    _AdminService_Broadcast_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _AdminService_Drain_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "poker.proto",
+}
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultInviteTTL is the lifetime of invites created without one.
+	defaultInviteTTL = 24 * time.Hour
+	// maxInviteTTL bounds the lifetime of an invite.
+	maxInviteTTL = 7 * 24 * time.Hour
+)
+
+// newTableAccess returns the access restrictions of a new table, or nil for a
+// public table.
+func newTableAccess(tableID string, private bool, password string) (*TableAccess, error) {
+	if !private && password == "" {
+		return nil, nil
+	}
+	access := &TableAccess{TableID: tableID, Private: private}
+	if password != "" {
+		salt, err := randomHex(16)
+		if err != nil {
+			return nil, err
+		}
+		access.PasswordSalt = salt
+		access.PasswordHash = hashTablePassword(salt, password)
+	}
+	return access, nil
+}
+
+// hashTablePassword returns the hex SHA-256 of the salted password.
+func hashTablePassword(salt, password string) string {
+	sum := sha256.Sum256([]byte(salt + password))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomHex returns n random bytes, hex encoded.
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// passwordMatches returns whether password is the join password of the table.
+func passwordMatches(access *TableAccess, password string) bool {
+	if access.PasswordHash == "" || password == "" {
+		return false
+	}
+	hash := hashTablePassword(access.PasswordSalt, password)
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(access.PasswordHash)) == 1
+}
+
+// restricted returns whether the access restrictions keep anyone out.
+func restricted(access *TableAccess) bool {
+	return access != nil && (access.Private || access.PasswordHash != "")
+}
+
+// activeInvites returns the unexpired invites of a table.
+func (s *Server) activeInvites(tableID string) ([]TableInvite, error) {
+	invites, err := s.db.GetTableInvites(tableID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	active := invites[:0]
+	for _, inv := range invites {
+		if now.Before(inv.ExpiresAt) {
+			active = append(active, inv)
+		}
+	}
+	return active, nil
+}
+
+// tableVisible returns whether the table is listed to the player. Private
+// tables are only listed to their host, their players and the players with an
+// invite.
+func (s *Server) tableVisible(table *poker.Table, access *TableAccess, playerID string) (bool, error) {
+	if access == nil || !access.Private {
+		return true, nil
+	}
+	if playerID == "" {
+		return false, nil
+	}
+	if table.GetConfig().HostID == playerID || table.GetUser(playerID) != nil {
+		return true, nil
+	}
+	invites, err := s.activeInvites(access.TableID)
+	if err != nil {
+		return false, err
+	}
+	for _, inv := range invites {
+		if inv.InviteeID == playerID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// joinDenied returns why the player may not join the table, or an empty
+// string when they may. Players the host banned are kept out; otherwise
+// players on the invite list, holding a valid invite code or giving the table
+// password are let in.
+func (s *Server) joinDenied(table *poker.Table, req *pokerrpc.JoinTableRequest) (string, error) {
+	ban, err := s.db.GetTableBan(req.TableId, req.PlayerId)
+	if err != nil {
+		return "", err
+	}
+	if ban != nil {
+		return "You are banned from this table", nil
+	}
+
+	access, err := s.db.GetTableAccess(req.TableId)
+	if err != nil {
+		return "", err
+	}
+	if !restricted(access) || table.GetConfig().HostID == req.PlayerId {
+		return "", nil
+	}
+
+	invites, err := s.activeInvites(req.TableId)
+	if err != nil {
+		return "", err
+	}
+	for _, inv := range invites {
+		if inv.InviteeID == req.PlayerId {
+			return "", nil
+		}
+		if req.InviteCode != "" && inv.Code == req.InviteCode && inv.InviteeID == "" {
+			return "", nil
+		}
+	}
+	if passwordMatches(access, req.Password) {
+		return "", nil
+	}
+
+	switch {
+	case req.InviteCode != "":
+		return "Invalid or expired invite code", nil
+	case access.PasswordHash != "" && req.Password != "":
+		return "Incorrect table password", nil
+	case access.PasswordHash != "":
+		return "Table requires a password or an invite", nil
+	default:
+		return "Table is private; an invite is required", nil
+	}
+}
+
+// CreateTableInvite issues an invite code to a table. Only the table host
+// may invite players.
+func (s *Server) CreateTableInvite(ctx context.Context, req *pokerrpc.CreateTableInviteRequest) (*pokerrpc.CreateTableInviteResponse, error) {
+	if req.PlayerId == "" || req.TableId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id and table_id are required")
+	}
+	if req.TtlSeconds < 0 || req.TtlSeconds > int64(maxInviteTTL/time.Second) {
+		return nil, status.Errorf(codes.InvalidArgument, "invite lifetime must be at most %v", maxInviteTTL)
+	}
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if ttl == 0 {
+		ttl = defaultInviteTTL
+	}
+
+	s.mu.RLock()
+	table, ok := s.tables[req.TableId]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, status.Error(codes.NotFound, "table not found")
+	}
+	if table.GetConfig().HostID != req.PlayerId {
+		return nil, status.Error(codes.PermissionDenied, "only the table host can invite players")
+	}
+
+	code, err := randomHex(8)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate invite code: %v", err)
+	}
+	invite := TableInvite{
+		Code:      code,
+		TableID:   req.TableId,
+		InviteeID: req.InviteeId,
+		CreatedBy: req.PlayerId,
+		ExpiresAt: time.Now().Add(ttl).Truncate(time.Second),
+	}
+	if err := s.db.SaveTableInvite(invite); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save invite: %v", err)
+	}
+
+	return &pokerrpc.CreateTableInviteResponse{Code: code, ExpiresAt: invite.ExpiresAt.Unix()}, nil
+}
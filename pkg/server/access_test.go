@@ -0,0 +1,194 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newAccessTest returns a server where alice, bob, carol and dave hold 1000
+// atoms each.
+func newAccessTest(t *testing.T) (*Server, Database) {
+	t.Helper()
+	database := newTestDatabase(t)
+	logBackend := createTestLogBackend()
+	t.Cleanup(func() { logBackend.Close() })
+	srv := NewServer(database, logBackend)
+	t.Cleanup(srv.Stop)
+	for _, id := range []string{"alice", "bob", "carol", "dave"} {
+		require.NoError(t, database.UpdatePlayerBalance(id, 1000, TransactionDeposit, "seed"))
+	}
+	return srv, database
+}
+
+func createAccessTable(t *testing.T, srv *Server, private bool, password string) string {
+	t.Helper()
+	resp, err := srv.CreateTable(context.Background(), &pokerrpc.CreateTableRequest{
+		PlayerId:   "alice",
+		SmallBlind: 10,
+		BigBlind:   20,
+		MinPlayers: 2,
+		MaxPlayers: 6,
+		BuyIn:      100,
+		Private:    private,
+		Password:   password,
+	})
+	require.NoError(t, err)
+	return resp.TableId
+}
+
+func listedTables(t *testing.T, srv *Server, playerID string) map[string]*pokerrpc.Table {
+	t.Helper()
+	resp, err := srv.GetTables(context.Background(), &pokerrpc.GetTablesRequest{PlayerId: playerID})
+	require.NoError(t, err)
+	tables := make(map[string]*pokerrpc.Table)
+	for _, table := range resp.Tables {
+		tables[table.Id] = table
+	}
+	return tables
+}
+
+func joinTable(t *testing.T, srv *Server, req *pokerrpc.JoinTableRequest) *pokerrpc.JoinTableResponse {
+	t.Helper()
+	resp, err := srv.JoinTable(context.Background(), req)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestPrivateTable(t *testing.T) {
+	srv, _ := newAccessTest(t)
+	ctx := context.Background()
+	tableID := createAccessTable(t, srv, true, "")
+
+	assert.Contains(t, listedTables(t, srv, "alice"), tableID)
+	assert.True(t, listedTables(t, srv, "alice")[tableID].Private)
+	assert.NotContains(t, listedTables(t, srv, "bob"), tableID)
+	assert.NotContains(t, listedTables(t, srv, ""), tableID)
+	assert.NotNil(t, srv.TableInfo(tableID))
+
+	resp := joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID})
+	assert.False(t, resp.Success)
+	assert.Contains(t, resp.Message, "private")
+
+	// Only the host invites players.
+	_, err := srv.CreateTableInvite(ctx, &pokerrpc.CreateTableInviteRequest{PlayerId: "bob", TableId: tableID})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// An invite for bob puts him on the invite list.
+	invite, err := srv.CreateTableInvite(ctx, &pokerrpc.CreateTableInviteRequest{
+		PlayerId: "alice", TableId: tableID, InviteeId: "bob"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, invite.Code)
+	assert.InDelta(t, time.Now().Add(defaultInviteTTL).Unix(), invite.ExpiresAt, 5)
+	assert.Contains(t, listedTables(t, srv, "bob"), tableID)
+	assert.True(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID}).Success)
+
+	// Bob's code does not admit carol.
+	resp = joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "carol", TableId: tableID, InviteCode: invite.Code})
+	assert.False(t, resp.Success)
+	assert.Contains(t, resp.Message, "invite code")
+
+	// An open code admits whoever holds it.
+	open, err := srv.CreateTableInvite(ctx, &pokerrpc.CreateTableInviteRequest{PlayerId: "alice", TableId: tableID})
+	require.NoError(t, err)
+	assert.NotContains(t, listedTables(t, srv, "carol"), tableID)
+	assert.True(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{
+		PlayerId: "carol", TableId: tableID, InviteCode: open.Code}).Success)
+	assert.Contains(t, listedTables(t, srv, "carol"), tableID)
+}
+
+// accessRecorder records the tables access restrictions are saved for.
+type accessRecorder struct {
+	*MemoryDatabase
+	saved []string
+}
+
+func (r *accessRecorder) SaveTableAccess(a TableAccess) error {
+	r.saved = append(r.saved, a.TableID)
+	return r.MemoryDatabase.SaveTableAccess(a)
+}
+
+func TestCreateTableFailureDropsAccess(t *testing.T) {
+	database := &accessRecorder{MemoryDatabase: NewMemoryDatabase()}
+	logBackend := createTestLogBackend()
+	defer logBackend.Close()
+	srv := NewServer(database, logBackend)
+	defer srv.Stop()
+	require.NoError(t, database.UpdatePlayerBalance("alice", 1000, TransactionDeposit, "seed"))
+
+	// The buy-in debit fails after the restrictions were saved.
+	database.FailNthWrite(2, nil)
+	_, err := srv.CreateTable(context.Background(), &pokerrpc.CreateTableRequest{
+		PlayerId: "alice", SmallBlind: 10, BigBlind: 20, MinPlayers: 2, MaxPlayers: 6,
+		BuyIn: 100, Private: true,
+	})
+	require.ErrorIs(t, err, ErrInjectedFault)
+	require.Len(t, database.saved, 1)
+	access, err := database.GetTableAccess(database.saved[0])
+	require.NoError(t, err)
+	assert.Nil(t, access)
+	assert.Empty(t, srv.tables)
+}
+
+func TestPasswordTable(t *testing.T) {
+	srv, _ := newAccessTest(t)
+	tableID := createAccessTable(t, srv, false, "s3cret")
+
+	listed := listedTables(t, srv, "")
+	require.Contains(t, listed, tableID)
+	assert.True(t, listed[tableID].PasswordProtected)
+	assert.False(t, listed[tableID].Private)
+
+	resp := joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID})
+	assert.False(t, resp.Success)
+	assert.Contains(t, resp.Message, "requires a password")
+
+	resp = joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID, Password: "wrong"})
+	assert.False(t, resp.Success)
+	assert.Contains(t, resp.Message, "Incorrect")
+	requireBalance(t, srv.db, "bob", 1000)
+
+	assert.True(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID, Password: "s3cret"}).Success)
+	requireBalance(t, srv.db, "bob", 900)
+}
+
+func TestTableInviteExpiry(t *testing.T) {
+	srv, database := newAccessTest(t)
+	ctx := context.Background()
+	tableID := createAccessTable(t, srv, true, "pw")
+
+	for _, ttl := range []int64{-1, int64(maxInviteTTL/time.Second) + 1} {
+		_, err := srv.CreateTableInvite(ctx, &pokerrpc.CreateTableInviteRequest{
+			PlayerId: "alice", TableId: tableID, TtlSeconds: ttl})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "ttl %d", ttl)
+	}
+	_, err := srv.CreateTableInvite(ctx, &pokerrpc.CreateTableInviteRequest{PlayerId: "alice", TableId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	require.NoError(t, database.SaveTableInvite(TableInvite{Code: "expired", TableID: tableID,
+		InviteeID: "bob", ExpiresAt: time.Now().Add(-time.Minute)}))
+	assert.NotContains(t, listedTables(t, srv, "bob"), tableID)
+	resp := joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "dave", TableId: tableID, InviteCode: "expired"})
+	assert.False(t, resp.Success)
+
+	// The password still admits players to a private table.
+	assert.True(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "dave", TableId: tableID, Password: "pw"}).Success)
+
+	// Closing the table drops its restrictions and invites.
+	_, err = srv.LeaveTable(ctx, &pokerrpc.LeaveTableRequest{PlayerId: "dave", TableId: tableID})
+	require.NoError(t, err)
+	_, err = srv.LeaveTable(ctx, &pokerrpc.LeaveTableRequest{PlayerId: "alice", TableId: tableID})
+	require.NoError(t, err)
+	access, err := database.GetTableAccess(tableID)
+	require.NoError(t, err)
+	assert.Nil(t, access)
+	invites, err := database.GetTableInvites(tableID)
+	require.NoError(t, err)
+	assert.Empty(t, invites)
+}
@@ -0,0 +1,228 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AdminTokenMetadataKey is the gRPC metadata key carrying the admin token
+// checked by AdminTokenInterceptor.
+const AdminTokenMetadataKey = "admin-token"
+
+// drainingMessage tells players why new tables and joins are refused.
+const drainingMessage = "The server is going down for maintenance; new tables and joins are disabled"
+
+// AdminServer implements AdminService for server operators. It must only be
+// served on a local socket or behind AdminTokenInterceptor.
+type AdminServer struct {
+	pokerrpc.UnimplementedAdminServiceServer
+	srv *Server
+}
+
+// NewAdminServer creates the admin service of srv.
+func NewAdminServer(srv *Server) *AdminServer {
+	return &AdminServer{srv: srv}
+}
+
+// AdminTokenInterceptor rejects AdminService calls that do not carry token
+// in their AdminTokenMetadataKey metadata. Calls to the other services pass
+// through, so the interceptor can guard a server shared with players. The
+// token is sent in the clear, so such a server must use TLS; without it only
+// the unix socket of ListenAdminSocket is safe.
+func AdminTokenInterceptor(token string) grpc.UnaryServerInterceptor {
+	prefix := "/" + pokerrpc.AdminService_ServiceDesc.ServiceName + "/"
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, prefix) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			got := md.Get(AdminTokenMetadataKey)
+			if token == "" || len(got) != 1 || subtle.ConstantTimeCompare([]byte(got[0]), []byte(token)) != 1 {
+				return nil, status.Error(codes.Unauthenticated, "invalid admin token")
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ListenAdminSocket listens on the unix socket at path, for serving the
+// admin service to local operators. A socket left behind by a previous run
+// is replaced, and only the current user may connect to the new one.
+func ListenAdminSocket(path string) (net.Listener, error) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		lis.Close()
+		return nil, err
+	}
+	return lis, nil
+}
+
+// ListTables returns every table, including private ones, with its live
+// state.
+func (a *AdminServer) ListTables(ctx context.Context, req *pokerrpc.AdminListTablesRequest) (*pokerrpc.AdminListTablesResponse, error) {
+	s := a.srv
+	s.mu.RLock()
+	ids := make([]string, 0, len(s.tables))
+	for id := range s.tables {
+		ids = append(ids, id)
+	}
+	draining := s.draining
+	s.mu.RUnlock()
+	sort.Strings(ids)
+
+	resp := &pokerrpc.AdminListTablesResponse{Draining: draining}
+	for _, id := range ids {
+		s.mu.RLock()
+		table := s.tables[id]
+		s.mu.RUnlock()
+		info := s.TableInfo(id)
+		if table == nil || info == nil {
+			continue // Closed meanwhile
+		}
+		resp.Tables = append(resp.Tables, &pokerrpc.AdminTable{
+			Table:          info,
+			Chips:          table.ChipCounts(),
+			HandInProgress: table.HandInProgress(),
+			Paused:         table.IsPaused(),
+			Closing:        table.IsClosing(),
+			LastAction:     table.LastActivity().Unix(),
+		})
+	}
+	return resp, nil
+}
+
+// EndGame ends the game at a table. The chips bet in an unfinished hand are
+// returned to the players, who stay seated.
+func (a *AdminServer) EndGame(ctx context.Context, req *pokerrpc.AdminEndGameRequest) (*pokerrpc.AdminEndGameResponse, error) {
+	s := a.srv
+	s.mu.RLock()
+	table, ok := s.tables[req.TableId]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, status.Error(codes.NotFound, "table not found")
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = "Ended by the server operator"
+	}
+	_, hand := auditTable(table)
+	if err := table.ForceEndGame(reason); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	s.auditGameEnded(AuditActorAdmin, table, hand, reason)
+	s.log.Warnf("Admin ended the game at table %s: %s", req.TableId, reason)
+	return &pokerrpc.AdminEndGameResponse{Message: "Game ended"}, nil
+}
+
+// DeleteTable removes a table right away. A hand in progress is voided,
+// every player is cashed out and the table's persisted state is deleted.
+func (a *AdminServer) DeleteTable(ctx context.Context, req *pokerrpc.AdminDeleteTableRequest) (*pokerrpc.AdminDeleteTableResponse, error) {
+	s := a.srv
+	reason := req.Reason
+	if reason == "" {
+		reason = "Deleted by the server operator"
+	}
+
+	s.mu.Lock()
+	table, ok := s.tables[req.TableId]
+	if ok {
+		s.closeReasons[req.TableId] = reason
+	}
+	s.mu.Unlock()
+	if !ok {
+		return nil, status.Error(codes.NotFound, "table not found")
+	}
+
+	table.BeginClose()
+	if table.GetGame() != nil {
+		_, hand := auditTable(table)
+		if err := table.ForceEndGame(reason); err != nil {
+			s.log.Warnf("Failed to end the game at table %s: %v", req.TableId, err)
+		} else {
+			s.auditGameEnded(AuditActorAdmin, table, hand, reason)
+		}
+	}
+	s.finishCloseTable(AuditActorAdmin, req.TableId, table)
+	s.log.Warnf("Admin deleted table %s: %s", req.TableId, reason)
+	return &pokerrpc.AdminDeleteTableResponse{Message: "Table deleted"}, nil
+}
+
+// AdjustBalance credits or debits a player's balance. The reason is
+// mandatory and recorded in the ledger.
+func (a *AdminServer) AdjustBalance(ctx context.Context, req *pokerrpc.AdjustBalanceRequest) (*pokerrpc.AdjustBalanceResponse, error) {
+	s := a.srv
+	switch {
+	case req.PlayerId == "":
+		return nil, status.Error(codes.InvalidArgument, "player_id is required")
+	case req.Amount == 0:
+		return nil, status.Error(codes.InvalidArgument, "amount must not be zero")
+	case strings.TrimSpace(req.Reason) == "":
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	balance, err := s.updateBalance(AuditActorAdmin, req.PlayerId, nil, req.Amount, TransactionAdminAdjustment, req.Reason)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.log.Warnf("Admin adjusted balance of %s by %d atoms: %s", req.PlayerId, req.Amount, req.Reason)
+	return &pokerrpc.AdjustBalanceResponse{NewBalance: balance}, nil
+}
+
+// GetLedger returns a page of the ledger, newest first. Unlike the lobby's
+// GetTransactions, an empty player ID returns every player's transactions.
+func (a *AdminServer) GetLedger(ctx context.Context, req *pokerrpc.GetTransactionsRequest) (*pokerrpc.GetTransactionsResponse, error) {
+	return a.srv.transactionsPage(req)
+}
+
+// Broadcast sends a server message to every open notification stream.
+func (a *AdminServer) Broadcast(ctx context.Context, req *pokerrpc.BroadcastRequest) (*pokerrpc.BroadcastResponse, error) {
+	if strings.TrimSpace(req.Message) == "" {
+		return nil, status.Error(codes.InvalidArgument, "message is required")
+	}
+	n := a.srv.broadcast(&pokerrpc.Notification{
+		Type:    pokerrpc.NotificationType_SERVER_MESSAGE,
+		Message: req.Message,
+	})
+	return &pokerrpc.BroadcastResponse{Recipients: int32(n)}, nil
+}
+
+// Drain starts draining the server ahead of a restart, as StartDrain does.
+// It reports what is still running and when the drain ends at the latest.
+func (a *AdminServer) Drain(ctx context.Context, req *pokerrpc.DrainRequest) (*pokerrpc.DrainResponse, error) {
+	s := a.srv
+	if req.TimeoutSeconds < 0 {
+		return nil, status.Error(codes.InvalidArgument, "timeout_seconds must not be negative")
+	}
+	deadline := s.StartDrain(time.Duration(req.TimeoutSeconds) * time.Second)
+	s.log.Warnf("Admin started draining the server")
+
+	tables := s.allTables()
+	resp := &pokerrpc.DrainResponse{
+		ActiveTables:    int32(len(tables)),
+		HandsInProgress: int32(s.handsInProgress()),
+		Deadline:        deadline.Unix(),
+	}
+	if req.Message != "" {
+		s.broadcast(&pokerrpc.Notification{
+			Type:    pokerrpc.NotificationType_SERVER_MESSAGE,
+			Message: req.Message,
+		})
+	}
+	return resp, nil
+}
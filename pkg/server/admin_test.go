@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdminListTables(t *testing.T) {
+	srv, _, tableID := newHostTest(t)
+	admin := NewAdminServer(srv)
+	ctx := context.Background()
+
+	// Private tables are listed too.
+	privateID := createAccessTable(t, srv, true, "")
+	resp, err := admin.ListTables(ctx, &pokerrpc.AdminListTablesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Tables, 2)
+	tables := make(map[string]*pokerrpc.AdminTable)
+	for _, table := range resp.Tables {
+		tables[table.Table.Id] = table
+	}
+	require.Contains(t, tables, privateID)
+	assert.Equal(t, map[string]int64{"alice": 1000, "bob": 1000}, tables[tableID].Chips)
+	assert.False(t, tables[tableID].HandInProgress)
+
+	startHostTestGame(t, srv, tableID)
+	resp, err = admin.ListTables(ctx, &pokerrpc.AdminListTablesRequest{})
+	require.NoError(t, err)
+	for _, table := range resp.Tables {
+		assert.Equal(t, table.Table.Id == tableID, table.HandInProgress)
+	}
+}
+
+func TestAdminEndGame(t *testing.T) {
+	srv, _, tableID := newHostTest(t)
+	admin := NewAdminServer(srv)
+	ctx := context.Background()
+
+	_, err := admin.EndGame(ctx, &pokerrpc.AdminEndGameRequest{TableId: tableID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = admin.EndGame(ctx, &pokerrpc.AdminEndGameRequest{TableId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The blinds posted in the voided hand go back to the players.
+	startHostTestGame(t, srv, tableID)
+	_, err = admin.EndGame(ctx, &pokerrpc.AdminEndGameRequest{TableId: tableID, Reason: "stuck"})
+	require.NoError(t, err)
+	table := srv.tables[tableID]
+	assert.False(t, table.IsGameStarted())
+	assert.Equal(t, map[string]int64{"alice": 1000, "bob": 1000}, table.ChipCounts())
+}
+
+func TestAdminDeleteTable(t *testing.T) {
+	srv, database, tableID := newHostTest(t)
+	admin := NewAdminServer(srv)
+	ctx := context.Background()
+	startHostTestGame(t, srv, tableID)
+
+	_, err := admin.DeleteTable(ctx, &pokerrpc.AdminDeleteTableRequest{TableId: tableID})
+	require.NoError(t, err)
+	assert.Nil(t, srv.TableInfo(tableID))
+	requireBalance(t, database, "alice", 1000)
+	requireBalance(t, database, "bob", 1000)
+
+	_, err = admin.DeleteTable(ctx, &pokerrpc.AdminDeleteTableRequest{TableId: tableID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAdminAdjustBalanceAndLedger(t *testing.T) {
+	srv, database := newAccessTest(t)
+	admin := NewAdminServer(srv)
+	ctx := context.Background()
+
+	_, err := admin.AdjustBalance(ctx, &pokerrpc.AdjustBalanceRequest{PlayerId: "alice", Amount: 50})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := admin.AdjustBalance(ctx, &pokerrpc.AdjustBalanceRequest{PlayerId: "alice", Amount: -50, Reason: "chargeback"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(950), resp.NewBalance)
+	requireBalance(t, database, "alice", 950)
+
+	ledger, err := admin.GetLedger(ctx, &pokerrpc.GetTransactionsRequest{Types: []string{TransactionAdminAdjustment}})
+	require.NoError(t, err)
+	require.Len(t, ledger.Transactions, 1)
+	assert.Equal(t, "alice", ledger.Transactions[0].PlayerId)
+	assert.Equal(t, "chargeback", ledger.Transactions[0].Description)
+
+	// Without a player the ledger covers everyone.
+	ledger, err = admin.GetLedger(ctx, &pokerrpc.GetTransactionsRequest{})
+	require.NoError(t, err)
+	players := make(map[string]bool)
+	for _, tx := range ledger.Transactions {
+		players[tx.PlayerId] = true
+	}
+	assert.True(t, players["alice"] && players["bob"])
+}
+
+func TestAdminDrain(t *testing.T) {
+	srv, _, tableID := newHostTest(t)
+	admin := NewAdminServer(srv)
+	ctx := context.Background()
+
+	startHostTestGame(t, srv, tableID)
+
+	_, err := admin.Drain(ctx, &pokerrpc.DrainRequest{TimeoutSeconds: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	resp, err := admin.Drain(ctx, &pokerrpc.DrainRequest{TimeoutSeconds: 60})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.ActiveTables)
+	assert.Equal(t, int32(1), resp.HandsInProgress)
+	assert.InDelta(t, time.Now().Add(time.Minute).Unix(), resp.Deadline, 2)
+	assert.True(t, srv.IsDraining())
+
+	// Draining again keeps the first deadline.
+	again, err := admin.Drain(ctx, &pokerrpc.DrainRequest{TimeoutSeconds: 600})
+	require.NoError(t, err)
+	assert.Equal(t, resp.Deadline, again.Deadline)
+}
+
+func TestAdminTokenInterceptor(t *testing.T) {
+	intercept := AdminTokenInterceptor("secret")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	call := func(method, token string) error {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(AdminTokenMetadataKey, token))
+		}
+		_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	assert.NoError(t, call("/poker.AdminService/ListTables", "secret"))
+	assert.Equal(t, codes.Unauthenticated, status.Code(call("/poker.AdminService/ListTables", "")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(call("/poker.AdminService/ListTables", "wrong")))
+	assert.NoError(t, call("/poker.LobbyService/GetTables", ""))
+}
@@ -0,0 +1,301 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"github.com/vctt94/pokerbisonrelay/pkg/strategy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// aiPlayerIDPrefix starts the player ID of every bot, which reads
+	// bot-<strategy>-<suffix>. Humans cannot take such IDs.
+	aiPlayerIDPrefix = "bot-"
+
+	// DefaultBotThinkTime is how long a bot waits before acting when the
+	// host does not say.
+	DefaultBotThinkTime = time.Second
+	// maxBotThinkTime bounds the think time a host may ask for.
+	maxBotThinkTime = 30 * time.Second
+
+	// aiPollInterval is how often a bot looks at its table when no game
+	// event woke it, in case one was dropped.
+	aiPollInterval = time.Second
+)
+
+// aiPlayer is a bot seated at a table. It sees the game as a player would
+// and acts through the same RPC handlers as humans. Its buy-in is paid by
+// the table host, who is credited with its chips when it leaves.
+type aiPlayer struct {
+	id        string
+	tableID   string
+	strategy  strategy.Strategy
+	thinkTime time.Duration
+
+	wake chan struct{} // Signals a change at the table; buffered by one
+	stop chan struct{}
+}
+
+// isAIPlayerID reports whether playerID is the ID of a bot.
+func isAIPlayerID(playerID string) bool {
+	return strings.HasPrefix(playerID, aiPlayerIDPrefix)
+}
+
+// reservedIDMessage rejects humans using a bot player ID.
+const reservedIDMessage = "player IDs starting with " + aiPlayerIDPrefix + " are reserved for bots"
+
+// newAIPlayerID returns a fresh ID for a bot playing strategyName.
+func newAIPlayerID(strategyName string) (string, error) {
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return aiPlayerIDPrefix + strategyName + "-" + hex.EncodeToString(b[:]), nil
+}
+
+// aiPlayerStrategy returns the strategy name a bot ID carries.
+func aiPlayerStrategy(playerID string) string {
+	name := strings.TrimPrefix(playerID, aiPlayerIDPrefix)
+	if i := strings.LastIndexByte(name, '-'); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// payee returns who is credited with the chips playerID leaves table with:
+// the player, or the table host for bots.
+func payee(table *poker.Table, playerID string) string {
+	if isAIPlayerID(playerID) {
+		return table.GetConfig().HostID
+	}
+	return playerID
+}
+
+// AddBot seats a bot playing the requested strategy at the host's table. The
+// host pays the bot's buy-in.
+func (s *Server) AddBot(ctx context.Context, req *pokerrpc.AddBotRequest) (*pokerrpc.AddBotResponse, error) {
+	table, err := s.hostTable(req.PlayerId, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+	if s.IsDraining() {
+		return &pokerrpc.AddBotResponse{Success: false, Message: drainingMessage}, nil
+	}
+	if _, err := strategy.New(req.Strategy, 0); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	thinkTime := time.Duration(req.ThinkTimeMs) * time.Millisecond
+	switch {
+	case thinkTime < 0 || thinkTime > maxBotThinkTime:
+		return nil, status.Errorf(codes.InvalidArgument, "think_time_ms must be between 0 and %d", maxBotThinkTime.Milliseconds())
+	case thinkTime == 0:
+		thinkTime = DefaultBotThinkTime
+	}
+
+	config := table.GetConfig()
+	balance, err := s.db.GetPlayerBalance(req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+	if balance < config.BuyIn {
+		return &pokerrpc.AddBotResponse{Success: false, Message: "Insufficient DCR balance for the bot's buy-in"}, nil
+	}
+
+	botID, err := newAIPlayerID(req.Strategy)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	seat, err := table.FreeSeat(botID)
+	if err != nil {
+		return &pokerrpc.AddBotResponse{Success: false, Message: err.Error()}, nil
+	}
+	user, err := table.AddNewUser(botID, botID, 0, seat)
+	if err != nil {
+		return &pokerrpc.AddBotResponse{Success: false, Message: err.Error()}, nil
+	}
+	if _, err := s.updateBalance(req.PlayerId, req.PlayerId, table, -config.BuyIn, TransactionBuyIn, "seated "+botID); err != nil {
+		table.RemoveUser(botID)
+		return nil, err
+	}
+	if err := s.saveUserAsPlayerState(req.TableId, user); err != nil {
+		s.log.Errorf("Failed to save bot player state: %v", err)
+	}
+
+	if evt, err := s.buildGameEvent(
+		pokerrpc.NotificationType_PLAYER_JOINED,
+		req.TableId,
+		PlayerJoinedPayload{PlayerID: botID},
+	); err == nil {
+		s.eventProcessor.PublishEvent(evt)
+	} else {
+		s.log.Errorf("Failed to build PLAYER_JOINED event: %v", err)
+	}
+
+	s.startAIPlayer(req.TableId, botID, thinkTime)
+	s.log.Infof("Host %s seated bot %s at table %s", req.PlayerId, botID, req.TableId)
+	return &pokerrpc.AddBotResponse{
+		Success: true,
+		Message: fmt.Sprintf("Seated %s", botID),
+		BotId:   botID,
+	}, nil
+}
+
+// startAIPlayer starts playing for the bot botID seated at tableID. It does
+// nothing when the bot already plays or its strategy is unknown.
+func (s *Server) startAIPlayer(tableID, botID string, thinkTime time.Duration) {
+	strat, err := strategy.New(aiPlayerStrategy(botID), time.Now().UnixNano())
+	if err != nil {
+		s.log.Errorf("Cannot play for bot %s: %v", botID, err)
+		return
+	}
+	p := &aiPlayer{
+		id:        botID,
+		tableID:   tableID,
+		strategy:  strat,
+		thinkTime: thinkTime,
+		wake:      make(chan struct{}, 1),
+		stop:      make(chan struct{}),
+	}
+
+	s.aiMu.Lock()
+	defer s.aiMu.Unlock()
+	if _, ok := s.aiPlayers[botID]; ok {
+		return
+	}
+	if s.aiPlayers == nil {
+		s.aiPlayers = make(map[string]*aiPlayer)
+	}
+	s.aiPlayers[botID] = p
+	s.aiWg.Add(1)
+	go func() {
+		defer s.aiWg.Done()
+		s.runAIPlayer(p)
+	}()
+	p.wake <- struct{}{}
+}
+
+// stopAIPlayers stops the bots matching the filter.
+func (s *Server) stopAIPlayers(match func(p *aiPlayer) bool) {
+	s.aiMu.Lock()
+	defer s.aiMu.Unlock()
+	for id, p := range s.aiPlayers {
+		if match(p) {
+			close(p.stop)
+			delete(s.aiPlayers, id)
+		}
+	}
+}
+
+// stopAIPlayer stops the bot playerID, if it is one.
+func (s *Server) stopAIPlayer(playerID string) {
+	s.stopAIPlayers(func(p *aiPlayer) bool { return p.id == playerID })
+}
+
+// wakeAIPlayers tells the bots at a table that its state changed.
+func (s *Server) wakeAIPlayers(tableID string) {
+	s.aiMu.Lock()
+	defer s.aiMu.Unlock()
+	for _, p := range s.aiPlayers {
+		if p.tableID != tableID {
+			continue
+		}
+		select {
+		case p.wake <- struct{}{}:
+		default: // Already woken
+		}
+	}
+}
+
+// restoreAIPlayers resumes playing for the bots seated at a table loaded
+// from the database. Their think time is not persisted and reverts to the
+// default.
+func (s *Server) restoreAIPlayers(tableID string, table *poker.Table) {
+	for _, u := range table.GetUsers() {
+		if isAIPlayerID(u.ID) {
+			s.startAIPlayer(tableID, u.ID, DefaultBotThinkTime)
+		}
+	}
+}
+
+// runAIPlayer plays for p until it is stopped.
+func (s *Server) runAIPlayer(p *aiPlayer) {
+	ticker := time.NewTicker(aiPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-p.wake:
+		case <-ticker.C:
+		}
+		s.stepAIPlayer(p)
+	}
+}
+
+// stepAIPlayer readies p between games and acts for it when it is its turn.
+// The state is read afresh rather than taken from the event that woke the
+// bot, as events may be handled out of order.
+func (s *Server) stepAIPlayer(p *aiPlayer) {
+	update, err := s.buildGameState(p.tableID, p.id)
+	if err != nil {
+		return
+	}
+	v := strategy.View{Update: update, PlayerID: p.id}
+	self := v.Self()
+	if self == nil {
+		return
+	}
+	ctx := context.Background()
+	if !update.GameStarted {
+		if !self.IsReady {
+			_, err = s.SetPlayerReady(ctx, &pokerrpc.SetPlayerReadyRequest{PlayerId: p.id, TableId: p.tableID})
+			if err != nil {
+				s.log.Debugf("Bot %s failed to get ready: %v", p.id, err)
+			}
+		}
+		return
+	}
+	if update.CurrentPlayer != p.id {
+		return
+	}
+
+	select {
+	case <-p.stop:
+		return
+	case <-time.After(p.thinkTime):
+	}
+	// The hand may have moved on while thinking.
+	if update, err = s.buildGameState(p.tableID, p.id); err != nil || update.CurrentPlayer != p.id {
+		return
+	}
+	s.mu.RLock()
+	table := s.tables[p.tableID]
+	s.mu.RUnlock()
+	if table == nil {
+		return
+	}
+	v = strategy.View{Update: update, PlayerID: p.id, BigBlind: table.GetConfig().BigBlind}
+	action := v.Legal(p.strategy.Decide(v))
+
+	switch action.Kind {
+	case strategy.Fold:
+		_, err = s.FoldBet(ctx, &pokerrpc.FoldBetRequest{PlayerId: p.id, TableId: p.tableID})
+	case strategy.Check:
+		_, err = s.CheckBet(ctx, &pokerrpc.CheckBetRequest{PlayerId: p.id, TableId: p.tableID})
+	case strategy.Call:
+		_, err = s.CallBet(ctx, &pokerrpc.CallBetRequest{PlayerId: p.id, TableId: p.tableID})
+	case strategy.Bet:
+		_, err = s.MakeBet(ctx, &pokerrpc.MakeBetRequest{PlayerId: p.id, TableId: p.tableID, Amount: action.Amount})
+	}
+	if err != nil {
+		s.log.Debugf("Bot %s failed to %v: %v", p.id, action, err)
+	}
+}
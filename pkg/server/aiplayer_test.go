@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func addBot(t *testing.T, srv *Server, tableID, strategyName string) string {
+	t.Helper()
+	resp, err := srv.AddBot(context.Background(), &pokerrpc.AddBotRequest{
+		PlayerId: "alice", TableId: tableID, Strategy: strategyName, ThinkTimeMs: 1,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Success, resp.Message)
+	return resp.BotId
+}
+
+func TestAddBotValidation(t *testing.T) {
+	srv, _ := newAccessTest(t)
+	ctx := context.Background()
+	tableID := createAccessTable(t, srv, false, "")
+
+	_, err := srv.AddBot(ctx, &pokerrpc.AddBotRequest{PlayerId: "bob", TableId: tableID, Strategy: "tag"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.AddBot(ctx, &pokerrpc.AddBotRequest{PlayerId: "alice", TableId: tableID, Strategy: "oracle"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.AddBot(ctx, &pokerrpc.AddBotRequest{PlayerId: "alice", TableId: tableID, Strategy: "tag", ThinkTimeMs: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Humans cannot pass for bots.
+	resp := joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bot-tag-0", TableId: tableID})
+	assert.False(t, resp.Success)
+	assert.Contains(t, resp.Message, "reserved")
+}
+
+func TestBotsPlayHands(t *testing.T) {
+	srv, database := newAccessTest(t)
+	ctx := context.Background()
+	resp, err := srv.CreateTable(ctx, &pokerrpc.CreateTableRequest{
+		PlayerId: "alice", SmallBlind: 10, BigBlind: 20, MinPlayers: 2, MaxPlayers: 6,
+		BuyIn: 100, AutoStartMs: 10,
+	})
+	require.NoError(t, err)
+	tableID := resp.TableId
+
+	tag := addBot(t, srv, tableID, "tag")
+	equity := addBot(t, srv, tableID, "equity")
+	// The host paid for both bots.
+	requireBalance(t, database, "alice", 700)
+
+	_, err = srv.SetPlayerReady(ctx, &pokerrpc.SetPlayerReadyRequest{PlayerId: "alice", TableId: tableID})
+	require.NoError(t, err)
+
+	// Alice folds whenever she is asked to act; the bots play on.
+	table := srv.tables[tableID]
+	require.Eventually(t, func() bool {
+		if table.GetCurrentPlayerID() == "alice" {
+			srv.FoldBet(ctx, &pokerrpc.FoldBetRequest{PlayerId: "alice", TableId: tableID})
+		}
+		g := table.GetGame()
+		return g != nil && g.GetRound() >= 3
+	}, 10*time.Second, 5*time.Millisecond)
+
+	// Kicked bots stop playing and their chips go to the host. Alice keeps
+	// folding so the hand in progress ends.
+	var (
+		target string
+		kicked *pokerrpc.KickPlayerResponse
+	)
+	require.Eventually(t, func() bool {
+		if table.GetCurrentPlayerID() == "alice" {
+			srv.FoldBet(ctx, &pokerrpc.FoldBetRequest{PlayerId: "alice", TableId: tableID})
+		}
+		target = tag
+		if table.GetUser(tag) == nil {
+			target = equity // Busted out
+		}
+		kicked, err = srv.KickPlayer(ctx, &pokerrpc.KickPlayerRequest{PlayerId: "alice", TableId: tableID, TargetId: target})
+		return err == nil
+	}, 10*time.Second, time.Millisecond)
+	srv.aiMu.Lock()
+	assert.NotContains(t, srv.aiPlayers, target)
+	srv.aiMu.Unlock()
+	assert.Nil(t, table.GetUser(target))
+	requireBalance(t, database, "alice", 700+kicked.Refund)
+}
+
+func TestHostLeavesBotTable(t *testing.T) {
+	srv, database := newAccessTest(t)
+	ctx := context.Background()
+
+	// The table closes when only bots would be left, returning the bots'
+	// buy-ins to the leaving host.
+	tableID := createAccessTable(t, srv, false, "")
+	addBot(t, srv, tableID, "tag")
+	requireBalance(t, database, "alice", 800)
+	resp, err := srv.LeaveTable(ctx, &pokerrpc.LeaveTableRequest{PlayerId: "alice", TableId: tableID})
+	require.NoError(t, err)
+	require.True(t, resp.Success, resp.Message)
+	requireBalance(t, database, "alice", 1000)
+	assert.NotContains(t, srv.tables, tableID)
+	srv.aiMu.Lock()
+	assert.Empty(t, srv.aiPlayers)
+	srv.aiMu.Unlock()
+
+	// Bots are skipped when the host is handed over.
+	tableID = createAccessTable(t, srv, false, "")
+	addBot(t, srv, tableID, "tag")
+	require.True(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID}).Success)
+	resp, err = srv.LeaveTable(ctx, &pokerrpc.LeaveTableRequest{PlayerId: "alice", TableId: tableID})
+	require.NoError(t, err)
+	require.True(t, resp.Success, resp.Message)
+	assert.Equal(t, "bob", srv.tables[tableID].GetConfig().HostID)
+}
@@ -0,0 +1,262 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/decred/slog"
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"github.com/vctt94/pokerbisonrelay/pkg/server/internal/db"
+)
+
+// AuditRecord is an entry of the hash-chained audit log.
+type AuditRecord = db.AuditRecord
+
+// Actions of the audit records written for play at a table. Their amounts
+// and balances are in chips. Balance changes are recorded with their
+// transaction type as action, and amounts and balances in atoms.
+const (
+	AuditBet       = "bet"
+	AuditCall      = "call"
+	AuditCheck     = "check"
+	AuditFold      = "fold"
+	AuditPayout    = "payout"
+	AuditGameEnded = "game ended"
+)
+
+// Actors of audit records that are not players.
+const (
+	AuditActorServer = "server"
+	AuditActorAdmin  = "admin"
+)
+
+// auditVerifyPageSize is how many records VerifyAuditLog reads at a time.
+const auditVerifyPageSize = 1000
+
+// auditLog appends records to the audit log kept in the database, chaining
+// each to the one before it. A nil *auditLog records nothing.
+type auditLog struct {
+	db  Database
+	log slog.Logger
+
+	mu     sync.Mutex
+	loaded bool         // Whether head was read from the database
+	head   *AuditRecord // Last record; nil while the log is empty
+}
+
+func newAuditLog(database Database, log slog.Logger) *auditLog {
+	return &auditLog{db: database, log: log}
+}
+
+// append completes r with its sequence number, time and hashes and appends
+// it to the log.
+func (a *auditLog) append(r AuditRecord) error {
+	return a.appendWith(func(seal func(AuditRecord) AuditRecord) error {
+		return a.db.AppendAuditRecord(seal(r))
+	})
+}
+
+// appendWith runs write, which must append to the log the record it passes
+// through seal, or fail. seal completes the record with its sequence number,
+// time and hashes; write may call it from within a database transaction.
+func (a *auditLog) appendWith(write func(seal func(AuditRecord) AuditRecord) error) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	// Another server sharing the database may have extended the chain; try
+	// again from its new head once.
+	for attempt := 0; ; attempt++ {
+		if !a.loaded {
+			head, err := a.db.GetLastAuditRecord()
+			if err != nil {
+				return fmt.Errorf("failed to read the audit log head: %w", err)
+			}
+			a.head, a.loaded = head, true
+		}
+
+		var sealed *AuditRecord
+		seal := func(r AuditRecord) AuditRecord {
+			r.Seq, r.PrevHash = 1, ""
+			if a.head != nil {
+				r.Seq, r.PrevHash = a.head.Seq+1, a.head.Hash
+			}
+			r.Time = time.Now().UnixNano()
+			r.Hash = AuditHash(r)
+			sealed = &r
+			return r
+		}
+		err := write(seal)
+		if errors.Is(err, db.ErrAuditSeqTaken) && attempt == 0 {
+			a.loaded = false
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if sealed != nil {
+			a.head = sealed
+		}
+		return nil
+	}
+}
+
+// record appends r to the log. The action it describes already happened, so
+// a failure is only logged.
+func (a *auditLog) record(r AuditRecord) {
+	if a == nil {
+		return
+	}
+	if err := a.append(r); err != nil {
+		a.log.Errorf("Failed to write audit record for %s of %s: %v", r.Action, r.PlayerID, err)
+	}
+}
+
+// balanceChange runs change, a database write changing the balance of a
+// player, with the AuditFunc that completes its audit record with the fields
+// of r and chains it to the log. The write fails when its record cannot be
+// appended. A nil *auditLog runs change without an AuditFunc.
+func (a *auditLog) balanceChange(r AuditRecord, change func(audit db.AuditFunc) error) error {
+	if a == nil {
+		return change(nil)
+	}
+	return a.appendWith(func(seal func(AuditRecord) AuditRecord) error {
+		return change(func(c AuditRecord) AuditRecord {
+			c.Actor, c.TableID, c.Hand = r.Actor, r.TableID, r.Hand
+			return seal(c)
+		})
+	})
+}
+
+// AuditHash returns the hex SHA-256 of every field of r but Hash. As the
+// fields include PrevHash, it covers every record before r as well.
+func AuditHash(r AuditRecord) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d|%d|%q|%q|%q|%d|%q|%d|%d|%q|%q", r.Seq, r.Time, r.Actor, r.PlayerID,
+		r.TableID, r.Hand, r.Action, r.Amount, r.Balance, r.Detail, r.PrevHash)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// VerifyAuditLog walks the audit log of database and checks that records are
+// numbered without gaps, that each names the hash of the one before it and
+// that each hash matches the record. It returns the number of records and the
+// hash of the last one; records removed from the end of the log can only be
+// noticed by comparing that hash with one noted earlier.
+func VerifyAuditLog(database Database) (int64, string, error) {
+	var n int64
+	head := ""
+	for {
+		page, err := database.GetAuditRecords(n, auditVerifyPageSize)
+		if err != nil {
+			return n, head, err
+		}
+		for _, r := range page {
+			switch {
+			case r.Seq != n+1:
+				return n, head, fmt.Errorf("record %d follows record %d: records are missing", r.Seq, n)
+			case r.PrevHash != head:
+				return n, head, fmt.Errorf("record %d does not chain to record %d", r.Seq, n)
+			case AuditHash(r) != r.Hash:
+				return n, head, fmt.Errorf("record %d does not match its hash: it was altered", r.Seq)
+			}
+			n, head = r.Seq, r.Hash
+		}
+		if len(page) < auditVerifyPageSize {
+			return n, head, nil
+		}
+	}
+}
+
+// updateBalance changes a player's balance as UpdatePlayerBalance does,
+// recording the change, made by actor, in the audit log in the same
+// transaction. table is the table the change concerns, if any. It returns the
+// new balance.
+func (s *Server) updateBalance(actor, playerID string, table *poker.Table, amount int64, transactionType, description string) (int64, error) {
+	tableID, hand := auditTable(table)
+	var balance int64
+	err := s.audit.balanceChange(AuditRecord{Actor: actor, TableID: tableID, Hand: hand}, func(audit db.AuditFunc) error {
+		var err error
+		balance, err = s.db.UpdatePlayerBalanceAudited(playerID, amount, transactionType, description, audit)
+		return err
+	})
+	return balance, err
+}
+
+// auditTableAction records an action at a table that moved amount chips of
+// playerID, with the chips they hold after it.
+func (s *Server) auditTableAction(actor, playerID string, table *poker.Table, action string, amount int64, detail string) {
+	if s.audit == nil {
+		return
+	}
+	tableID, hand := auditTable(table)
+	s.audit.record(AuditRecord{
+		Actor:    actor,
+		PlayerID: playerID,
+		TableID:  tableID,
+		Hand:     hand,
+		Action:   action,
+		Amount:   amount,
+		Balance:  table.ChipCounts()[playerID],
+		Detail:   detail,
+	})
+}
+
+// auditTable returns the ID of table and the number of its current or last
+// hand, or zero values when table is nil.
+func auditTable(table *poker.Table) (string, int64) {
+	if table == nil {
+		return "", 0
+	}
+	var hand int64
+	if g := table.GetGame(); g != nil {
+		hand = int64(g.GetRound())
+	}
+	return table.GetConfig().ID, hand
+}
+
+// auditShowdown records the winnings of each player paid at a showdown.
+func (s *Server) auditShowdown(event poker.TableEvent) {
+	showdown, ok := event.Payload.(*pokerrpc.Showdown)
+	if !ok {
+		return
+	}
+	for _, w := range showdown.Winners {
+		s.audit.record(AuditRecord{
+			Actor:    AuditActorServer,
+			PlayerID: w.PlayerId,
+			TableID:  event.TableID,
+			Hand:     int64(event.Hand),
+			Action:   AuditPayout,
+			Amount:   w.Winnings,
+			Balance:  event.Stacks[w.PlayerId],
+			Detail:   fmt.Sprintf("pot of %d", showdown.Pot),
+		})
+	}
+}
+
+// auditGameEnded records the chips each player at table holds once actor
+// ended its game during hand number hand.
+func (s *Server) auditGameEnded(actor string, table *poker.Table, hand int64, reason string) {
+	for playerID, chips := range table.ChipCounts() {
+		s.audit.record(AuditRecord{
+			Actor:    actor,
+			PlayerID: playerID,
+			TableID:  table.GetConfig().ID,
+			Hand:     hand,
+			Action:   AuditGameEnded,
+			Balance:  chips,
+			Detail:   reason,
+		})
+	}
+}
+
+// Deposit credits a player's balance with atoms received from outside the
+// server, such as a Bison Relay tip.
+func (s *Server) Deposit(playerID string, atoms int64, description string) error {
+	_, err := s.updateBalance(playerID, playerID, nil, atoms, TransactionDeposit, description)
+	return err
+}
@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/decred/slog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"github.com/vctt94/pokerbisonrelay/pkg/server/internal/db"
+)
+
+// auditRecords returns the whole audit log of database.
+func auditRecords(t *testing.T, database Database) []AuditRecord {
+	t.Helper()
+	records, err := database.GetAuditRecords(0, 1000)
+	require.NoError(t, err)
+	return records
+}
+
+// findAuditRecord returns the first record of action for playerID, or nil.
+func findAuditRecord(records []AuditRecord, playerID, action string) *AuditRecord {
+	for i := range records {
+		if records[i].PlayerID == playerID && records[i].Action == action {
+			return &records[i]
+		}
+	}
+	return nil
+}
+
+func TestAuditLogRecordsHand(t *testing.T) {
+	srv, database, tableID := newHostTest(t)
+	startHostTestGame(t, srv, tableID)
+	table := srv.tables[tableID]
+	hand := int64(table.GetGame().GetRound())
+
+	folder := table.GetCurrentPlayerID()
+	_, err := srv.FoldBet(context.Background(), &pokerrpc.FoldBetRequest{PlayerId: folder, TableId: tableID})
+	require.NoError(t, err)
+	winner := "alice"
+	if folder == "alice" {
+		winner = "bob"
+	}
+
+	// The payout is recorded as the showdown event is processed.
+	var records []AuditRecord
+	require.Eventually(t, func() bool {
+		records = auditRecords(t, database)
+		return findAuditRecord(records, winner, AuditPayout) != nil
+	}, 2*time.Second, 10*time.Millisecond)
+
+	buyIn := findAuditRecord(records, "bob", TransactionBuyIn)
+	require.NotNil(t, buyIn)
+	assert.Equal(t, "bob", buyIn.Actor)
+	assert.Equal(t, tableID, buyIn.TableID)
+	assert.Equal(t, int64(-100), buyIn.Amount)
+	assert.Equal(t, int64(900), buyIn.Balance)
+
+	fold := findAuditRecord(records, folder, AuditFold)
+	require.NotNil(t, fold)
+	assert.Equal(t, hand, fold.Hand)
+
+	payout := findAuditRecord(records, winner, AuditPayout)
+	assert.Equal(t, AuditActorServer, payout.Actor)
+	assert.Equal(t, hand, payout.Hand)
+	assert.Positive(t, payout.Amount)
+	assert.Equal(t, table.ChipCounts()[winner], payout.Balance)
+
+	n, head, err := VerifyAuditLog(database)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(records)), n)
+	assert.Equal(t, records[len(records)-1].Hash, head)
+}
+
+func TestAuditLogBalanceChanges(t *testing.T) {
+	srv, database := newAccessTest(t)
+	ctx := context.Background()
+
+	_, err := srv.ProcessTip(ctx, &pokerrpc.ProcessTipRequest{FromPlayerId: "alice", ToPlayerId: "bob", Amount: 50})
+	require.NoError(t, err)
+	_, err = srv.UpdateBalance(ctx, &pokerrpc.UpdateBalanceRequest{PlayerId: "carol", Amount: 25, Description: "bonus"})
+	require.NoError(t, err)
+	_, err = NewAdminServer(srv).AdjustBalance(ctx, &pokerrpc.AdjustBalanceRequest{PlayerId: "dave", Amount: -10, Reason: "refund"})
+	require.NoError(t, err)
+	require.NoError(t, srv.Deposit("dave", 5, "tip"))
+
+	records := auditRecords(t, database)
+	require.Len(t, records, 5)
+	want := []struct {
+		actor, player, action string
+		amount, balance       int64
+	}{
+		{"alice", "alice", TransactionTipSent, -50, 950},
+		{"alice", "bob", TransactionTipReceived, 50, 1050},
+		{"carol", "carol", TransactionBalanceAdmin, 25, 1025},
+		{AuditActorAdmin, "dave", TransactionAdminAdjustment, -10, 990},
+		{"dave", "dave", TransactionDeposit, 5, 995},
+	}
+	for i, w := range want {
+		r := records[i]
+		assert.Equal(t, int64(i+1), r.Seq)
+		assert.Equal(t, w.actor, r.Actor)
+		assert.Equal(t, w.player, r.PlayerID)
+		assert.Equal(t, w.action, r.Action)
+		assert.Equal(t, w.amount, r.Amount)
+		assert.Equal(t, w.balance, r.Balance)
+	}
+}
+
+func TestAuditLogDetectsTampering(t *testing.T) {
+	srv, database := newAccessTest(t)
+	memory, ok := database.(*MemoryDatabase)
+	if !ok {
+		t.Skip("tampering needs the in-memory database")
+	}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, srv.Deposit("alice", 10, "tip"))
+	}
+	_, _, err := VerifyAuditLog(database)
+	require.NoError(t, err)
+
+	// Rewriting the amount without fixing the hash is caught at the record.
+	records := auditRecords(t, database)
+	forged := records[1]
+	forged.Amount = 1000
+	memory.TamperAuditRecord(forged)
+	n, _, err := VerifyAuditLog(database)
+	assert.ErrorContains(t, err, "record 2")
+	assert.Equal(t, int64(1), n)
+
+	// So is recomputing its hash, as the next record no longer chains to it.
+	forged.Hash = AuditHash(forged)
+	memory.TamperAuditRecord(forged)
+	n, _, err = VerifyAuditLog(database)
+	assert.ErrorContains(t, err, "record 3 does not chain")
+	assert.Equal(t, int64(2), n)
+}
+
+func TestAuditLogResumesChain(t *testing.T) {
+	srv, database := newAccessTest(t)
+	require.NoError(t, srv.Deposit("alice", 10, "tip"))
+
+	// A log that did not write the head, as after a restart or when another
+	// server shares the database, continues from it.
+	other := newAuditLog(database, slog.Disabled)
+	other.record(AuditRecord{Actor: "bob", PlayerID: "bob", Action: TransactionDeposit, Amount: 1})
+	require.NoError(t, srv.Deposit("alice", 10, "tip"))
+
+	records := auditRecords(t, database)
+	require.Len(t, records, 3)
+	assert.Equal(t, "alice", records[2].PlayerID)
+	n, _, err := VerifyAuditLog(database)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+}
+
+// racingDB extends the audit log before every audited balance change, as
+// another server sharing the database could.
+type racingDB struct {
+	Database
+	other *auditLog
+}
+
+func (r racingDB) UpdatePlayerBalanceAudited(playerID string, amount int64, transactionType, description string, audit db.AuditFunc) (int64, error) {
+	r.other.record(AuditRecord{Actor: "bob", PlayerID: "bob", Action: TransactionDeposit, Amount: 1})
+	return r.Database.UpdatePlayerBalanceAudited(playerID, amount, transactionType, description, audit)
+}
+
+func TestAuditLogFailureFailsBalanceChange(t *testing.T) {
+	database := newTestDatabase(t)
+	require.NoError(t, database.UpdatePlayerBalance("alice", 1000, TransactionDeposit, "seed"))
+	logBackend := createTestLogBackend()
+	defer logBackend.Close()
+	srv := NewServer(racingDB{database, newAuditLog(database, slog.Disabled)}, logBackend)
+	defer srv.Stop()
+
+	// The chain moves on under every attempt, so the deposit cannot be
+	// recorded and is not made.
+	err := srv.Deposit("alice", 10, "tip")
+	require.ErrorIs(t, err, db.ErrAuditSeqTaken)
+	requireBalance(t, database, "alice", 1000)
+
+	records := auditRecords(t, database)
+	require.Len(t, records, 2)
+	assert.Nil(t, findAuditRecord(records, "alice", TransactionDeposit))
+	_, _, err = VerifyAuditLog(database)
+	require.NoError(t, err)
+}
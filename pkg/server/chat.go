@@ -0,0 +1,181 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// chatHistorySize bounds the recent messages of a table sent to the
+	// players who join it.
+	chatHistorySize = 50
+	// chatMaxLength bounds the length of a chat message, in characters.
+	chatMaxLength = 280
+	// A player may send chatRateLimit messages per chatRateWindow.
+	chatRateLimit  = 5
+	chatRateWindow = 10 * time.Second
+)
+
+// tableChat is the chat of a table: its recent messages, the players the host
+// muted and when each player sent their latest messages.
+type tableChat struct {
+	history []*pokerrpc.ChatMessage
+	muted   map[string]bool
+	sent    map[string][]time.Time
+}
+
+// tableChatLocked returns the chat of a table, creating it on first use.
+// Assumes chatMu is held.
+func (s *Server) tableChatLocked(tableID string) *tableChat {
+	c := s.chats[tableID]
+	if c == nil {
+		c = &tableChat{muted: make(map[string]bool), sent: make(map[string][]time.Time)}
+		s.chats[tableID] = c
+	}
+	return c
+}
+
+// cleanChatText drops the control characters of a chat message, which could
+// garble the terminals displaying it, and the surrounding spaces.
+func cleanChatText(text string) string {
+	return strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, text))
+}
+
+// SendChatMessage sends the message of a player seated at a table to the
+// other players, the spectators and the relays of the table as a
+// CHAT_MESSAGE notification.
+func (s *Server) SendChatMessage(ctx context.Context, req *pokerrpc.SendChatMessageRequest) (*pokerrpc.SendChatMessageResponse, error) {
+	if req.PlayerId == "" || req.TableId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id and table_id are required")
+	}
+	text := cleanChatText(req.Text)
+	if text == "" {
+		return nil, status.Error(codes.InvalidArgument, "text is required")
+	}
+	if utf8.RuneCountInString(text) > chatMaxLength {
+		return &pokerrpc.SendChatMessageResponse{
+			Success: false,
+			Message: fmt.Sprintf("Chat messages are limited to %d characters", chatMaxLength),
+		}, nil
+	}
+	s.mu.RLock()
+	table, ok := s.tables[req.TableId]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, status.Error(codes.NotFound, "table not found")
+	}
+	if table.GetUser(req.PlayerId) == nil {
+		return nil, status.Error(codes.FailedPrecondition, "player not at table")
+	}
+
+	msg, denied := s.recordChatMessage(req.TableId, req.PlayerId, text, time.Now())
+	if denied != "" {
+		return &pokerrpc.SendChatMessageResponse{Success: false, Message: denied}, nil
+	}
+	s.notifyPlayers(s.tablePlayerIDs(req.TableId), &pokerrpc.Notification{
+		Type:     pokerrpc.NotificationType_CHAT_MESSAGE,
+		TableId:  req.TableId,
+		PlayerId: req.PlayerId,
+		Message:  text,
+		Chat:     []*pokerrpc.ChatMessage{msg},
+	})
+	return &pokerrpc.SendChatMessageResponse{Success: true, Message: "Message sent"}, nil
+}
+
+// recordChatMessage adds a message to the history of a table, unless the
+// player is muted or sending too fast, in which case it returns why.
+func (s *Server) recordChatMessage(tableID, playerID, text string, now time.Time) (*pokerrpc.ChatMessage, string) {
+	s.chatMu.Lock()
+	defer s.chatMu.Unlock()
+	c := s.tableChatLocked(tableID)
+	if c.muted[playerID] {
+		return nil, "The host muted you in the table chat"
+	}
+
+	recent := c.sent[playerID][:0]
+	for _, at := range c.sent[playerID] {
+		if now.Sub(at) < chatRateWindow {
+			recent = append(recent, at)
+		}
+	}
+	c.sent[playerID] = recent
+	if len(recent) >= chatRateLimit {
+		wait := chatRateWindow - now.Sub(recent[0])
+		return nil, fmt.Sprintf("You are sending messages too fast; wait %d seconds", int(wait.Round(time.Second)/time.Second))
+	}
+	c.sent[playerID] = append(recent, now)
+
+	msg := &pokerrpc.ChatMessage{PlayerId: playerID, Text: text, SentUnixMs: now.UnixMilli()}
+	c.history = append(c.history, msg)
+	if len(c.history) > chatHistorySize {
+		c.history = append([]*pokerrpc.ChatMessage(nil), c.history[len(c.history)-chatHistorySize:]...)
+	}
+	return msg, ""
+}
+
+// sendChatHistory sends the recent chat of a table to a player who joined
+// it, if there is any.
+func (s *Server) sendChatHistory(tableID, playerID string) {
+	s.chatMu.Lock()
+	var history []*pokerrpc.ChatMessage
+	if c := s.chats[tableID]; c != nil {
+		history = append(history, c.history...)
+	}
+	s.chatMu.Unlock()
+	if len(history) == 0 {
+		return
+	}
+	s.notifyPlayer(playerID, &pokerrpc.Notification{
+		Type:    pokerrpc.NotificationType_CHAT_MESSAGE,
+		TableId: tableID,
+		Chat:    history,
+	})
+}
+
+// dropChat forgets the chat of a removed table.
+func (s *Server) dropChat(tableID string) {
+	s.chatMu.Lock()
+	defer s.chatMu.Unlock()
+	delete(s.chats, tableID)
+}
+
+// MuteChatPlayer refuses the chat messages of a player at the table the host
+// hosts, or accepts them again, for as long as the table runs.
+func (s *Server) MuteChatPlayer(ctx context.Context, req *pokerrpc.MuteChatPlayerRequest) (*pokerrpc.MuteChatPlayerResponse, error) {
+	if _, err := s.hostTable(req.PlayerId, req.TableId); err != nil {
+		return nil, err
+	}
+	if req.TargetId == "" {
+		return nil, status.Error(codes.InvalidArgument, "target_id is required")
+	}
+	if req.TargetId == req.PlayerId {
+		return nil, status.Error(codes.InvalidArgument, "the host cannot mute themselves")
+	}
+
+	s.chatMu.Lock()
+	c := s.tableChatLocked(req.TableId)
+	if req.Muted {
+		c.muted[req.TargetId] = true
+	} else {
+		delete(c.muted, req.TargetId)
+	}
+	s.chatMu.Unlock()
+
+	if req.Muted {
+		return &pokerrpc.MuteChatPlayerResponse{Success: true, Message: fmt.Sprintf("Muted %s in the table chat", req.TargetId)}, nil
+	}
+	return &pokerrpc.MuteChatPlayerResponse{Success: true, Message: fmt.Sprintf("Unmuted %s in the table chat", req.TargetId)}, nil
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTableChat(t *testing.T) {
+	srv, _ := newAccessTest(t)
+	ctx := context.Background()
+	relay := &recordingRelay{}
+	srv.SetNotificationRelay(relay)
+	tableID := createAccessTable(t, srv, false, "")
+	require.True(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID}).Success)
+
+	send := func(playerID, text string) *pokerrpc.SendChatMessageResponse {
+		t.Helper()
+		resp, err := srv.SendChatMessage(ctx, &pokerrpc.SendChatMessageRequest{PlayerId: playerID, TableId: tableID, Text: text})
+		require.NoError(t, err)
+		return resp
+	}
+
+	require.True(t, send("bob", "  hi\x1b\n").Success)
+	chat := notificationsOf(relay, "alice", pokerrpc.NotificationType_CHAT_MESSAGE)
+	require.Len(t, chat, 1)
+	assert.Equal(t, "bob", chat[0].PlayerId)
+	require.Len(t, chat[0].Chat, 1)
+	assert.Equal(t, "hi", chat[0].Chat[0].Text)
+
+	// Only seated players chat.
+	_, err := srv.SendChatMessage(ctx, &pokerrpc.SendChatMessageRequest{PlayerId: "carol", TableId: tableID, Text: "hello"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.False(t, send("bob", strings.Repeat("x", chatMaxLength+1)).Success)
+
+	// The host mutes and unmutes players.
+	_, err = srv.MuteChatPlayer(ctx, &pokerrpc.MuteChatPlayerRequest{PlayerId: "bob", TableId: tableID, TargetId: "alice", Muted: true})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.MuteChatPlayer(ctx, &pokerrpc.MuteChatPlayerRequest{PlayerId: "alice", TableId: tableID, TargetId: "bob", Muted: true})
+	require.NoError(t, err)
+	assert.False(t, send("bob", "let me talk").Success)
+	_, err = srv.MuteChatPlayer(ctx, &pokerrpc.MuteChatPlayerRequest{PlayerId: "alice", TableId: tableID, TargetId: "bob"})
+	require.NoError(t, err)
+	assert.True(t, send("bob", "thanks").Success)
+
+	// Players sending too fast are refused.
+	for i := 0; i < chatRateLimit; i++ {
+		require.True(t, send("alice", "spam").Success, i)
+	}
+	assert.False(t, send("alice", "spam").Success)
+
+	// Players who join get the recent chat.
+	require.True(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "carol", TableId: tableID}).Success)
+	history := notificationsOf(relay, "carol", pokerrpc.NotificationType_CHAT_MESSAGE)
+	require.Len(t, history, 1)
+	require.Len(t, history[0].Chat, 2+chatRateLimit)
+	assert.Equal(t, "hi", history[0].Chat[0].Text)
+	assert.Equal(t, "thanks", history[0].Chat[1].Text)
+}
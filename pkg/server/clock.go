@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// timeBankReserve returns the time bank reserve asked for at table creation;
+// zero leaves the table default.
+func timeBankReserve(seconds int32) time.Duration {
+	if seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// unixMillis returns t in milliseconds since the Unix epoch, or 0 for the
+// zero time.
+func unixMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+// timeBankSeconds returns a time bank in whole seconds.
+func timeBankSeconds(d time.Duration) int32 {
+	return int32(d / time.Second)
+}
+
+// UseTimeBank adds the player's time bank to the clock of their turn. The
+// new deadline reaches the table through the TIME_BANK_USED notification and
+// the game stream.
+func (s *Server) UseTimeBank(ctx context.Context, req *pokerrpc.UseTimeBankRequest) (*pokerrpc.UseTimeBankResponse, error) {
+	if req.PlayerId == "" || req.TableId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id and table_id are required")
+	}
+	s.mu.RLock()
+	table, ok := s.tables[req.TableId]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, status.Error(codes.NotFound, "table not found")
+	}
+
+	deadline, err := table.UseTimeBank(req.PlayerId)
+	switch {
+	case errors.Is(err, poker.ErrTimeBankEmpty):
+		return &pokerrpc.UseTimeBankResponse{Success: false, Message: "Your time bank is empty"}, nil
+	case errors.Is(err, poker.ErrNoActionClock):
+		return &pokerrpc.UseTimeBankResponse{Success: false, Message: "This table has no action clock"}, nil
+	case err != nil:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &pokerrpc.UseTimeBankResponse{
+		Success:              true,
+		Message:              "Time bank added to your clock",
+		ActionDeadlineUnixMs: unixMillis(deadline),
+		TimeBankSeconds:      timeBankSeconds(table.TimeBankLeft(req.PlayerId)),
+	}, nil
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+func TestUseTimeBank(t *testing.T) {
+	srv, _ := newAccessTest(t)
+	ctx := context.Background()
+	relay := &recordingRelay{}
+	srv.SetNotificationRelay(relay)
+	created, err := srv.CreateTable(ctx, &pokerrpc.CreateTableRequest{
+		PlayerId:               "alice",
+		SmallBlind:             10,
+		BigBlind:               20,
+		MinPlayers:             2,
+		MaxPlayers:             2,
+		BuyIn:                  100,
+		TimeBankSeconds:        30,
+		TimeBankReserveSeconds: 20,
+	})
+	require.NoError(t, err)
+	tableID := created.TableId
+	require.True(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID}).Success)
+	for _, id := range []string{"alice", "bob"} {
+		_, err := srv.SetPlayerReady(ctx, &pokerrpc.SetPlayerReadyRequest{PlayerId: id, TableId: tableID})
+		require.NoError(t, err)
+	}
+
+	// The game state carries the deadline of the player to act and every
+	// player's time bank.
+	state, err := srv.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID, PlayerId: "alice"})
+	require.NoError(t, err)
+	deadline := time.UnixMilli(state.GameState.ActionDeadlineUnixMs)
+	assert.WithinDuration(t, time.Now().Add(30*time.Second), deadline, 5*time.Second)
+	for _, p := range state.GameState.Players {
+		assert.Equal(t, int32(20), p.TimeBankSeconds, p.Id)
+	}
+
+	current := state.GameState.CurrentPlayer
+	other := "alice"
+	if current == other {
+		other = "bob"
+	}
+	_, err = srv.UseTimeBank(ctx, &pokerrpc.UseTimeBankRequest{PlayerId: other, TableId: tableID})
+	assert.Error(t, err)
+
+	used, err := srv.UseTimeBank(ctx, &pokerrpc.UseTimeBankRequest{PlayerId: current, TableId: tableID})
+	require.NoError(t, err)
+	require.True(t, used.Success, used.Message)
+	assert.Equal(t, deadline.Add(20*time.Second).UnixMilli(), used.ActionDeadlineUnixMs)
+	assert.Zero(t, used.TimeBankSeconds)
+	require.Eventually(t, func() bool {
+		return len(notificationsOf(relay, other, pokerrpc.NotificationType_TIME_BANK_USED)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	n := notificationsOf(relay, other, pokerrpc.NotificationType_TIME_BANK_USED)[0]
+	assert.Equal(t, current, n.PlayerId)
+	assert.InDelta(t, 50, n.Countdown, 5)
+
+	used, err = srv.UseTimeBank(ctx, &pokerrpc.UseTimeBankRequest{PlayerId: current, TableId: tableID})
+	require.NoError(t, err)
+	assert.False(t, used.Success)
+}
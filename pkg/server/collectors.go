@@ -28,6 +28,7 @@ func (s *Server) collectTableSnapshot(tableID string) (*TableSnapshot, error) {
 	playerSnapshots := make([]*PlayerSnapshot, 0, len(users))
 	for _, user := range users {
 		snapshot := s.collectPlayerSnapshot(user, game)
+		snapshot.TimeBank = table.TimeBankLeft(user.ID)
 		playerSnapshots = append(playerSnapshots, snapshot)
 	}
 
@@ -54,12 +55,13 @@ func (s *Server) collectTableSnapshot(tableID string) (*TableSnapshot, error) {
 	}
 
 	return &TableSnapshot{
-		ID:           tableID,
-		Players:      playerSnapshots,
-		GameSnapshot: gameSnapshot,
-		Config:       config,
-		State:        tableState,
-		Timestamp:    time.Now(),
+		ID:             tableID,
+		Players:        playerSnapshots,
+		GameSnapshot:   gameSnapshot,
+		Config:         config,
+		State:          tableState,
+		ActionDeadline: table.ActionDeadline(),
+		Timestamp:      time.Now(),
 	}, nil
 }
 
@@ -73,6 +75,7 @@ func (s *Server) collectPlayerSnapshot(user *poker.User, game *poker.Game) *Play
 		DCRAccountBalance: user.DCRAccountBalance,
 		IsReady:           user.IsReady,
 		IsDisconnected:    false,
+		IsSittingOut:      user.SittingOut,
 		HasFolded:         false,
 		IsAllIn:           false,
 		IsDealer:          false,
@@ -173,6 +176,24 @@ func (s *Server) buildGameEvent(
 		switch p := payload.(type) {
 		case *pokerrpc.Showdown:
 			serverPayload = ShowdownPayload{Showdown: p}
+		case poker.TimeBankEvent:
+			serverPayload = TimeBankUsedPayload{PlayerID: p.PlayerID, Added: p.Added, Deadline: p.Deadline}
+		case poker.AutoActionEvent:
+			if eventType == pokerrpc.NotificationType_CHECK_MADE {
+				serverPayload = CheckMadePayload{PlayerID: p.PlayerID}
+			} else {
+				serverPayload = PlayerFoldedPayload{PlayerID: p.PlayerID}
+			}
+		case poker.SitOutEvent:
+			if eventType == pokerrpc.NotificationType_PLAYER_SAT_IN {
+				serverPayload = PlayerSatInPayload{PlayerID: p.PlayerID}
+			} else {
+				serverPayload = PlayerSatOutPayload{PlayerID: p.PlayerID, Auto: p.Auto}
+			}
+		case map[string]interface{}:
+			// Tables report why a game ended this way
+			reason, _ := p["reason"].(string)
+			serverPayload = GameEndedPayload{Reason: reason}
 		case EventPayload:
 			// Already a server payload
 			serverPayload = p
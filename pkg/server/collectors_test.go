@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -23,7 +24,37 @@ func (stubDB) SaveSnapshot(*db.TableState, []*db.PlayerState) error    { return
 func (stubDB) LoadPlayerStates(string) ([]*db.PlayerState, error)      { return nil, nil }
 func (stubDB) DeletePlayerState(string, string) error                  { return nil }
 func (stubDB) GetAllTableIDs() ([]string, error)                       { return nil, nil }
+func (stubDB) PingContext(context.Context) error                       { return nil }
+func (stubDB) AppendAuditRecord(db.AuditRecord) error                  { return nil }
+func (stubDB) GetLastAuditRecord() (*db.AuditRecord, error)            { return nil, nil }
+func (stubDB) GetAuditRecords(int64, int) ([]db.AuditRecord, error)    { return nil, nil }
 func (stubDB) Close() error                                            { return nil }
+func (stubDB) GetTransactions(db.TransactionFilter) ([]db.Transaction, error) {
+	return nil, nil
+}
+func (stubDB) UpdatePlayerBalanceAudited(string, int64, string, string, db.AuditFunc) (int64, error) {
+	return 0, nil
+}
+func (stubDB) CreateWithdrawal(*db.Withdrawal, int64, time.Time, db.AuditFunc) (int64, error) {
+	return 0, nil
+}
+func (stubDB) UpdateWithdrawal(int64, string, string, db.AuditFunc) error   { return nil }
+func (stubDB) GetWithdrawal(int64) (*db.Withdrawal, error)                  { return nil, nil }
+func (stubDB) GetWithdrawals(string, string, int) ([]*db.Withdrawal, error) { return nil, nil }
+func (stubDB) GetWithdrawalEvents(int64) ([]db.WithdrawalEvent, error)      { return nil, nil }
+func (stubDB) SaveGCTable(db.GCTable) error                                 { return nil }
+func (stubDB) DeleteGCTable(string) error                                   { return nil }
+func (stubDB) GetGCTables() ([]db.GCTable, error)                           { return nil, nil }
+func (stubDB) SaveTableAccess(db.TableAccess) error                         { return nil }
+func (stubDB) GetTableAccess(string) (*db.TableAccess, error)               { return nil, nil }
+func (stubDB) DeleteTableAccess(string) error                               { return nil }
+func (stubDB) SaveTableInvite(db.TableInvite) error                         { return nil }
+func (stubDB) GetTableInvites(string) ([]db.TableInvite, error)             { return nil, nil }
+func (stubDB) SaveTableBan(db.TableBan) error                               { return nil }
+func (stubDB) GetTableBan(string, string) (*db.TableBan, error)             { return nil, nil }
+func (stubDB) AddWaitlistEntry(db.WaitlistEntry) error                      { return nil }
+func (stubDB) DeleteWaitlistEntry(string, string) error                     { return nil }
+func (stubDB) GetWaitlistEntries() ([]db.WaitlistEntry, error)              { return nil, nil }
 
 // newBareServer returns a minimal Server suitable for snapshot tests.
 func newBareServer() *Server {
@@ -1,11 +1,13 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
+	"time"
 
 	"github.com/vctt94/pokerbisonrelay/pkg/poker"
 	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
@@ -18,6 +20,12 @@ type Database interface {
 	GetPlayerBalance(playerID string) (int64, error)
 	// UpdatePlayerBalance updates a player's balance and records the transaction
 	UpdatePlayerBalance(playerID string, amount int64, transactionType, description string) error
+	// UpdatePlayerBalanceAudited is UpdatePlayerBalance that also appends the
+	// change's audit record, completed by audit, in the same transaction and
+	// returns the new balance
+	UpdatePlayerBalanceAudited(playerID string, amount int64, transactionType, description string, audit db.AuditFunc) (int64, error)
+	// GetTransactions returns recorded balance changes, newest first
+	GetTransactions(filter db.TransactionFilter) ([]db.Transaction, error)
 
 	// Game state persistence
 	SaveTableState(tableState *db.TableState) error
@@ -34,19 +42,83 @@ type Database interface {
 	// Table discovery
 	GetAllTableIDs() ([]string, error)
 
+	// Withdrawals
+	CreateWithdrawal(w *db.Withdrawal, dailyLimit int64, since time.Time, audit db.AuditFunc) (int64, error)
+	UpdateWithdrawal(id int64, status, detail string, audit db.AuditFunc) error
+	GetWithdrawal(id int64) (*db.Withdrawal, error)
+	GetWithdrawals(playerID, status string, limit int) ([]*db.Withdrawal, error)
+	GetWithdrawalEvents(id int64) ([]db.WithdrawalEvent, error)
+
+	// Group chat tables
+	SaveGCTable(b db.GCTable) error
+	DeleteGCTable(tableID string) error
+	GetGCTables() ([]db.GCTable, error)
+
+	// Private and password-protected tables
+	SaveTableAccess(a db.TableAccess) error
+	GetTableAccess(tableID string) (*db.TableAccess, error)
+	DeleteTableAccess(tableID string) error
+	SaveTableInvite(inv db.TableInvite) error
+	GetTableInvites(tableID string) ([]db.TableInvite, error)
+	SaveTableBan(b db.TableBan) error
+	GetTableBan(tableID, playerID string) (*db.TableBan, error)
+
+	// Waitlists of full tables
+	AddWaitlistEntry(e db.WaitlistEntry) error
+	DeleteWaitlistEntry(tableID, playerID string) error
+	GetWaitlistEntries() ([]db.WaitlistEntry, error)
+
+	// Audit log
+	AppendAuditRecord(r db.AuditRecord) error
+	GetLastAuditRecord() (*db.AuditRecord, error)
+	GetAuditRecords(afterSeq int64, limit int) ([]db.AuditRecord, error)
+
+	// PingContext checks that the database can be reached.
+	PingContext(ctx context.Context) error
+
 	// Close closes the database connection
 	Close() error
 }
 
 // Transaction represents a player's transaction
-type Transaction struct {
-	ID          int64
-	PlayerID    string
-	Amount      int64
-	Type        string
-	Description string
-	CreatedAt   string
-}
+type Transaction = db.Transaction
+
+// TransactionFilter selects the transactions returned by GetTransactions.
+type TransactionFilter = db.TransactionFilter
+
+// GCTable binds a table to the Bison Relay group chat its public events are
+// posted to.
+type GCTable = db.GCTable
+
+// TableAccess restricts who can see and join a table.
+type TableAccess = db.TableAccess
+
+// TableInvite is an invite code issued by a table host.
+type TableInvite = db.TableInvite
+
+// TableBan bars a player from rejoining a table.
+type TableBan = db.TableBan
+
+// WaitlistEntry is a player waiting for a seat at a full table.
+type WaitlistEntry = db.WaitlistEntry
+
+// Transaction types recorded by the server and the bot.
+const (
+	TransactionDeposit         = "deposit"          // Tip received by the bot from a player
+	TransactionBuyIn           = "table buy-in"     // Buy-in debited on create or join
+	TransactionRefund          = "table refund"     // Chips credited back on leaving a table
+	TransactionTipSent         = "tip sent"         // Player-to-player tip, sender side
+	TransactionTipReceived     = "tip received"     // Player-to-player tip, recipient side
+	TransactionBalanceAdmin    = "balance update"   // Manual adjustment through UpdateBalance
+	TransactionAdminAdjustment = "admin adjustment" // Operator adjustment through AdminService
+
+	TransactionWithdrawal         = db.TransactionWithdrawal         // Amount debited by a withdrawal
+	TransactionWithdrawalReversal = db.TransactionWithdrawalReversal // Refund of a reversed withdrawal
+)
+
+// TransactionLegacyDeposit is the type bot deposits were recorded under
+// before TransactionDeposit existed.
+const TransactionLegacyDeposit = "tip"
 
 // NewDatabase creates a new database connection
 func NewDatabase(dbPath string) (Database, error) {
@@ -60,6 +132,70 @@ func NewDatabase(dbPath string) (Database, error) {
 	return db.NewDB(dbPath)
 }
 
+// NewPostgresDatabase connects to the PostgreSQL database described by dsn,
+// upgrading its schema if needed.
+func NewPostgresDatabase(dsn string) (Database, error) {
+	return db.NewPostgresDB(dsn)
+}
+
+// MemoryDatabase is an in-memory Database with fault injection, for tests and
+// ephemeral servers.
+type MemoryDatabase = db.MemoryDB
+
+// ErrInjectedFault is returned by MemoryDatabase writes failed on purpose.
+var ErrInjectedFault = db.ErrInjectedFault
+
+// NewMemoryDatabase creates an empty in-memory database. Nothing is persisted
+// across restarts.
+func NewMemoryDatabase() *MemoryDatabase {
+	return db.NewMemoryDB()
+}
+
+// OpenDatabase opens the PostgreSQL database described by dsn when it is set
+// and the SQLite database at dbPath otherwise.
+func OpenDatabase(dbPath, dsn string) (Database, error) {
+	if dsn != "" {
+		return NewPostgresDatabase(dsn)
+	}
+	return NewDatabase(dbPath)
+}
+
+// Migration is a single up migration of the database schema.
+type Migration = db.Migration
+
+// MigrationResult summarizes an upgrade of the database schema.
+type MigrationResult = db.MigrationResult
+
+// PendingMigrations returns the current schema version of the database at
+// dbPath and the migrations that would be applied on the next upgrade. The
+// database is not modified.
+func PendingMigrations(dbPath string) (int, []Migration, error) {
+	return db.PendingMigrations(dbPath)
+}
+
+// MigrateDatabase upgrades the database at dbPath to the latest schema
+// version. Existing databases are backed up before any migration runs.
+func MigrateDatabase(dbPath string) (*MigrationResult, error) {
+	dir := filepath.Dir(dbPath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create database directory: %v", err)
+	}
+
+	return db.Migrate(dbPath)
+}
+
+// PendingPostgresMigrations is PendingMigrations for the PostgreSQL database
+// described by dsn.
+func PendingPostgresMigrations(dsn string) (int, []Migration, error) {
+	return db.PendingPostgresMigrations(dsn)
+}
+
+// MigratePostgresDatabase upgrades the PostgreSQL database described by dsn to
+// the latest schema version.
+func MigratePostgresDatabase(dsn string) (*MigrationResult, error) {
+	return db.MigratePostgres(dsn)
+}
+
 // loadTableFromDatabase restores a table from the database
 func (s *Server) loadTableFromDatabase(tableID string) (*poker.Table, error) {
 	// Load table state
@@ -74,19 +210,21 @@ func (s *Server) loadTableFromDatabase(tableID string) (*poker.Table, error) {
 	gameLog := s.logBackend.Logger("GAME")
 
 	cfg := poker.TableConfig{
-		ID:             dbTableState.ID,
-		Log:            tblLog,
-		GameLog:        gameLog,
-		HostID:         dbTableState.HostID,
-		BuyIn:          dbTableState.BuyIn,
-		MinPlayers:     dbTableState.MinPlayers,
-		MaxPlayers:     dbTableState.MaxPlayers,
-		SmallBlind:     dbTableState.SmallBlind,
-		BigBlind:       dbTableState.BigBlind,
-		MinBalance:     dbTableState.MinBalance,
-		StartingChips:  dbTableState.StartingChips,
-		TimeBank:       dbTableState.TimeBank,       // Default
-		AutoStartDelay: dbTableState.AutoStartDelay, // Default
+		ID:              dbTableState.ID,
+		Log:             tblLog,
+		GameLog:         gameLog,
+		HostID:          dbTableState.HostID,
+		BuyIn:           dbTableState.BuyIn,
+		MinPlayers:      dbTableState.MinPlayers,
+		MaxPlayers:      dbTableState.MaxPlayers,
+		SmallBlind:      dbTableState.SmallBlind,
+		BigBlind:        dbTableState.BigBlind,
+		MinBalance:      dbTableState.MinBalance,
+		StartingChips:   dbTableState.StartingChips,
+		TimeBank:        dbTableState.TimeBank,       // Default
+		AutoStartDelay:  dbTableState.AutoStartDelay, // Default
+		CheckInvariants: s.checkInvariants.Load(),
+		SitOutLimit:     DefaultSitOutLimit,
 	}
 
 	// Create table
@@ -278,18 +416,44 @@ func (s *Server) restoreGameState(table *poker.Table, dbTableState *db.TableStat
 		}
 	})
 
-	// Reconstruct pot based on each player's saved bet so that GetPot() matches
-	// the persisted total. We do this outside the ModifyPlayers block to avoid
-	// holding the game write-lock for the additional potManager updates.
-	for idx, p := range game.GetPlayers() {
-		if p.HasBet > 0 {
-			game.AddToPotForPlayer(idx, p.HasBet)
+	// Restore the remaining deck so the rest of the board is dealt exactly as
+	// it would have been without the restart.
+	var deckState poker.DeckState
+	if ok, err := decodeSnapshotField(dbTableState.DeckState, &deckState); err != nil {
+		s.log.Errorf("Failed to decode deck state for table %s: %v", dbTableState.ID, err)
+	} else if ok && len(deckState.RemainingCards) > 0 {
+		if err := game.SetDeckState(&deckState); err != nil {
+			s.log.Errorf("Failed to restore deck state for table %s: %v", dbTableState.ID, err)
+		}
+	}
+
+	// Restore the full pot structure (side pots, eligibility and per-player
+	// contributions) when the snapshot carries it.
+	var potState poker.PotManagerState
+	potRestored := false
+	if ok, err := decodeSnapshotField(dbTableState.PotState, &potState); err != nil {
+		s.log.Errorf("Failed to decode pot state for table %s: %v", dbTableState.ID, err)
+	} else if ok {
+		if err := game.SetPotManagerState(&potState); err != nil {
+			s.log.Errorf("Failed to restore pot state for table %s: %v", dbTableState.ID, err)
+		} else {
+			potRestored = true
 		}
 	}
 
-	// Ensure the pot total matches the snapshot exactly (bets alone may not
-	// capture contributions from previous betting rounds).
-	game.ForceSetPot(dbTableState.Pot)
+	if !potRestored {
+		// Legacy snapshots only carry the pot total. Reconstruct the pot from
+		// each player's saved bet so that GetPot() matches the persisted total.
+		for idx, p := range game.GetPlayers() {
+			if p.HasBet > 0 {
+				game.AddToPotForPlayer(idx, p.HasBet)
+			}
+		}
+
+		// Ensure the pot total matches the snapshot exactly (bets alone may not
+		// capture contributions from previous betting rounds).
+		game.ForceSetPot(dbTableState.Pot)
+	}
 
 	s.log.Infof("Successfully restored game state: dealer=%d, currentPlayer=%d, pot=%d, phase=%s, players=%d",
 		dbTableState.Dealer, dbTableState.CurrentPlayer, dbTableState.Pot, dbTableState.GamePhase, len(game.GetPlayers()))
@@ -297,6 +461,36 @@ func (s *Server) restoreGameState(table *poker.Table, dbTableState *db.TableStat
 	return nil
 }
 
+// decodeSnapshotField decodes a JSON snapshot field into out. The field is
+// either the raw JSON string read from the database or the original value
+// when the database keeps values in memory. It reports whether a value was
+// present.
+func decodeSnapshotField(field interface{}, out interface{}) (bool, error) {
+	var data []byte
+	switch v := field.(type) {
+	case nil:
+		return false, nil
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		var err error
+		if data, err = json.Marshal(v); err != nil {
+			return false, err
+		}
+	}
+
+	switch string(data) {
+	case "", "null", "[]", "{}":
+		return false, nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // loadAllTables loads all persisted tables from the database on server startup
 func (s *Server) loadAllTables() error {
 	s.log.Infof("Loading persisted tables from database...")
@@ -323,6 +517,7 @@ func (s *Server) loadAllTables() error {
 		s.mu.Lock()
 		s.tables[tableID] = table
 		s.mu.Unlock()
+		s.restoreAIPlayers(tableID, table)
 
 		loadedCount++
 		s.log.Infof("Loaded table %s from database", tableID)
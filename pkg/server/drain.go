@@ -0,0 +1,180 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// DefaultDrainTimeout is how long a drain waits for running hands to finish
+// when no timeout is given.
+const DefaultDrainTimeout = 5 * time.Minute
+
+// drainPollInterval is how often a drain checks whether hands are still
+// running.
+const drainPollInterval = 250 * time.Millisecond
+
+// drainAnnouncements are the times left before the drain deadline at which
+// players are reminded of the restart, besides when the drain starts.
+var drainAnnouncements = []time.Duration{2 * time.Minute, time.Minute, 30 * time.Second, 10 * time.Second}
+
+// StartDrain drains the server ahead of a restart. New tables and joins are
+// refused, paused tables are resumed so their running hands play out, and no
+// new hand starts. Players are told
+// how long the restart may take. Once no hand is running, or timeout passed,
+// every table is persisted and Drained is closed. It returns the deadline of
+// the drain; calls after the first return that of the first and do nothing
+// else.
+func (s *Server) StartDrain(timeout time.Duration) time.Time {
+	if timeout <= 0 {
+		timeout = DefaultDrainTimeout
+	}
+
+	s.mu.Lock()
+	if s.draining {
+		deadline := s.drainDeadline
+		s.mu.Unlock()
+		return deadline
+	}
+	s.draining = true
+	s.drainDeadline = time.Now().Add(timeout)
+	deadline := s.drainDeadline
+	tables := make([]*poker.Table, 0, len(s.tables))
+	for _, table := range s.tables {
+		tables = append(tables, table)
+	}
+	s.mu.Unlock()
+
+	for _, table := range tables {
+		table.Drain()
+	}
+	s.log.Infof("Draining server: %d tables, waiting up to %v for hands to finish", len(tables), timeout)
+	go s.runDrain(deadline)
+	return deadline
+}
+
+// IsDraining returns whether the server is draining or drained.
+func (s *Server) IsDraining() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.draining
+}
+
+// Drained returns a channel closed once a drain started by StartDrain has
+// finished and every table was persisted.
+func (s *Server) Drained() <-chan struct{} {
+	return s.drained
+}
+
+// runDrain waits for running hands to finish or the deadline to pass,
+// announcing the restart along the way, then persists every table.
+func (s *Server) runDrain(deadline time.Time) {
+	defer close(s.drained)
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	next := 0 // Next of drainAnnouncements to make
+	for first := true; ; first = false {
+		hands := s.handsInProgress()
+		if hands == 0 {
+			break
+		}
+		left := time.Until(deadline)
+		if left <= 0 {
+			s.log.Warnf("Drain timed out with %d hands in progress", hands)
+			break
+		}
+
+		announce := first
+		for next < len(drainAnnouncements) && left <= drainAnnouncements[next] {
+			announce = true
+			next++
+		}
+		if announce {
+			s.announceDrain(left, fmt.Sprintf("The server restarts for maintenance in at most %v. "+
+				"Hands in progress will finish; no new hands will start.", left.Round(time.Second)))
+		}
+		<-ticker.C
+	}
+
+	s.persistAllTables()
+	s.announceDrain(0, "The server is restarting for maintenance.")
+	s.log.Infof("Server drained")
+}
+
+// allTables returns the tables of the server.
+func (s *Server) allTables() []*poker.Table {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	tables := make([]*poker.Table, 0, len(s.tables))
+	for _, table := range s.tables {
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+// handsInProgress returns the number of tables where a hand is being played.
+func (s *Server) handsInProgress() int {
+	n := 0
+	for _, table := range s.allTables() {
+		if table.HandInProgress() {
+			n++
+		}
+	}
+	return n
+}
+
+// announceDrain tells every connected client, and every seated player
+// reached through the notification relay, about the restart.
+func (s *Server) announceDrain(left time.Duration, message string) {
+	notification := &pokerrpc.Notification{
+		Type:      pokerrpc.NotificationType_SERVER_DRAINING,
+		Message:   message,
+		Countdown: int32(left.Seconds()),
+	}
+	s.broadcast(notification)
+
+	s.notificationMu.RLock()
+	relay := s.notificationRelay
+	s.notificationMu.RUnlock()
+	if relay == nil {
+		return
+	}
+	for _, playerID := range s.seatedPlayers() {
+		s.notificationMu.RLock()
+		_, hasStream := s.notificationStreams[playerID]
+		s.notificationMu.RUnlock()
+		if !hasStream {
+			relay.RelayNotification(playerID, notification)
+		}
+	}
+}
+
+// seatedPlayers returns the players seated at any table.
+func (s *Server) seatedPlayers() []string {
+	var ids []string
+	for _, table := range s.allTables() {
+		for _, u := range table.GetUsers() {
+			ids = append(ids, u.ID)
+		}
+	}
+	return ids
+}
+
+// persistAllTables saves every table and waits for the saves to complete.
+func (s *Server) persistAllTables() {
+	s.mu.RLock()
+	ids := make([]string, 0, len(s.tables))
+	for id := range s.tables {
+		ids = append(ids, id)
+	}
+	s.mu.RUnlock()
+
+	for _, id := range ids {
+		s.saveTableStateAsync(id, "drain")
+	}
+	s.saveWg.Wait()
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// isDrained returns whether the drain of srv finished.
+func isDrained(srv *Server) bool {
+	select {
+	case <-srv.Drained():
+		return true
+	default:
+		return false
+	}
+}
+
+func TestDrainFinishesRunningHand(t *testing.T) {
+	srv, database, tableID := newHostTest(t)
+	ctx := context.Background()
+	startHostTestGame(t, srv, tableID)
+
+	srv.StartDrain(time.Minute)
+	_, err := srv.CreateTable(ctx, &pokerrpc.CreateTableRequest{PlayerId: "carol", BuyIn: 100})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	join := joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "carol", TableId: tableID})
+	assert.False(t, join.Success)
+	assert.Contains(t, join.Message, "maintenance")
+
+	// The hand in progress keeps the server from draining until it ends.
+	time.Sleep(2 * drainPollInterval)
+	assert.False(t, isDrained(srv))
+
+	folder := srv.tables[tableID].GetCurrentPlayerID()
+	_, err = srv.FoldBet(ctx, &pokerrpc.FoldBetRequest{PlayerId: folder, TableId: tableID})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return isDrained(srv) }, 5*time.Second, 10*time.Millisecond)
+
+	// No new hand is dealt and the table was persisted with its players.
+	table := srv.tables[tableID]
+	assert.False(t, table.HandInProgress())
+	_, err = database.LoadTableState(tableID)
+	require.NoError(t, err)
+	players, err := database.LoadPlayerStates(tableID)
+	require.NoError(t, err)
+	assert.Len(t, players, 2)
+}
+
+func TestDrainResumesPausedTable(t *testing.T) {
+	srv, _, tableID := newHostTest(t)
+	ctx := context.Background()
+	startHostTestGame(t, srv, tableID)
+	_, err := srv.PauseTable(ctx, &pokerrpc.PauseTableRequest{PlayerId: "alice", TableId: tableID})
+	require.NoError(t, err)
+
+	srv.StartDrain(time.Minute)
+	table := srv.tables[tableID]
+	assert.False(t, table.IsPaused())
+	_, err = srv.PauseTable(ctx, &pokerrpc.PauseTableRequest{PlayerId: "alice", TableId: tableID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The hand that was paused can be played out.
+	folder := table.GetCurrentPlayerID()
+	_, err = srv.FoldBet(ctx, &pokerrpc.FoldBetRequest{PlayerId: folder, TableId: tableID})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return isDrained(srv) }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestDrainTimeout(t *testing.T) {
+	srv, _, tableID := newHostTest(t)
+	startHostTestGame(t, srv, tableID)
+
+	srv.StartDrain(drainPollInterval)
+	require.Eventually(t, func() bool { return isDrained(srv) }, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, srv.tables[tableID].HandInProgress())
+}
+
+func TestDrainKeepsHandsFromStarting(t *testing.T) {
+	srv, _, tableID := newHostTest(t)
+	srv.StartDrain(time.Minute)
+	require.Eventually(t, func() bool { return isDrained(srv) }, 5*time.Second, 10*time.Millisecond)
+
+	startHostTestGame(t, srv, tableID)
+	assert.False(t, srv.tables[tableID].IsGameStarted())
+}
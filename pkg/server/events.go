@@ -1,7 +1,9 @@
 package server
 
 import (
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/decred/slog"
@@ -30,7 +32,9 @@ type TableSnapshot struct {
 	GameSnapshot *GameSnapshot
 	Config       poker.TableConfig
 	State        TableState
-	Timestamp    time.Time
+	// When the table acts for the current player; zero when off the clock
+	ActionDeadline time.Time
+	Timestamp      time.Time
 }
 
 // PlayerSnapshot represents an immutable snapshot of player state
@@ -42,6 +46,7 @@ type PlayerSnapshot struct {
 	DCRAccountBalance int64
 	IsReady           bool
 	IsDisconnected    bool
+	IsSittingOut      bool
 	HasFolded         bool
 	IsAllIn           bool
 	IsDealer          bool
@@ -50,6 +55,7 @@ type PlayerSnapshot struct {
 	HandDescription   string
 	HasBet            int64
 	StartingBalance   int64
+	TimeBank          time.Duration
 }
 
 // GameSnapshot represents an immutable snapshot of game state
@@ -85,12 +91,17 @@ type EventProcessor struct {
 	mu       sync.Mutex
 }
 
+// eventStallTimeout is how long a worker may spend on a single event before
+// CheckWorkers reports it stuck.
+const eventStallTimeout = 30 * time.Second
+
 // eventWorker processes events from the queue
 type eventWorker struct {
 	id        int
 	processor *EventProcessor
 	stopChan  chan struct{}
 	wg        *sync.WaitGroup
+	busySince atomic.Int64 // Unix nanoseconds the current event was taken at; 0 when idle
 }
 
 // NewEventProcessor creates a new event processor
@@ -167,6 +178,7 @@ func (ep *EventProcessor) PublishEvent(event *GameEvent) {
 
 	if !started {
 		ep.log.Warnf("Event processor not started, dropping event: %v", event.Type)
+		ep.server.metrics.observeEventDropped()
 		return
 	}
 
@@ -175,9 +187,32 @@ func (ep *EventProcessor) PublishEvent(event *GameEvent) {
 		ep.log.Debugf("Published event: %s for table %s", event.Type, event.TableID)
 	default:
 		ep.log.Errorf("Event queue full, dropping event: %s for table %s", event.Type, event.TableID)
+		ep.server.metrics.observeEventDropped()
 	}
 }
 
+// CheckWorkers returns an error when the processor is stopped or a worker has
+// been stuck on an event for longer than eventStallTimeout.
+func (ep *EventProcessor) CheckWorkers() error {
+	ep.mu.Lock()
+	started := ep.started
+	ep.mu.Unlock()
+	if !started {
+		return fmt.Errorf("not running")
+	}
+
+	for _, w := range ep.workers {
+		since := w.busySince.Load()
+		if since == 0 {
+			continue
+		}
+		if busy := time.Since(time.Unix(0, since)); busy > eventStallTimeout {
+			return fmt.Errorf("worker %d stuck on an event for %v", w.id, busy.Round(time.Second))
+		}
+	}
+	return nil
+}
+
 // run executes the worker loop
 func (w *eventWorker) run() {
 	defer w.wg.Done()
@@ -195,7 +230,9 @@ func (w *eventWorker) run() {
 
 		case event := <-w.processor.queue:
 			if event != nil {
+				w.busySince.Store(time.Now().UnixNano())
 				w.processEvent(event)
+				w.busySince.Store(0)
 			}
 		}
 	}
@@ -209,6 +246,7 @@ func (w *eventWorker) processEvent(event *GameEvent) {
 	w.processNotifications(event)
 	w.processGameStateUpdates(event)
 	w.processPersistence(event)
+	w.processWaitlist(event)
 }
 
 // processNotifications handles notification broadcasting for the event
@@ -223,6 +261,18 @@ func (w *eventWorker) processGameStateUpdates(event *GameEvent) {
 	handler.HandleEvent(event)
 }
 
+// processWaitlist offers seats the event may have freed, such as those of
+// players who left or busted out, to the players waiting for one.
+func (w *eventWorker) processWaitlist(event *GameEvent) {
+	s := w.processor.server
+	s.mu.RLock()
+	table, ok := s.tables[event.TableID]
+	s.mu.RUnlock()
+	if ok {
+		s.offerSeats(event.TableID, table)
+	}
+}
+
 // processPersistence handles state persistence for the event
 func (w *eventWorker) processPersistence(event *GameEvent) {
 	handler := NewPersistenceHandler(w.processor.server)
@@ -1,6 +1,10 @@
 package server
 
-import "github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+import (
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
 
 // Each event carries exactly one payload implementing this interface.
 type EventPayload interface {
@@ -26,6 +30,14 @@ func (GameStartedPayload) Kind() pokerrpc.NotificationType {
 	return pokerrpc.NotificationType_GAME_STARTED
 }
 
+type GameEndedPayload struct {
+	Reason string
+}
+
+func (GameEndedPayload) Kind() pokerrpc.NotificationType {
+	return pokerrpc.NotificationType_GAME_ENDED
+}
+
 type NewHandStartedPayload struct {
 	HandID    uint64 // optional
 	DealerPos int    // optional
@@ -98,3 +110,76 @@ type PlayerLeftPayload struct {
 func (PlayerLeftPayload) Kind() pokerrpc.NotificationType {
 	return pokerrpc.NotificationType_PLAYER_LEFT
 }
+
+type PlayerSatOutPayload struct {
+	PlayerID string
+	Auto     bool // Sat out by the table for missing actions
+}
+
+func (PlayerSatOutPayload) Kind() pokerrpc.NotificationType {
+	return pokerrpc.NotificationType_PLAYER_SAT_OUT
+}
+
+type PlayerSatInPayload struct {
+	PlayerID string
+}
+
+func (PlayerSatInPayload) Kind() pokerrpc.NotificationType {
+	return pokerrpc.NotificationType_PLAYER_SAT_IN
+}
+
+type TimeBankUsedPayload struct {
+	PlayerID string
+	Added    time.Duration // Time drawn from the player's time bank
+	Deadline time.Time     // New deadline of the player's turn
+}
+
+func (TimeBankUsedPayload) Kind() pokerrpc.NotificationType {
+	return pokerrpc.NotificationType_TIME_BANK_USED
+}
+
+// ---------- Host moderation payloads ----------
+
+type PlayerKickedPayload struct {
+	PlayerID string
+	Reason   string
+	Refund   int64 // Atoms credited back for the player's chips
+	Auto     bool  // Removed by the table for sitting out too long
+}
+
+func (PlayerKickedPayload) Kind() pokerrpc.NotificationType {
+	return pokerrpc.NotificationType_PLAYER_KICKED
+}
+
+type PlayerBannedPayload struct {
+	PlayerID string
+	Reason   string
+}
+
+func (PlayerBannedPayload) Kind() pokerrpc.NotificationType {
+	return pokerrpc.NotificationType_PLAYER_BANNED
+}
+
+type GamePausedPayload struct {
+	HostID string
+}
+
+func (GamePausedPayload) Kind() pokerrpc.NotificationType {
+	return pokerrpc.NotificationType_GAME_PAUSED
+}
+
+type GameResumedPayload struct {
+	HostID string
+}
+
+func (GameResumedPayload) Kind() pokerrpc.NotificationType {
+	return pokerrpc.NotificationType_GAME_RESUMED
+}
+
+type TableClosedPayload struct {
+	Reason string
+}
+
+func (TableClosedPayload) Kind() pokerrpc.NotificationType {
+	return pokerrpc.NotificationType_TABLE_CLOSED
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"time"
+
 	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
 )
 
@@ -40,10 +42,24 @@ func (nh *NotificationHandler) HandleEvent(event *GameEvent) {
 		nh.handlePlayerJoined(event)
 	case pokerrpc.NotificationType_PLAYER_LEFT:
 		nh.handlePlayerLeft(event)
+	case pokerrpc.NotificationType_PLAYER_SAT_OUT,
+		pokerrpc.NotificationType_PLAYER_SAT_IN:
+		nh.handleSitOutChanged(event)
+	case pokerrpc.NotificationType_TIME_BANK_USED:
+		nh.handleTimeBankUsed(event)
 	case pokerrpc.NotificationType_NEW_HAND_STARTED:
 		nh.handleNewHandStarted(event)
 	case pokerrpc.NotificationType_SHOWDOWN_RESULT:
 		nh.handleShowdownResult(event)
+	case pokerrpc.NotificationType_PLAYER_KICKED:
+		nh.handlePlayerKicked(event)
+	case pokerrpc.NotificationType_PLAYER_BANNED:
+		nh.handlePlayerBanned(event)
+	case pokerrpc.NotificationType_GAME_PAUSED,
+		pokerrpc.NotificationType_GAME_RESUMED:
+		nh.handlePauseChanged(event)
+	case pokerrpc.NotificationType_TABLE_CLOSED:
+		nh.handleTableClosed(event)
 	}
 }
 
@@ -117,11 +133,13 @@ func (nh *NotificationHandler) handleGameStarted(event *GameEvent) {
 }
 
 func (nh *NotificationHandler) handleGameEnded(event *GameEvent) {
-	// If you have a typed payload (e.g., winner summary), assert it here
 	notification := &pokerrpc.Notification{
 		Type:    pokerrpc.NotificationType_GAME_ENDED,
 		TableId: event.TableID,
 	}
+	if pl, ok := event.Payload.(GameEndedPayload); ok {
+		notification.Message = pl.Reason
+	}
 	nh.server.notifyPlayers(event.PlayerIDs, notification)
 }
 
@@ -190,6 +208,91 @@ func (nh *NotificationHandler) handleShowdownResult(event *GameEvent) {
 	nh.server.notifyPlayers(event.PlayerIDs, notification)
 }
 
+func (nh *NotificationHandler) handlePlayerKicked(event *GameEvent) {
+	pl, ok := event.Payload.(PlayerKickedPayload)
+	if !ok {
+		nh.server.log.Warnf("PLAYER_KICKED without PlayerKickedPayload; skipping (table=%s)", event.TableID)
+		return
+	}
+	notification := &pokerrpc.Notification{
+		Type:     pokerrpc.NotificationType_PLAYER_KICKED,
+		PlayerId: pl.PlayerID,
+		TableId:  event.TableID,
+		Message:  pl.Reason,
+		Amount:   pl.Refund,
+		Auto:     pl.Auto,
+	}
+	nh.server.notifyPlayers(event.PlayerIDs, notification)
+}
+
+func (nh *NotificationHandler) handlePlayerBanned(event *GameEvent) {
+	pl, ok := event.Payload.(PlayerBannedPayload)
+	if !ok {
+		nh.server.log.Warnf("PLAYER_BANNED without PlayerBannedPayload; skipping (table=%s)", event.TableID)
+		return
+	}
+	notification := &pokerrpc.Notification{
+		Type:     pokerrpc.NotificationType_PLAYER_BANNED,
+		PlayerId: pl.PlayerID,
+		TableId:  event.TableID,
+		Message:  pl.Reason,
+	}
+	nh.server.notifyPlayers(event.PlayerIDs, notification)
+}
+
+func (nh *NotificationHandler) handlePauseChanged(event *GameEvent) {
+	notification := &pokerrpc.Notification{
+		Type:    event.Type,
+		TableId: event.TableID,
+	}
+	switch pl := event.Payload.(type) {
+	case GamePausedPayload:
+		notification.PlayerId = pl.HostID
+	case GameResumedPayload:
+		notification.PlayerId = pl.HostID
+	}
+	nh.server.notifyPlayers(event.PlayerIDs, notification)
+}
+
+func (nh *NotificationHandler) handleSitOutChanged(event *GameEvent) {
+	notification := &pokerrpc.Notification{
+		Type:    event.Type,
+		TableId: event.TableID,
+	}
+	switch pl := event.Payload.(type) {
+	case PlayerSatOutPayload:
+		notification.PlayerId = pl.PlayerID
+		notification.Auto = pl.Auto
+	case PlayerSatInPayload:
+		notification.PlayerId = pl.PlayerID
+	}
+	nh.server.notifyPlayers(event.PlayerIDs, notification)
+}
+
+func (nh *NotificationHandler) handleTimeBankUsed(event *GameEvent) {
+	pl, ok := event.Payload.(TimeBankUsedPayload)
+	if !ok {
+		return
+	}
+	nh.server.notifyPlayers(event.PlayerIDs, &pokerrpc.Notification{
+		Type:      pokerrpc.NotificationType_TIME_BANK_USED,
+		TableId:   event.TableID,
+		PlayerId:  pl.PlayerID,
+		Countdown: int32(time.Until(pl.Deadline).Round(time.Second) / time.Second),
+	})
+}
+
+func (nh *NotificationHandler) handleTableClosed(event *GameEvent) {
+	notification := &pokerrpc.Notification{
+		Type:    pokerrpc.NotificationType_TABLE_CLOSED,
+		TableId: event.TableID,
+	}
+	if pl, ok := event.Payload.(TableClosedPayload); ok {
+		notification.Message = pl.Reason
+	}
+	nh.server.notifyPlayers(event.PlayerIDs, notification)
+}
+
 // ------------------------ Game State Handler ------------------------
 
 type GameStateHandler struct {
@@ -206,6 +309,16 @@ func (gsh *GameStateHandler) HandleEvent(event *GameEvent) {
 	if len(gameStates) > 0 {
 		gsh.server.sendGameStateUpdates(event.TableID, gameStates)
 	}
+	gsh.server.wakeAIPlayers(event.TableID)
+
+	// The table relay and the spectators get the state as seen by an
+	// onlooker
+	if update := gsh.buildGameUpdateFromSnapshot(event.TableSnapshot, ""); update != nil {
+		if relay := gsh.server.getTableRelay(); relay != nil {
+			relay.RelayTableUpdate(event.TableID, update)
+		}
+		gsh.server.feedSpectators(event.TableID, spectatorItem{update: update})
+	}
 }
 
 func (gsh *GameStateHandler) buildGameStatesFromSnapshot(snapshot *TableSnapshot) map[string]*pokerrpc.GameUpdate {
@@ -234,8 +347,12 @@ func (gsh *GameStateHandler) buildGameUpdateFromSnapshot(tableSnapshot *TableSna
 		var players []*pokerrpc.Player
 		for _, ps := range tableSnapshot.Players {
 			player := &pokerrpc.Player{
-				Id:      ps.ID,
-				IsReady: ps.IsReady,
+				Id:         ps.ID,
+				IsReady:    ps.IsReady,
+				Seat:       int32(ps.TableSeat + 1),
+				SittingOut: ps.IsSittingOut,
+
+				TimeBankSeconds: timeBankSeconds(ps.TimeBank),
 			}
 			players = append(players, player)
 		}
@@ -247,6 +364,7 @@ func (gsh *GameStateHandler) buildGameUpdateFromSnapshot(tableSnapshot *TableSna
 			Players:         players,
 			PlayersRequired: int32(tableSnapshot.Config.MinPlayers),
 			PlayersJoined:   int32(tableSnapshot.State.PlayerCount),
+			Spectators:      int32(gsh.server.spectatorCount(tableSnapshot.ID)),
 		}
 	}
 
@@ -259,6 +377,11 @@ func (gsh *GameStateHandler) buildGameUpdateFromSnapshot(tableSnapshot *TableSna
 			IsReady:    ps.IsReady,
 			Folded:     ps.HasFolded,
 			CurrentBet: ps.HasBet,
+			IsDealer:   ps.IsDealer,
+			Seat:       int32(ps.TableSeat + 1),
+			SittingOut: ps.IsSittingOut,
+
+			TimeBankSeconds: timeBankSeconds(ps.TimeBank),
 		}
 
 		if ps.ID == requestingPlayerID {
@@ -314,6 +437,9 @@ func (gsh *GameStateHandler) buildGameUpdateFromSnapshot(tableSnapshot *TableSna
 		GameStarted:     tableSnapshot.State.GameStarted,
 		PlayersRequired: int32(tableSnapshot.Config.MinPlayers),
 		PlayersJoined:   int32(tableSnapshot.State.PlayerCount),
+
+		ActionDeadlineUnixMs: unixMillis(tableSnapshot.ActionDeadline),
+		Spectators:           int32(gsh.server.spectatorCount(tableSnapshot.ID)),
 	}
 }
 
@@ -328,6 +454,10 @@ func NewPersistenceHandler(server *Server) *PersistenceHandler {
 }
 
 func (ph *PersistenceHandler) HandleEvent(event *GameEvent) {
+	// A closed table has no state left to save
+	if event.Type == pokerrpc.NotificationType_TABLE_CLOSED {
+		return
+	}
 	// Save table state asynchronously using existing method
 	ph.server.saveTableStateAsync(event.TableID, string(event.Type))
 }
@@ -2,8 +2,11 @@ package server
 
 import (
 	"context"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/require"
 	"github.com/vctt94/pokerbisonrelay/pkg/poker"
 	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
 	"google.golang.org/grpc/metadata"
@@ -105,3 +108,224 @@ func TestGameStateHandlerBuildGameStates(t *testing.T) {
 		t.Errorf("p1 should NOT see p2 hand in preflop phase")
 	}
 }
+
+// newPersistenceTestServer returns a server backed by an in-memory database
+// holding one active heads-up table.
+func newPersistenceTestServer(t *testing.T) (*Server, *MemoryDatabase) {
+	t.Helper()
+
+	database := NewMemoryDatabase()
+	logBackend := createTestLogBackend()
+	s := NewServer(database, logBackend)
+	t.Cleanup(func() {
+		s.Stop()
+		database.Close()
+		logBackend.Close()
+	})
+
+	s.mu.Lock()
+	s.tables["tid"] = buildActiveHeadsUpTable(t, "tid")
+	s.mu.Unlock()
+	return s, database
+}
+
+// TestSaveTableStateWriteFailure verifies that a failed snapshot write is
+// reported and leaves nothing behind, and that the next save succeeds.
+func TestSaveTableStateWriteFailure(t *testing.T) {
+	s, database := newPersistenceTestServer(t)
+
+	database.FailNthWrite(1, nil)
+	err := s.saveTableState("tid")
+	require.ErrorContains(t, err, "failed to save table snapshot")
+	require.ErrorContains(t, err, ErrInjectedFault.Error())
+
+	_, err = database.LoadTableState("tid")
+	require.Error(t, err)
+	players, err := database.LoadPlayerStates("tid")
+	require.NoError(t, err)
+	require.Empty(t, players)
+
+	require.NoError(t, s.saveTableState("tid"))
+	ts, err := database.LoadTableState("tid")
+	require.NoError(t, err)
+	require.True(t, ts.GameStarted)
+	players, err = database.LoadPlayerStates("tid")
+	require.NoError(t, err)
+	require.Len(t, players, 2)
+}
+
+// TestPersistenceHandlerRecoversFromWriteFailure verifies that a failed
+// asynchronous save does not block later events for the same table from
+// being persisted, even when writes are slow.
+func TestPersistenceHandlerRecoversFromWriteFailure(t *testing.T) {
+	s, database := newPersistenceTestServer(t)
+	database.SetLatency(10 * time.Millisecond)
+
+	ph := NewPersistenceHandler(s)
+	database.FailNthWrite(1, nil)
+	ph.HandleEvent(&GameEvent{Type: pokerrpc.NotificationType_BET_MADE, TableID: "tid"})
+	ph.HandleEvent(&GameEvent{Type: pokerrpc.NotificationType_BET_MADE, TableID: "tid"})
+	s.saveWg.Wait()
+
+	require.Equal(t, 2, database.Writes())
+	ts, err := database.LoadTableState("tid")
+	require.NoError(t, err)
+	require.Equal(t, "tid", ts.ID)
+}
+
+// recordingRelay records the notifications and game updates relayed to each
+// player.
+type recordingRelay struct {
+	mu      sync.Mutex
+	sent    map[string][]*pokerrpc.Notification
+	updates map[string][]*pokerrpc.GameUpdate
+
+	// Public table events, keyed by table ID.
+	tableSent    map[string][]*pokerrpc.Notification
+	tableUpdates map[string][]*pokerrpc.GameUpdate
+}
+
+func (r *recordingRelay) RelayNotification(playerID string, n *pokerrpc.Notification) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.sent == nil {
+		r.sent = make(map[string][]*pokerrpc.Notification)
+	}
+	r.sent[playerID] = append(r.sent[playerID], n)
+}
+
+func (r *recordingRelay) RelayGameUpdate(playerID string, u *pokerrpc.GameUpdate) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.updates == nil {
+		r.updates = make(map[string][]*pokerrpc.GameUpdate)
+	}
+	r.updates[playerID] = append(r.updates[playerID], u)
+}
+
+func (r *recordingRelay) RelayTableNotification(tableID string, n *pokerrpc.Notification) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.tableSent == nil {
+		r.tableSent = make(map[string][]*pokerrpc.Notification)
+	}
+	r.tableSent[tableID] = append(r.tableSent[tableID], n)
+}
+
+func (r *recordingRelay) RelayTableUpdate(tableID string, u *pokerrpc.GameUpdate) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.tableUpdates == nil {
+		r.tableUpdates = make(map[string][]*pokerrpc.GameUpdate)
+	}
+	r.tableUpdates[tableID] = append(r.tableUpdates[tableID], u)
+}
+
+// TestNotificationRelay verifies that players without an open notification
+// stream get their notifications through the relay, and only them.
+func TestNotificationRelay(t *testing.T) {
+	s := newBareServer()
+	s.notificationStreams = make(map[string]*NotificationStream)
+	s.tables["tid"] = buildActiveHeadsUpTable(t, "tid")
+
+	stream := &mockNotificationStream{}
+	s.notificationStreams["p1"] = &NotificationStream{playerID: "p1", stream: stream, done: make(chan struct{})}
+
+	// Without a relay, players without a stream are skipped.
+	s.notifyPlayers([]string{"p1", "p2"}, &pokerrpc.Notification{Type: pokerrpc.NotificationType_CHECK_MADE})
+	require.Len(t, stream.sent, 1)
+
+	relay := &recordingRelay{}
+	s.SetNotificationRelay(relay)
+	s.broadcastNotificationToTable("tid", &pokerrpc.Notification{Type: pokerrpc.NotificationType_GAME_STARTED})
+	require.Len(t, stream.sent, 2)
+	require.Len(t, relay.sent["p2"], 1)
+	require.Empty(t, relay.sent["p1"])
+
+	// A closed stream falls back to the relay.
+	close(s.notificationStreams["p1"].done)
+	s.notifyPlayer("p1", &pokerrpc.Notification{Type: pokerrpc.NotificationType_GAME_ENDED})
+	require.Len(t, stream.sent, 2)
+	require.Len(t, relay.sent["p1"], 1)
+	require.Equal(t, pokerrpc.NotificationType_GAME_ENDED, relay.sent["p1"][0].Type)
+}
+
+// mockGameStream records the game updates sent to a player's game stream.
+type mockGameStream struct {
+	mockNotificationStream
+	mu      sync.Mutex
+	updates []*pokerrpc.GameUpdate
+}
+
+func (m *mockGameStream) Send(u *pokerrpc.GameUpdate) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updates = append(m.updates, u)
+	return nil
+}
+
+// TestGameUpdateRelay verifies that game updates for players without a game
+// stream go through the relay.
+func TestGameUpdateRelay(t *testing.T) {
+	s := newBareServer()
+	s.gameStreams = make(map[string]map[string]pokerrpc.PokerService_StartGameStreamServer)
+	stream := &mockGameStream{}
+	s.gameStreams["tid"] = map[string]pokerrpc.PokerService_StartGameStreamServer{"p1": stream}
+
+	relay := &recordingRelay{}
+	s.SetNotificationRelay(relay)
+	s.sendGameStateUpdates("tid", map[string]*pokerrpc.GameUpdate{
+		"p1": {TableId: "tid", CurrentPlayer: "p1"},
+		"p2": {TableId: "tid", CurrentPlayer: "p1"},
+	})
+
+	relay.mu.Lock()
+	require.Len(t, relay.updates["p2"], 1)
+	require.Empty(t, relay.updates["p1"])
+	relay.mu.Unlock()
+	require.Eventually(t, func() bool {
+		stream.mu.Lock()
+		defer stream.mu.Unlock()
+		return len(stream.updates) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+// TestTableRelay verifies that the table relay gets each table notification
+// once and the game state without hole cards.
+func TestTableRelay(t *testing.T) {
+	s := newBareServer()
+	s.notificationStreams = make(map[string]*NotificationStream)
+	s.gameStreams = make(map[string]map[string]pokerrpc.PokerService_StartGameStreamServer)
+	relay := &recordingRelay{}
+	s.SetTableRelay(relay)
+
+	s.notifyPlayers([]string{"p1", "p2"}, &pokerrpc.Notification{
+		Type: pokerrpc.NotificationType_CHECK_MADE, TableId: "tid", PlayerId: "p1",
+	})
+	require.Len(t, relay.tableSent["tid"], 1)
+	require.Empty(t, relay.sent, "the table relay is not a player relay")
+
+	cardA := poker.NewCardFromSuitValue(poker.Spades, poker.Ace)
+	snapshot := &TableSnapshot{
+		ID: "tid",
+		Players: []*PlayerSnapshot{
+			{ID: "p1", Balance: 990, Hand: []poker.Card{cardA, cardA}},
+			{ID: "p2", Balance: 980, Hand: []poker.Card{cardA, cardA}},
+		},
+		GameSnapshot: &GameSnapshot{
+			Phase:          pokerrpc.GamePhase_FLOP,
+			CommunityCards: []poker.Card{cardA, cardA, cardA},
+			CurrentPlayer:  "p1",
+		},
+		State: TableState{GameStarted: true, PlayerCount: 2},
+	}
+	NewGameStateHandler(s).HandleEvent(&GameEvent{TableID: "tid", TableSnapshot: snapshot})
+
+	require.Len(t, relay.tableUpdates["tid"], 1)
+	update := relay.tableUpdates["tid"][0]
+	require.Len(t, update.CommunityCards, 3)
+	require.Len(t, update.Players, 2)
+	for _, p := range update.Players {
+		require.Empty(t, p.Hand, "hole cards of %s must stay hidden", p.Id)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
 	"github.com/vctt94/pokerbisonrelay/pkg/server/internal/db"
@@ -21,9 +22,6 @@ func (s *Server) buildGameState(tableID, requestingPlayerID string) (*pokerrpc.G
 		return nil, status.Error(codes.NotFound, "table not found")
 	}
 
-	// Process any player timeouts before building the state
-	table.HandleTimeouts()
-
 	game := table.GetGame()
 
 	return s.buildGameStateForPlayer(table, game, requestingPlayerID), nil
@@ -69,6 +67,7 @@ func (s *Server) saveTableState(tableID string) error {
 		dbTableState.BetRound = tableSnapshot.Game.BetRound
 		dbTableState.CommunityCards = tableSnapshot.Game.CommunityCards
 		dbTableState.DeckState = tableSnapshot.Game.DeckState
+		dbTableState.PotState = tableSnapshot.Game.PotState
 	}
 
 	// Build an aggregated, de-duplicated set of player states.
@@ -153,7 +152,9 @@ func (s *Server) saveTableStateAsync(tableID string, reason string) {
 		saveMutex.Lock()
 		defer saveMutex.Unlock()
 
+		start := time.Now()
 		err := s.saveTableState(tableID)
+		s.metrics.observeSave(time.Since(start), err)
 		if err != nil {
 			s.log.Errorf("Failed to save table state for %s (%s): %v", tableID, reason, err)
 		}
@@ -0,0 +1,298 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// hostTable returns the table for a host moderation request, checking that
+// the requesting player hosts it.
+func (s *Server) hostTable(playerID, tableID string) (*poker.Table, error) {
+	if playerID == "" || tableID == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id and table_id are required")
+	}
+	s.mu.RLock()
+	table, ok := s.tables[tableID]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, status.Error(codes.NotFound, "table not found")
+	}
+	if table.GetConfig().HostID != playerID {
+		return nil, status.Error(codes.PermissionDenied, "only the table host can do this")
+	}
+	return table, nil
+}
+
+// chipsToAtoms converts table chips back to DCR atoms at the table's buy-in
+// rate.
+func chipsToAtoms(cfg poker.TableConfig, chips int64) int64 {
+	if cfg.StartingChips <= 0 || chips <= 0 {
+		return 0
+	}
+	return chips * cfg.BuyIn / cfg.StartingChips
+}
+
+// cashOut credits a player leaving a table with the DCR value of their chips
+// and returns the amount credited. The chips of bots go to the table host.
+// actor is who made them leave.
+func (s *Server) cashOut(actor string, table *poker.Table, playerID string, chips int64, description string) (int64, error) {
+	refund := chipsToAtoms(table.GetConfig(), chips)
+	if refund == 0 {
+		return 0, nil
+	}
+	if isAIPlayerID(playerID) {
+		description += " (" + playerID + ")"
+	}
+	if _, err := s.updateBalance(actor, payee(table, playerID), table, refund, TransactionRefund, description); err != nil {
+		return 0, err
+	}
+	return refund, nil
+}
+
+// kickPlayer removes a player from a table between hands and cashes out
+// their chips. actor is the host removing them.
+func (s *Server) kickPlayer(actor, tableID string, table *poker.Table, playerID string) (int64, error) {
+	chips, err := table.KickUser(playerID)
+	if errors.Is(err, poker.ErrHandInProgress) {
+		return 0, status.Error(codes.FailedPrecondition, "players can only be removed between hands")
+	}
+	if err != nil {
+		return 0, status.Error(codes.NotFound, err.Error())
+	}
+	s.stopAIPlayer(playerID)
+	if err := s.db.DeletePlayerState(tableID, playerID); err != nil {
+		s.log.Errorf("Failed to delete player state from database: %v", err)
+	}
+	refund, err := s.cashOut(actor, table, playerID, chips, "removed from table")
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to refund player: %v", err)
+	}
+	s.log.Infof("Player %s removed from table %s with %d chips (%d atoms refunded)", playerID, tableID, chips, refund)
+	return refund, nil
+}
+
+// publishModerationEvent publishes a host moderation event to the players at
+// the table and, when set, to a player who was just removed from it.
+func (s *Server) publishModerationEvent(eventType pokerrpc.NotificationType, tableID string, payload EventPayload, removedID string) {
+	event, err := s.buildGameEvent(eventType, tableID, payload)
+	if err != nil {
+		s.log.Errorf("Failed to build %s event: %v", eventType, err)
+		return
+	}
+	if removedID != "" {
+		event.PlayerIDs = append(event.PlayerIDs, removedID)
+	}
+	s.eventProcessor.PublishEvent(event)
+}
+
+// KickPlayer removes a player from the table between hands. Their chips are
+// converted back to DCR and credited to their account.
+func (s *Server) KickPlayer(ctx context.Context, req *pokerrpc.KickPlayerRequest) (*pokerrpc.KickPlayerResponse, error) {
+	table, err := s.hostTable(req.PlayerId, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+	if req.TargetId == "" {
+		return nil, status.Error(codes.InvalidArgument, "target_id is required")
+	}
+	if req.TargetId == req.PlayerId {
+		return nil, status.Error(codes.InvalidArgument, "the host cannot remove themselves; leave the table instead")
+	}
+
+	refund, err := s.kickPlayer(req.PlayerId, req.TableId, table, req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	s.publishModerationEvent(pokerrpc.NotificationType_PLAYER_KICKED, req.TableId,
+		PlayerKickedPayload{PlayerID: req.TargetId, Reason: req.Reason, Refund: refund}, req.TargetId)
+
+	return &pokerrpc.KickPlayerResponse{
+		Success: true,
+		Message: fmt.Sprintf("Removed %s from the table", req.TargetId),
+		Refund:  refund,
+	}, nil
+}
+
+// BanPlayer bars a player from rejoining the table, removing them first if
+// they are seated.
+func (s *Server) BanPlayer(ctx context.Context, req *pokerrpc.BanPlayerRequest) (*pokerrpc.BanPlayerResponse, error) {
+	table, err := s.hostTable(req.PlayerId, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+	if req.TargetId == "" {
+		return nil, status.Error(codes.InvalidArgument, "target_id is required")
+	}
+	if req.TargetId == req.PlayerId {
+		return nil, status.Error(codes.InvalidArgument, "the host cannot ban themselves")
+	}
+
+	var refund int64
+	seated := table.GetUser(req.TargetId) != nil
+	if seated {
+		if refund, err = s.kickPlayer(req.PlayerId, req.TableId, table, req.TargetId); err != nil {
+			return nil, err
+		}
+	}
+	ban := TableBan{TableID: req.TableId, PlayerID: req.TargetId, BannedBy: req.PlayerId, Reason: req.Reason}
+	if err := s.db.SaveTableBan(ban); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save ban: %v", err)
+	}
+
+	removedID := ""
+	if seated {
+		removedID = req.TargetId
+	}
+	s.publishModerationEvent(pokerrpc.NotificationType_PLAYER_BANNED, req.TableId,
+		PlayerBannedPayload{PlayerID: req.TargetId, Reason: req.Reason}, removedID)
+
+	return &pokerrpc.BanPlayerResponse{
+		Success: true,
+		Message: fmt.Sprintf("Banned %s from the table", req.TargetId),
+		Refund:  refund,
+	}, nil
+}
+
+// PauseTable freezes the table's game: players cannot act, timebanks stop
+// running and no new hand starts until the host resumes it.
+func (s *Server) PauseTable(ctx context.Context, req *pokerrpc.PauseTableRequest) (*pokerrpc.PauseTableResponse, error) {
+	table, err := s.hostTable(req.PlayerId, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+	if err := table.Pause(); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	s.publishModerationEvent(pokerrpc.NotificationType_GAME_PAUSED, req.TableId,
+		GamePausedPayload{HostID: req.PlayerId}, "")
+
+	return &pokerrpc.PauseTableResponse{Success: true, Message: "Game paused"}, nil
+}
+
+// ResumeTable resumes a paused table, starting the game if every player
+// became ready while it was paused.
+func (s *Server) ResumeTable(ctx context.Context, req *pokerrpc.ResumeTableRequest) (*pokerrpc.ResumeTableResponse, error) {
+	table, err := s.hostTable(req.PlayerId, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+	if err := table.Resume(); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	s.publishModerationEvent(pokerrpc.NotificationType_GAME_RESUMED, req.TableId,
+		GameResumedPayload{HostID: req.PlayerId}, "")
+
+	if !table.IsGameStarted() && !table.IsDraining() && table.CheckAllPlayersReady() {
+		if err := s.startTableGame(req.TableId, table, req.PlayerId); err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to start game: %v", err))
+		}
+	}
+
+	return &pokerrpc.ResumeTableResponse{Success: true, Message: "Game resumed"}, nil
+}
+
+// CloseTable closes the table. A hand in progress is played out first; then
+// every player is cashed out and the table's persisted state is deleted.
+func (s *Server) CloseTable(ctx context.Context, req *pokerrpc.CloseTableRequest) (*pokerrpc.CloseTableResponse, error) {
+	table, err := s.hostTable(req.PlayerId, req.TableId)
+	if err != nil {
+		return nil, err
+	}
+	if table.IsClosing() {
+		return nil, status.Error(codes.FailedPrecondition, "table is already closing")
+	}
+
+	s.mu.Lock()
+	s.closeReasons[req.TableId] = req.Reason
+	s.mu.Unlock()
+	table.BeginClose()
+
+	if table.HandInProgress() {
+		return &pokerrpc.CloseTableResponse{
+			Success: true,
+			Message: "Table will close when the current hand ends",
+		}, nil
+	}
+	s.finishCloseTable(req.PlayerId, req.TableId, table)
+	return &pokerrpc.CloseTableResponse{Success: true, Message: "Table closed"}, nil
+}
+
+// finishCloseTable removes a closing table, stops its bots and timers, cashes
+// out its players, deletes its persisted state and publishes TABLE_CLOSED.
+// actor is who closed it: the host, or the last player leaving it. It does
+// nothing once the table was removed, waiting for a close in progress. Every
+// table is removed here, so that all of its state is dropped.
+func (s *Server) finishCloseTable(actor, tableID string, table *poker.Table) {
+	s.closeMu.Lock()
+	defer s.closeMu.Unlock()
+
+	s.mu.RLock()
+	reason, current := s.closeReasons[tableID], s.tables[tableID]
+	s.mu.RUnlock()
+	if current != table {
+		return
+	}
+
+	// Build the event while the table is still registered so its players
+	// are notified.
+	event, err := s.buildGameEvent(pokerrpc.NotificationType_TABLE_CLOSED, tableID, TableClosedPayload{Reason: reason})
+	if err != nil {
+		s.log.Errorf("Failed to build TABLE_CLOSED event: %v", err)
+	}
+
+	// Wait for any save of the table in flight so it cannot write the
+	// table state back after it is deleted.
+	s.saveMu.Lock()
+	saveMutex, ok := s.saveMutexes[tableID]
+	if !ok {
+		saveMutex = &sync.Mutex{}
+	}
+	delete(s.saveMutexes, tableID)
+	s.saveMu.Unlock()
+	saveMutex.Lock()
+	defer saveMutex.Unlock()
+
+	s.mu.Lock()
+	if s.tables[tableID] != table {
+		s.mu.Unlock()
+		return
+	}
+	delete(s.tables, tableID)
+	delete(s.closeReasons, tableID)
+	s.mu.Unlock()
+
+	table.StopClock()
+	if g := table.GetGame(); g != nil {
+		g.CancelAutoStart()
+	}
+	s.stopAIPlayers(func(p *aiPlayer) bool { return p.tableID == tableID })
+	s.cancelSitOutRemovals(tableID)
+	for playerID, chips := range table.ChipCounts() {
+		if _, err := s.cashOut(actor, table, playerID, chips, "table closed"); err != nil {
+			s.log.Errorf("Failed to cash out player %s from table %s: %v", playerID, tableID, err)
+		}
+	}
+	if err := s.db.DeleteTableState(tableID); err != nil {
+		s.log.Errorf("Failed to delete table state from database: %v", err)
+	}
+	if err := s.db.DeleteTableAccess(tableID); err != nil {
+		s.log.Errorf("Failed to delete table access from database: %v", err)
+	}
+	s.dropWaitlist(tableID)
+	s.dropChat(tableID)
+	s.log.Infof("Table %s closed by %s", tableID, actor)
+
+	if event != nil {
+		s.eventProcessor.PublishEvent(event)
+	} else {
+		// Spectators otherwise leave on the TABLE_CLOSED notification.
+		s.endSpectatorFeed(tableID)
+	}
+}
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newHostTest returns a server with a public table hosted by alice that bob
+// joined. Both paid the 100 atom buy-in for 1000 chips.
+func newHostTest(t *testing.T) (*Server, Database, string) {
+	t.Helper()
+	srv, database := newAccessTest(t)
+	tableID := createAccessTable(t, srv, false, "")
+	require.True(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID}).Success)
+	return srv, database, tableID
+}
+
+// startHostTestGame readies alice and bob so the first hand is dealt.
+func startHostTestGame(t *testing.T, srv *Server, tableID string) {
+	t.Helper()
+	for _, id := range []string{"alice", "bob"} {
+		_, err := srv.SetPlayerReady(context.Background(), &pokerrpc.SetPlayerReadyRequest{PlayerId: id, TableId: tableID})
+		require.NoError(t, err)
+	}
+}
+
+func TestHostOnlyModeration(t *testing.T) {
+	srv, _, tableID := newHostTest(t)
+	ctx := context.Background()
+
+	_, err := srv.KickPlayer(ctx, &pokerrpc.KickPlayerRequest{PlayerId: "bob", TableId: tableID, TargetId: "alice"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.BanPlayer(ctx, &pokerrpc.BanPlayerRequest{PlayerId: "bob", TableId: tableID, TargetId: "alice"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.PauseTable(ctx, &pokerrpc.PauseTableRequest{PlayerId: "bob", TableId: tableID})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.CloseTable(ctx, &pokerrpc.CloseTableRequest{PlayerId: "bob", TableId: tableID})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.CloseTable(ctx, &pokerrpc.CloseTableRequest{PlayerId: "alice", TableId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.KickPlayer(ctx, &pokerrpc.KickPlayerRequest{PlayerId: "alice", TableId: tableID, TargetId: "alice"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.KickPlayer(ctx, &pokerrpc.KickPlayerRequest{PlayerId: "alice", TableId: tableID, TargetId: "carol"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestKickAndBanPlayer(t *testing.T) {
+	srv, database, tableID := newHostTest(t)
+	ctx := context.Background()
+
+	// A kicked player gets their chips back and may rejoin.
+	resp, err := srv.KickPlayer(ctx, &pokerrpc.KickPlayerRequest{PlayerId: "alice", TableId: tableID, TargetId: "bob"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), resp.Refund)
+	requireBalance(t, database, "bob", 1000)
+	assert.Equal(t, int32(1), srv.TableInfo(tableID).CurrentPlayers)
+	require.True(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID}).Success)
+
+	// A banned player is removed and kept out.
+	ban, err := srv.BanPlayer(ctx, &pokerrpc.BanPlayerRequest{PlayerId: "alice", TableId: tableID, TargetId: "bob", Reason: "spam"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), ban.Refund)
+	requireBalance(t, database, "bob", 1000)
+	join := joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID})
+	assert.False(t, join.Success)
+	assert.Contains(t, join.Message, "banned")
+
+	// Players who are not seated can be banned ahead of time.
+	_, err = srv.BanPlayer(ctx, &pokerrpc.BanPlayerRequest{PlayerId: "alice", TableId: tableID, TargetId: "carol"})
+	require.NoError(t, err)
+	assert.False(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "carol", TableId: tableID}).Success)
+	requireBalance(t, database, "carol", 1000)
+}
+
+func TestKickWaitsForHandEnd(t *testing.T) {
+	srv, _, tableID := newHostTest(t)
+	ctx := context.Background()
+	startHostTestGame(t, srv, tableID)
+
+	_, err := srv.KickPlayer(ctx, &pokerrpc.KickPlayerRequest{PlayerId: "alice", TableId: tableID, TargetId: "bob"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestPauseAndResumeTable(t *testing.T) {
+	srv, _, tableID := newHostTest(t)
+	ctx := context.Background()
+
+	_, err := srv.ResumeTable(ctx, &pokerrpc.ResumeTableRequest{PlayerId: "alice", TableId: tableID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Readying up while paused does not start the game; resuming does.
+	_, err = srv.PauseTable(ctx, &pokerrpc.PauseTableRequest{PlayerId: "alice", TableId: tableID})
+	require.NoError(t, err)
+	_, err = srv.PauseTable(ctx, &pokerrpc.PauseTableRequest{PlayerId: "alice", TableId: tableID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	startHostTestGame(t, srv, tableID)
+	table := srv.tables[tableID]
+	assert.False(t, table.IsGameStarted())
+
+	_, err = srv.ResumeTable(ctx, &pokerrpc.ResumeTableRequest{PlayerId: "alice", TableId: tableID})
+	require.NoError(t, err)
+	require.True(t, table.IsGameStarted())
+
+	// Players cannot act while the game is paused.
+	_, err = srv.PauseTable(ctx, &pokerrpc.PauseTableRequest{PlayerId: "alice", TableId: tableID})
+	require.NoError(t, err)
+	current := table.GetCurrentPlayerID()
+	_, err = srv.CallBet(ctx, &pokerrpc.CallBetRequest{PlayerId: current, TableId: tableID})
+	assert.Error(t, err)
+	_, err = srv.ResumeTable(ctx, &pokerrpc.ResumeTableRequest{PlayerId: "alice", TableId: tableID})
+	require.NoError(t, err)
+	_, err = srv.CallBet(ctx, &pokerrpc.CallBetRequest{PlayerId: current, TableId: tableID})
+	assert.NoError(t, err)
+}
+
+func TestCloseTable(t *testing.T) {
+	srv, database, tableID := newHostTest(t)
+	ctx := context.Background()
+	_, err := srv.BanPlayer(ctx, &pokerrpc.BanPlayerRequest{PlayerId: "alice", TableId: tableID, TargetId: "carol"})
+	require.NoError(t, err)
+
+	resp, err := srv.CloseTable(ctx, &pokerrpc.CloseTableRequest{PlayerId: "alice", TableId: tableID})
+	require.NoError(t, err)
+	assert.Equal(t, "Table closed", resp.Message)
+	assert.Nil(t, srv.TableInfo(tableID))
+	requireBalance(t, database, "alice", 1000)
+	requireBalance(t, database, "bob", 1000)
+	state, err := database.LoadTableState(tableID)
+	assert.True(t, err != nil || state == nil)
+	ban, err := database.GetTableBan(tableID, "carol")
+	require.NoError(t, err)
+	assert.Nil(t, ban)
+}
+
+func TestCloseTableFinishesHand(t *testing.T) {
+	srv, database, tableID := newHostTest(t)
+	ctx := context.Background()
+	startHostTestGame(t, srv, tableID)
+
+	resp, err := srv.CloseTable(ctx, &pokerrpc.CloseTableRequest{PlayerId: "alice", TableId: tableID, Reason: "closing time"})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Message, "current hand ends")
+	require.NotNil(t, srv.TableInfo(tableID))
+	_, err = srv.CloseTable(ctx, &pokerrpc.CloseTableRequest{PlayerId: "alice", TableId: tableID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Heads-up the small blind acts first; folding hands the big blind 10
+	// chips, worth one atom.
+	folder := srv.tables[tableID].GetCurrentPlayerID()
+	_, err = srv.FoldBet(ctx, &pokerrpc.FoldBetRequest{PlayerId: folder, TableId: tableID})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return srv.TableInfo(tableID) == nil }, 5*time.Second, 10*time.Millisecond)
+
+	winner := "alice"
+	if folder == "alice" {
+		winner = "bob"
+	}
+	requireBalance(t, database, folder, 999)
+	requireBalance(t, database, winner, 1001)
+}
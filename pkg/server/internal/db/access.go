@@ -0,0 +1,335 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+)
+
+// TableAccess restricts who can see and join a table. Tables without one are
+// public.
+type TableAccess struct {
+	TableID      string
+	Private      bool   // Hidden from the lobby and joined by invitation
+	PasswordSalt string // Hex salt of PasswordHash
+	PasswordHash string // Hex SHA-256 of the salted join password, empty for none
+}
+
+// TableInvite is an invite code issued by a table host.
+type TableInvite struct {
+	Code      string
+	TableID   string
+	InviteeID string // Player the invite is for, empty when anyone may use the code
+	CreatedBy string
+	ExpiresAt time.Time
+	CreatedAt string // RFC3339, UTC
+}
+
+// TableBan bars a player from rejoining a table.
+type TableBan struct {
+	TableID   string
+	PlayerID  string
+	BannedBy  string
+	Reason    string
+	CreatedAt string // RFC3339, UTC
+}
+
+func (d dialect) saveTableAccess(db *sql.DB, a TableAccess) error {
+	_, err := db.Exec(d.rebind(`INSERT INTO table_access (table_id, private, password_salt, password_hash)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (table_id) DO UPDATE SET private = excluded.private,
+			password_salt = excluded.password_salt, password_hash = excluded.password_hash`),
+		a.TableID, a.Private, a.PasswordSalt, a.PasswordHash)
+	return err
+}
+
+func (d dialect) getTableAccess(db *sql.DB, tableID string) (*TableAccess, error) {
+	a := TableAccess{TableID: tableID}
+	err := db.QueryRow(d.rebind("SELECT private, password_salt, password_hash FROM table_access WHERE table_id = ?"),
+		tableID).Scan(&a.Private, &a.PasswordSalt, &a.PasswordHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (d dialect) deleteTableAccess(db *sql.DB, tableID string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(d.rebind("DELETE FROM table_invites WHERE table_id = ?"), tableID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(d.rebind("DELETE FROM table_bans WHERE table_id = ?"), tableID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(d.rebind("DELETE FROM table_waitlist WHERE table_id = ?"), tableID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(d.rebind("DELETE FROM table_access WHERE table_id = ?"), tableID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d dialect) saveTableInvite(db *sql.DB, inv TableInvite) error {
+	_, err := db.Exec(d.rebind(`INSERT INTO table_invites (code, table_id, invitee_id, created_by, expires_at)
+		VALUES (?, ?, ?, ?, ?)`),
+		inv.Code, inv.TableID, inv.InviteeID, inv.CreatedBy, d.timeArg(inv.ExpiresAt))
+	return err
+}
+
+func (d dialect) getTableInvites(db *sql.DB, tableID string) ([]TableInvite, error) {
+	rows, err := db.Query(d.rebind(`SELECT code, table_id, invitee_id, created_by, expires_at, created_at
+		FROM table_invites WHERE table_id = ? ORDER BY expires_at, code`), tableID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invites []TableInvite
+	for rows.Next() {
+		var inv TableInvite
+		var createdAt time.Time
+		if err := rows.Scan(&inv.Code, &inv.TableID, &inv.InviteeID, &inv.CreatedBy, &inv.ExpiresAt, &createdAt); err != nil {
+			return nil, err
+		}
+		inv.ExpiresAt = inv.ExpiresAt.UTC()
+		inv.CreatedAt = formatTime(createdAt)
+		invites = append(invites, inv)
+	}
+	return invites, rows.Err()
+}
+
+func (d dialect) saveTableBan(db *sql.DB, b TableBan) error {
+	_, err := db.Exec(d.rebind(`INSERT INTO table_bans (table_id, player_id, banned_by, reason)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (table_id, player_id) DO UPDATE SET banned_by = excluded.banned_by,
+			reason = excluded.reason`),
+		b.TableID, b.PlayerID, b.BannedBy, b.Reason)
+	return err
+}
+
+func (d dialect) getTableBan(db *sql.DB, tableID, playerID string) (*TableBan, error) {
+	b := TableBan{TableID: tableID, PlayerID: playerID}
+	var createdAt time.Time
+	err := db.QueryRow(d.rebind("SELECT banned_by, reason, created_at FROM table_bans WHERE table_id = ? AND player_id = ?"),
+		tableID, playerID).Scan(&b.BannedBy, &b.Reason, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	b.CreatedAt = formatTime(createdAt)
+	return &b, nil
+}
+
+// SaveTableAccess sets the access restrictions of a table, replacing any
+// previous ones.
+func (db *DB) SaveTableAccess(a TableAccess) error {
+	return sqliteDialect.saveTableAccess(db.DB, a)
+}
+
+// GetTableAccess returns the access restrictions of a table, or nil when the
+// table is public.
+func (db *DB) GetTableAccess(tableID string) (*TableAccess, error) {
+	return sqliteDialect.getTableAccess(db.DB, tableID)
+}
+
+// DeleteTableAccess removes the access restrictions, invites, bans and
+// waitlist of a table.
+func (db *DB) DeleteTableAccess(tableID string) error {
+	return sqliteDialect.deleteTableAccess(db.DB, tableID)
+}
+
+// SaveTableInvite records an invite code.
+func (db *DB) SaveTableInvite(inv TableInvite) error {
+	return sqliteDialect.saveTableInvite(db.DB, inv)
+}
+
+// GetTableInvites returns the invites of a table, expired ones included,
+// ordered by expiry.
+func (db *DB) GetTableInvites(tableID string) ([]TableInvite, error) {
+	return sqliteDialect.getTableInvites(db.DB, tableID)
+}
+
+// SaveTableBan bars a player from rejoining a table, replacing any previous
+// ban of the player.
+func (db *DB) SaveTableBan(b TableBan) error {
+	return sqliteDialect.saveTableBan(db.DB, b)
+}
+
+// GetTableBan returns the ban of a player from a table, or nil when the
+// player is not banned.
+func (db *DB) GetTableBan(tableID, playerID string) (*TableBan, error) {
+	return sqliteDialect.getTableBan(db.DB, tableID, playerID)
+}
+
+// SaveTableAccess sets the access restrictions of a table, replacing any
+// previous ones.
+func (db *PostgresDB) SaveTableAccess(a TableAccess) error {
+	return postgresDialect.saveTableAccess(db.DB, a)
+}
+
+// GetTableAccess returns the access restrictions of a table, or nil when the
+// table is public.
+func (db *PostgresDB) GetTableAccess(tableID string) (*TableAccess, error) {
+	return postgresDialect.getTableAccess(db.DB, tableID)
+}
+
+// DeleteTableAccess removes the access restrictions, invites, bans and
+// waitlist of a table.
+func (db *PostgresDB) DeleteTableAccess(tableID string) error {
+	return postgresDialect.deleteTableAccess(db.DB, tableID)
+}
+
+// SaveTableInvite records an invite code.
+func (db *PostgresDB) SaveTableInvite(inv TableInvite) error {
+	return postgresDialect.saveTableInvite(db.DB, inv)
+}
+
+// GetTableInvites returns the invites of a table, expired ones included,
+// ordered by expiry.
+func (db *PostgresDB) GetTableInvites(tableID string) ([]TableInvite, error) {
+	return postgresDialect.getTableInvites(db.DB, tableID)
+}
+
+// SaveTableBan bars a player from rejoining a table, replacing any previous
+// ban of the player.
+func (db *PostgresDB) SaveTableBan(b TableBan) error {
+	return postgresDialect.saveTableBan(db.DB, b)
+}
+
+// GetTableBan returns the ban of a player from a table, or nil when the
+// player is not banned.
+func (db *PostgresDB) GetTableBan(tableID, playerID string) (*TableBan, error) {
+	return postgresDialect.getTableBan(db.DB, tableID, playerID)
+}
+
+// SaveTableAccess sets the access restrictions of a table, replacing any
+// previous ones.
+func (m *MemoryDB) SaveTableAccess(a TableAccess) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tableAccess[a.TableID] = a
+	return nil
+}
+
+// GetTableAccess returns the access restrictions of a table, or nil when the
+// table is public.
+func (m *MemoryDB) GetTableAccess(tableID string) (*TableAccess, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	a, ok := m.tableAccess[tableID]
+	if !ok {
+		return nil, nil
+	}
+	return &a, nil
+}
+
+// DeleteTableAccess removes the access restrictions, invites, bans and
+// waitlist of a table.
+func (m *MemoryDB) DeleteTableAccess(tableID string) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.tableAccess, tableID)
+	for code, inv := range m.tableInvites {
+		if inv.TableID == tableID {
+			delete(m.tableInvites, code)
+		}
+	}
+	delete(m.tableBans, tableID)
+	delete(m.waitlists, tableID)
+	return nil
+}
+
+// SaveTableInvite records an invite code.
+func (m *MemoryDB) SaveTableInvite(inv TableInvite) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.tableInvites[inv.Code]; ok {
+		return errors.New("duplicate invite code")
+	}
+	// Match the second precision of the SQL backends.
+	inv.ExpiresAt = inv.ExpiresAt.UTC().Truncate(time.Second)
+	inv.CreatedAt = formatTime(time.Now())
+	m.tableInvites[inv.Code] = inv
+	return nil
+}
+
+// GetTableInvites returns the invites of a table, expired ones included,
+// ordered by expiry.
+func (m *MemoryDB) GetTableInvites(tableID string) ([]TableInvite, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var invites []TableInvite
+	for _, inv := range m.tableInvites {
+		if inv.TableID == tableID {
+			invites = append(invites, inv)
+		}
+	}
+	sort.Slice(invites, func(i, j int) bool {
+		if !invites[i].ExpiresAt.Equal(invites[j].ExpiresAt) {
+			return invites[i].ExpiresAt.Before(invites[j].ExpiresAt)
+		}
+		return invites[i].Code < invites[j].Code
+	})
+	return invites, nil
+}
+
+// SaveTableBan bars a player from rejoining a table, replacing any previous
+// ban of the player.
+func (m *MemoryDB) SaveTableBan(b TableBan) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	bans := m.tableBans[b.TableID]
+	if bans == nil {
+		bans = make(map[string]TableBan)
+		m.tableBans[b.TableID] = bans
+	}
+	b.CreatedAt = formatTime(time.Now())
+	bans[b.PlayerID] = b
+	return nil
+}
+
+// GetTableBan returns the ban of a player from a table, or nil when the
+// player is not banned.
+func (m *MemoryDB) GetTableBan(tableID, playerID string) (*TableBan, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	b, ok := m.tableBans[tableID][playerID]
+	if !ok {
+		return nil, nil
+	}
+	return &b, nil
+}
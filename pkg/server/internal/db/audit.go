@@ -0,0 +1,241 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// AuditRecord is an entry of the append-only audit log. Records are numbered
+// from 1 without gaps, and Hash covers the record's fields and PrevHash, the
+// hash of the record before it.
+type AuditRecord struct {
+	Seq      int64
+	Time     int64  // Unix nanoseconds
+	Actor    string // Who performed the action
+	PlayerID string // Whose chips or balance the action changed
+	TableID  string
+	Hand     int64
+	Action   string
+	Amount   int64
+	Balance  int64 // Chips or balance of PlayerID after the action
+	Detail   string
+	PrevHash string
+	Hash     string
+}
+
+// ErrAuditSeqTaken is returned when appending an audit record whose sequence
+// number is already used, meaning another writer extended the chain.
+var ErrAuditSeqTaken = errors.New("audit record sequence number already used")
+
+// AuditFunc completes the audit record of a balance change made by a
+// database write, which fills in PlayerID, Action, Amount, Balance and
+// Detail. The completed record is appended to the audit log in the write's
+// transaction, and the write fails when it cannot be.
+type AuditFunc func(r AuditRecord) AuditRecord
+
+// execer runs statements; it is implemented by *sql.DB and *sql.Tx.
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+const auditColumns = `seq, created_at_ns, actor, player_id, table_id, hand, action,
+	amount, balance, detail, prev_hash, hash`
+
+func (d dialect) appendAuditRecord(db execer, r AuditRecord) error {
+	res, err := db.Exec(d.rebind(`INSERT INTO audit_log (`+auditColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT (seq) DO NOTHING`),
+		r.Seq, r.Time, r.Actor, r.PlayerID, r.TableID, r.Hand, r.Action,
+		r.Amount, r.Balance, r.Detail, r.PrevHash, r.Hash)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: %d", ErrAuditSeqTaken, r.Seq)
+	}
+	return nil
+}
+
+// auditBalance appends the audit record of a balance change to the log in tx,
+// unless audit is nil.
+func (d dialect) auditBalance(tx *sql.Tx, audit AuditFunc, playerID string, amount, balance int64, transactionType, description string) error {
+	if audit == nil {
+		return nil
+	}
+	return d.appendAuditRecord(tx, audit(AuditRecord{
+		PlayerID: playerID,
+		Action:   transactionType,
+		Amount:   amount,
+		Balance:  balance,
+		Detail:   description,
+	}))
+}
+
+func (d dialect) getLastAuditRecord(db *sql.DB) (*AuditRecord, error) {
+	records, err := d.queryAuditRecords(db, "SELECT "+auditColumns+" FROM audit_log ORDER BY seq DESC LIMIT 1")
+	if err != nil || len(records) == 0 {
+		return nil, err
+	}
+	return &records[0], nil
+}
+
+func (d dialect) getAuditRecords(db *sql.DB, afterSeq int64, limit int) ([]AuditRecord, error) {
+	return d.queryAuditRecords(db, d.rebind("SELECT "+auditColumns+
+		" FROM audit_log WHERE seq > ? ORDER BY seq LIMIT ?"), afterSeq, limit)
+}
+
+func (d dialect) queryAuditRecords(db *sql.DB, query string, args ...interface{}) ([]AuditRecord, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []AuditRecord
+	for rows.Next() {
+		var r AuditRecord
+		if err := rows.Scan(&r.Seq, &r.Time, &r.Actor, &r.PlayerID, &r.TableID, &r.Hand, &r.Action,
+			&r.Amount, &r.Balance, &r.Detail, &r.PrevHash, &r.Hash); err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+	return records, rows.Err()
+}
+
+// AppendAuditRecord adds r at the end of the audit log. It fails with
+// ErrAuditSeqTaken when r.Seq is already used.
+func (db *DB) AppendAuditRecord(r AuditRecord) error {
+	return sqliteDialect.appendAuditRecord(db.DB, r)
+}
+
+// GetLastAuditRecord returns the last record of the audit log, or nil when
+// the log is empty.
+func (db *DB) GetLastAuditRecord() (*AuditRecord, error) {
+	return sqliteDialect.getLastAuditRecord(db.DB)
+}
+
+// GetAuditRecords returns up to limit audit records following afterSeq, in
+// order.
+func (db *DB) GetAuditRecords(afterSeq int64, limit int) ([]AuditRecord, error) {
+	return sqliteDialect.getAuditRecords(db.DB, afterSeq, limit)
+}
+
+// AppendAuditRecord adds r at the end of the audit log. It fails with
+// ErrAuditSeqTaken when r.Seq is already used.
+func (db *PostgresDB) AppendAuditRecord(r AuditRecord) error {
+	return postgresDialect.appendAuditRecord(db.DB, r)
+}
+
+// GetLastAuditRecord returns the last record of the audit log, or nil when
+// the log is empty.
+func (db *PostgresDB) GetLastAuditRecord() (*AuditRecord, error) {
+	return postgresDialect.getLastAuditRecord(db.DB)
+}
+
+// GetAuditRecords returns up to limit audit records following afterSeq, in
+// order.
+func (db *PostgresDB) GetAuditRecords(afterSeq int64, limit int) ([]AuditRecord, error) {
+	return postgresDialect.getAuditRecords(db.DB, afterSeq, limit)
+}
+
+// AppendAuditRecord adds r at the end of the audit log. It fails with
+// ErrAuditSeqTaken when r.Seq is already used.
+func (m *MemoryDB) AppendAuditRecord(r AuditRecord) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.checkAuditSeq(r); err != nil {
+		return err
+	}
+	m.auditLog = append(m.auditLog, r)
+	return nil
+}
+
+// checkAuditSeq fails with ErrAuditSeqTaken when r cannot be appended to the
+// log. The log is kept in sequence order, and the server never skips a
+// number. Callers must hold m.mu.
+func (m *MemoryDB) checkAuditSeq(r AuditRecord) error {
+	if n := len(m.auditLog); n > 0 && r.Seq <= m.auditLog[n-1].Seq {
+		return fmt.Errorf("%w: %d", ErrAuditSeqTaken, r.Seq)
+	}
+	return nil
+}
+
+// auditBalance completes the audit record of a balance change and checks that
+// it can be appended, so the change can be made along with it. It returns nil
+// when audit is nil. Callers must hold m.mu.
+func (m *MemoryDB) auditBalance(audit AuditFunc, playerID string, amount, balance int64, transactionType, description string) (*AuditRecord, error) {
+	if audit == nil {
+		return nil, nil
+	}
+	r := audit(AuditRecord{
+		PlayerID: playerID,
+		Action:   transactionType,
+		Amount:   amount,
+		Balance:  balance,
+		Detail:   description,
+	})
+	if err := m.checkAuditSeq(r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// appendAudit appends r, as returned by auditBalance, to the log. Callers must
+// hold m.mu.
+func (m *MemoryDB) appendAudit(r *AuditRecord) {
+	if r != nil {
+		m.auditLog = append(m.auditLog, *r)
+	}
+}
+
+// GetLastAuditRecord returns the last record of the audit log, or nil when
+// the log is empty.
+func (m *MemoryDB) GetLastAuditRecord() (*AuditRecord, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if len(m.auditLog) == 0 {
+		return nil, nil
+	}
+	r := m.auditLog[len(m.auditLog)-1]
+	return &r, nil
+}
+
+// GetAuditRecords returns up to limit audit records following afterSeq, in
+// order.
+func (m *MemoryDB) GetAuditRecords(afterSeq int64, limit int) ([]AuditRecord, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	i := sort.Search(len(m.auditLog), func(i int) bool { return m.auditLog[i].Seq > afterSeq })
+	j := len(m.auditLog)
+	if j-i > limit {
+		j = i + limit
+	}
+	return append([]AuditRecord(nil), m.auditLog[i:j]...), nil
+}
+
+// TamperAuditRecord replaces the stored audit record with the same sequence
+// number as r, bypassing the append-only rule, so tests can check that
+// tampering is detected.
+func (m *MemoryDB) TamperAuditRecord(r AuditRecord) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.auditLog {
+		if m.auditLog[i].Seq == r.Seq {
+			m.auditLog[i] = r
+		}
+	}
+}
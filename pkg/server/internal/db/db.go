@@ -39,6 +39,9 @@ type TableState struct {
 	// Deck state (stored as JSON)
 	DeckState interface{}
 
+	// Pot manager state: side pots, eligibility and per-player bets (stored as JSON)
+	PotState interface{}
+
 	TimeBank       time.Duration
 	AutoStartDelay time.Duration
 }
@@ -78,104 +81,15 @@ func NewDB(dbPath string) (*DB, error) {
 		return nil, err
 	}
 
-	// Create tables if they don't exist
-	if err := createTables(db); err != nil {
+	// Bring the schema up to date, backing up existing databases first.
+	if _, err := upgrade(db, dbPath); err != nil {
+		db.Close()
 		return nil, err
 	}
 
 	return &DB{db}, nil
 }
 
-// createTables creates the necessary database tables
-func createTables(db *sql.DB) error {
-	// Create players table
-	_, err := db.Exec(`
-		CREATE TABLE IF NOT EXISTS players (
-			id TEXT PRIMARY KEY,
-			name TEXT NOT NULL,
-			balance INTEGER NOT NULL DEFAULT 0,
-			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-		)
-	`)
-	if err != nil {
-		return err
-	}
-
-	// Create transactions table
-	_, err = db.Exec(`
-		CREATE TABLE IF NOT EXISTS transactions (
-			id INTEGER PRIMARY KEY AUTOINCREMENT,
-			player_id TEXT NOT NULL,
-			amount INTEGER NOT NULL,
-			type TEXT NOT NULL,
-			description TEXT,
-			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-			FOREIGN KEY (player_id) REFERENCES players(id)
-		)
-	`)
-	if err != nil {
-		return err
-	}
-
-	// Create table_states table for persisting game state
-	_, err = db.Exec(`
-		CREATE TABLE IF NOT EXISTS table_states (
-			id TEXT PRIMARY KEY,
-			host_id TEXT NOT NULL,
-			buy_in INTEGER NOT NULL,
-			min_players INTEGER NOT NULL,
-			max_players INTEGER NOT NULL,
-			small_blind INTEGER NOT NULL,
-			big_blind INTEGER NOT NULL,
-			min_balance INTEGER NOT NULL,
-			starting_chips INTEGER NOT NULL,
-			game_started BOOLEAN NOT NULL DEFAULT FALSE,
-			game_phase TEXT NOT NULL DEFAULT 'WAITING',
-			dealer INTEGER DEFAULT -1,
-			current_player INTEGER DEFAULT -1,
-			current_bet INTEGER DEFAULT 0,
-			pot INTEGER DEFAULT 0,
-			round_num INTEGER DEFAULT 0,
-			bet_round INTEGER DEFAULT 0,
-			community_cards TEXT DEFAULT '[]',
-			deck_state TEXT DEFAULT '[]',
-			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-			last_action TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-		)
-	`)
-	if err != nil {
-		return err
-	}
-
-	// Create player_states table for persisting player state at tables
-	_, err = db.Exec(`
-		CREATE TABLE IF NOT EXISTS player_states (
-			player_id TEXT NOT NULL,
-			table_id TEXT NOT NULL,
-			table_seat INTEGER NOT NULL,
-			is_ready BOOLEAN NOT NULL DEFAULT FALSE,
-			balance INTEGER NOT NULL DEFAULT 0,
-			starting_balance INTEGER NOT NULL DEFAULT 0,
-			has_bet INTEGER NOT NULL DEFAULT 0,
-			has_folded BOOLEAN NOT NULL DEFAULT FALSE,
-			is_all_in BOOLEAN NOT NULL DEFAULT FALSE,
-			is_dealer BOOLEAN NOT NULL DEFAULT FALSE,
-			is_turn BOOLEAN NOT NULL DEFAULT FALSE,
-			game_state TEXT NOT NULL DEFAULT 'AT_TABLE',
-			hand TEXT DEFAULT '[]',
-			hand_description TEXT DEFAULT '',
-			last_action TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-			PRIMARY KEY (player_id, table_id),
-			FOREIGN KEY (table_id) REFERENCES table_states(id) ON DELETE CASCADE
-		)
-	`)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // GetPlayerBalance returns the current balance of a player
 func (db *DB) GetPlayerBalance(playerID string) (int64, error) {
 	var balance int64
@@ -191,20 +105,30 @@ func (db *DB) GetPlayerBalance(playerID string) (int64, error) {
 
 // UpdatePlayerBalance updates a player's balance and records the transaction
 func (db *DB) UpdatePlayerBalance(playerID string, amount int64, transactionType, description string) error {
+	_, err := db.UpdatePlayerBalanceAudited(playerID, amount, transactionType, description, nil)
+	return err
+}
+
+// UpdatePlayerBalanceAudited updates a player's balance and records the
+// transaction along with its audit record, completed by audit, returning the
+// new balance.
+func (db *DB) UpdatePlayerBalanceAudited(playerID string, amount int64, transactionType, description string, audit AuditFunc) (int64, error) {
 	tx, err := db.Begin()
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer tx.Rollback()
 
 	// Update player balance
-	_, err = tx.Exec(`
+	var balance int64
+	err = tx.QueryRow(`
 		INSERT INTO players (id, name, balance)
 		VALUES (?, ?, ?)
 		ON CONFLICT(id) DO UPDATE SET balance = balance + ?
-	`, playerID, playerID, amount, amount)
+		RETURNING balance
+	`, playerID, playerID, amount, amount).Scan(&balance)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	// Record transaction
@@ -213,10 +137,14 @@ func (db *DB) UpdatePlayerBalance(playerID string, amount int64, transactionType
 		VALUES (?, ?, ?, ?)
 	`, playerID, amount, transactionType, description)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return tx.Commit()
+	err = sqliteDialect.auditBalance(tx, audit, playerID, amount, balance, transactionType, description)
+	if err != nil {
+		return 0, err
+	}
+	return balance, tx.Commit()
 }
 
 // Close closes the database connection
@@ -229,20 +157,21 @@ func (db *DB) SaveTableState(tableState *TableState) error {
 	// Convert community cards and deck state to JSON
 	communityCardsJSON, _ := json.Marshal(tableState.CommunityCards)
 	deckStateJSON, _ := json.Marshal(tableState.DeckState)
+	potStateJSON, _ := json.Marshal(tableState.PotState)
 
 	_, err := db.Exec(`
 		INSERT OR REPLACE INTO table_states (
 			id, host_id, buy_in, min_players, max_players, small_blind, big_blind,
 			min_balance, starting_chips, game_started, game_phase, dealer,
 			current_player, current_bet, pot, round_num, bet_round,
-			community_cards, deck_state, last_action
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			community_cards, deck_state, pot_state, last_action
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`,
 		tableState.ID, tableState.HostID, tableState.BuyIn, tableState.MinPlayers, tableState.MaxPlayers,
 		tableState.SmallBlind, tableState.BigBlind, tableState.MinBalance, tableState.StartingChips,
 		tableState.GameStarted, tableState.GamePhase, tableState.Dealer, tableState.CurrentPlayer,
 		tableState.CurrentBet, tableState.Pot, tableState.Round, tableState.BetRound,
-		string(communityCardsJSON), string(deckStateJSON), time.Now(),
+		string(communityCardsJSON), string(deckStateJSON), string(potStateJSON), time.Now(),
 	)
 	return err
 }
@@ -251,19 +180,20 @@ func (db *DB) SaveTableState(tableState *TableState) error {
 func (db *DB) LoadTableState(tableID string) (*TableState, error) {
 	var ts TableState
 	var communityCardsJSON, deckStateJSON string
+	var potStateJSON sql.NullString
 
 	err := db.QueryRow(`
 		SELECT id, host_id, buy_in, min_players, max_players, small_blind, big_blind,
 		       min_balance, starting_chips, game_started, game_phase, dealer,
 		       current_player, current_bet, pot, round_num, bet_round,
-		       community_cards, deck_state, created_at, last_action
+		       community_cards, deck_state, pot_state, created_at, last_action
 		FROM table_states WHERE id = ?
 	`, tableID).Scan(
 		&ts.ID, &ts.HostID, &ts.BuyIn, &ts.MinPlayers, &ts.MaxPlayers,
 		&ts.SmallBlind, &ts.BigBlind, &ts.MinBalance, &ts.StartingChips,
 		&ts.GameStarted, &ts.GamePhase, &ts.Dealer, &ts.CurrentPlayer,
 		&ts.CurrentBet, &ts.Pot, &ts.Round, &ts.BetRound,
-		&communityCardsJSON, &deckStateJSON, &ts.CreatedAt, &ts.LastAction,
+		&communityCardsJSON, &deckStateJSON, &potStateJSON, &ts.CreatedAt, &ts.LastAction,
 	)
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("table state not found")
@@ -277,6 +207,7 @@ func (db *DB) LoadTableState(tableID string) (*TableState, error) {
 	// restoration where they are expected to be provided as JSON strings).
 	ts.CommunityCards = communityCardsJSON
 	ts.DeckState = deckStateJSON
+	ts.PotState = potStateJSON.String
 
 	return &ts, nil
 }
@@ -382,6 +313,7 @@ func (db *DB) SaveSnapshot(tableState *TableState, playerStates []*PlayerState)
 	// Convert complex fields to JSON up front so that we can reuse them in the transaction.
 	communityCardsJSON, _ := json.Marshal(tableState.CommunityCards)
 	deckStateJSON, _ := json.Marshal(tableState.DeckState)
+	potStateJSON, _ := json.Marshal(tableState.PotState)
 
 	tx, err := db.Begin()
 	if err != nil {
@@ -400,14 +332,14 @@ func (db *DB) SaveSnapshot(tableState *TableState, playerStates []*PlayerState)
 			id, host_id, buy_in, min_players, max_players, small_blind, big_blind,
 			min_balance, starting_chips, game_started, game_phase, dealer,
 			current_player, current_bet, pot, round_num, bet_round,
-			community_cards, deck_state, last_action
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			community_cards, deck_state, pot_state, last_action
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`,
 		tableState.ID, tableState.HostID, tableState.BuyIn, tableState.MinPlayers, tableState.MaxPlayers,
 		tableState.SmallBlind, tableState.BigBlind, tableState.MinBalance, tableState.StartingChips,
 		tableState.GameStarted, tableState.GamePhase, tableState.Dealer, tableState.CurrentPlayer,
 		tableState.CurrentBet, tableState.Pot, tableState.Round, tableState.BetRound,
-		string(communityCardsJSON), string(deckStateJSON), time.Now(),
+		string(communityCardsJSON), string(deckStateJSON), string(potStateJSON), time.Now(),
 	)
 	if err != nil {
 		return err
@@ -0,0 +1,124 @@
+package db
+
+import (
+	"database/sql"
+	"sort"
+	"time"
+)
+
+// GCTable binds a poker table to the Bison Relay group chat its public events
+// are posted to.
+type GCTable struct {
+	TableID   string
+	GCID      string
+	BoundBy   string // Player that bound the table
+	CreatedAt string // RFC3339, UTC
+}
+
+func (d dialect) saveGCTable(db *sql.DB, b GCTable) error {
+	_, err := db.Exec(d.rebind(`INSERT INTO gc_tables (table_id, gc_id, bound_by) VALUES (?, ?, ?)
+		ON CONFLICT (table_id) DO UPDATE SET gc_id = excluded.gc_id, bound_by = excluded.bound_by`),
+		b.TableID, b.GCID, b.BoundBy)
+	return err
+}
+
+func (d dialect) deleteGCTable(db *sql.DB, tableID string) error {
+	_, err := db.Exec(d.rebind("DELETE FROM gc_tables WHERE table_id = ?"), tableID)
+	return err
+}
+
+func (d dialect) getGCTables(db *sql.DB) ([]GCTable, error) {
+	rows, err := db.Query("SELECT table_id, gc_id, bound_by, created_at FROM gc_tables ORDER BY table_id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bindings []GCTable
+	for rows.Next() {
+		var b GCTable
+		var createdAt time.Time
+		if err := rows.Scan(&b.TableID, &b.GCID, &b.BoundBy, &createdAt); err != nil {
+			return nil, err
+		}
+		b.CreatedAt = formatTime(createdAt)
+		bindings = append(bindings, b)
+	}
+	return bindings, rows.Err()
+}
+
+// SaveGCTable binds a table to a group chat, replacing any previous binding
+// of the table.
+func (db *DB) SaveGCTable(b GCTable) error {
+	return sqliteDialect.saveGCTable(db.DB, b)
+}
+
+// DeleteGCTable removes the group chat binding of a table.
+func (db *DB) DeleteGCTable(tableID string) error {
+	return sqliteDialect.deleteGCTable(db.DB, tableID)
+}
+
+// GetGCTables returns all group chat bindings ordered by table ID.
+func (db *DB) GetGCTables() ([]GCTable, error) {
+	return sqliteDialect.getGCTables(db.DB)
+}
+
+// SaveGCTable binds a table to a group chat, replacing any previous binding
+// of the table.
+func (db *PostgresDB) SaveGCTable(b GCTable) error {
+	return postgresDialect.saveGCTable(db.DB, b)
+}
+
+// DeleteGCTable removes the group chat binding of a table.
+func (db *PostgresDB) DeleteGCTable(tableID string) error {
+	return postgresDialect.deleteGCTable(db.DB, tableID)
+}
+
+// GetGCTables returns all group chat bindings ordered by table ID.
+func (db *PostgresDB) GetGCTables() ([]GCTable, error) {
+	return postgresDialect.getGCTables(db.DB)
+}
+
+// SaveGCTable binds a table to a group chat, replacing any previous binding
+// of the table.
+func (m *MemoryDB) SaveGCTable(b GCTable) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if old, ok := m.gcTables[b.TableID]; ok {
+		b.CreatedAt = old.CreatedAt
+	} else {
+		b.CreatedAt = formatTime(time.Now())
+	}
+	m.gcTables[b.TableID] = b
+	return nil
+}
+
+// DeleteGCTable removes the group chat binding of a table.
+func (m *MemoryDB) DeleteGCTable(tableID string) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.gcTables, tableID)
+	return nil
+}
+
+// GetGCTables returns all group chat bindings ordered by table ID.
+func (m *MemoryDB) GetGCTables() ([]GCTable, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	bindings := make([]GCTable, 0, len(m.gcTables))
+	for _, b := range m.gcTables {
+		bindings = append(bindings, b)
+	}
+	sort.Slice(bindings, func(i, j int) bool { return bindings[i].TableID < bindings[j].TableID })
+	return bindings, nil
+}
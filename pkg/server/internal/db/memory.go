@@ -0,0 +1,589 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrInjectedFault is returned by MemoryDB writes failed on purpose through
+// FailNthWrite.
+var ErrInjectedFault = errors.New("injected database fault")
+
+// errMemoryDBClosed is returned by writes to a closed MemoryDB.
+var errMemoryDBClosed = errors.New("database is closed")
+
+// memTransaction is a balance change recorded by MemoryDB.
+type memTransaction struct {
+	ID          int64
+	PlayerID    string
+	Amount      int64
+	Type        string
+	Description string
+	CreatedAt   time.Time
+}
+
+// memWithdrawal is a withdrawal stored by MemoryDB.
+type memWithdrawal struct {
+	Withdrawal
+	created time.Time
+}
+
+// MemoryDB is an in-memory implementation of the server database for tests
+// and ephemeral servers. It follows the semantics of the SQLite
+// implementation: balances are created on first update and every change is
+// recorded as a transaction, JSON fields are stored serialized and loaded
+// back as raw JSON strings, and SaveSnapshot applies all or nothing.
+//
+// Writes can be made to fail deterministically with FailNthWrite and every
+// operation can be slowed down with SetLatency.
+type MemoryDB struct {
+	mu           sync.RWMutex
+	balances     map[string]int64
+	transactions []memTransaction
+	tableStates  map[string]*TableState
+	playerStates map[string]map[string]*PlayerState // tableID -> playerID -> state
+	withdrawals  []*memWithdrawal                   // Indexed by ID-1
+	wdEvents     []WithdrawalEvent
+	gcTables     map[string]GCTable                  // tableID -> binding
+	tableAccess  map[string]TableAccess              // tableID -> restrictions
+	tableInvites map[string]TableInvite              // code -> invite
+	tableBans    map[string]map[string]TableBan      // tableID -> playerID -> ban
+	waitlists    map[string]map[string]WaitlistEntry // tableID -> playerID -> entry
+	auditLog     []AuditRecord                       // In sequence order
+
+	nextTxID int64
+	closed   bool
+
+	faultMu  sync.Mutex
+	writes   int   // Writes attempted so far
+	failAt   []int // Write numbers that must fail
+	faultErr error
+	latency  time.Duration
+}
+
+// NewMemoryDB returns an empty in-memory database.
+func NewMemoryDB() *MemoryDB {
+	return &MemoryDB{
+		balances:     make(map[string]int64),
+		tableStates:  make(map[string]*TableState),
+		playerStates: make(map[string]map[string]*PlayerState),
+		gcTables:     make(map[string]GCTable),
+		tableAccess:  make(map[string]TableAccess),
+		tableInvites: make(map[string]TableInvite),
+		tableBans:    make(map[string]map[string]TableBan),
+		waitlists:    make(map[string]map[string]WaitlistEntry),
+		faultErr:     ErrInjectedFault,
+	}
+}
+
+// FailNthWrite makes the nth write from now (1-based) fail with err, or with
+// ErrInjectedFault when err is nil. The failed write leaves the stored data
+// untouched. It may be called several times to fail several writes.
+func (m *MemoryDB) FailNthWrite(n int, err error) {
+	m.faultMu.Lock()
+	defer m.faultMu.Unlock()
+
+	if err == nil {
+		err = ErrInjectedFault
+	}
+	m.failAt = append(m.failAt, m.writes+n)
+	m.faultErr = err
+}
+
+// SetLatency delays every subsequent read and write by d.
+func (m *MemoryDB) SetLatency(d time.Duration) {
+	m.faultMu.Lock()
+	defer m.faultMu.Unlock()
+	m.latency = d
+}
+
+// Writes returns the number of writes attempted so far, including failed ones.
+func (m *MemoryDB) Writes() int {
+	m.faultMu.Lock()
+	defer m.faultMu.Unlock()
+	return m.writes
+}
+
+// beforeRead applies the configured latency.
+func (m *MemoryDB) beforeRead() {
+	m.faultMu.Lock()
+	latency := m.latency
+	m.faultMu.Unlock()
+
+	if latency > 0 {
+		time.Sleep(latency)
+	}
+}
+
+// beforeWrite applies the configured latency and counts the write, returning
+// the injected error when this write must fail.
+func (m *MemoryDB) beforeWrite() error {
+	m.faultMu.Lock()
+	m.writes++
+	latency := m.latency
+	var err error
+	for i, n := range m.failAt {
+		if n == m.writes {
+			err = m.faultErr
+			m.failAt = append(m.failAt[:i], m.failAt[i+1:]...)
+			break
+		}
+	}
+	m.faultMu.Unlock()
+
+	if latency > 0 {
+		time.Sleep(latency)
+	}
+	if err != nil {
+		return err
+	}
+
+	m.mu.RLock()
+	closed := m.closed
+	m.mu.RUnlock()
+	if closed {
+		return errMemoryDBClosed
+	}
+	return nil
+}
+
+// GetPlayerBalance returns the current balance of a player
+func (m *MemoryDB) GetPlayerBalance(playerID string) (int64, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	balance, ok := m.balances[playerID]
+	if !ok {
+		return 0, fmt.Errorf("player not found")
+	}
+	return balance, nil
+}
+
+// UpdatePlayerBalance updates a player's balance and records the transaction
+func (m *MemoryDB) UpdatePlayerBalance(playerID string, amount int64, transactionType, description string) error {
+	_, err := m.UpdatePlayerBalanceAudited(playerID, amount, transactionType, description, nil)
+	return err
+}
+
+// UpdatePlayerBalanceAudited updates a player's balance and records the
+// transaction along with its audit record, completed by audit, returning the
+// new balance.
+func (m *MemoryDB) UpdatePlayerBalanceAudited(playerID string, amount int64, transactionType, description string, audit AuditFunc) (int64, error) {
+	if err := m.beforeWrite(); err != nil {
+		return 0, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	balance := m.balances[playerID] + amount
+	record, err := m.auditBalance(audit, playerID, amount, balance, transactionType, description)
+	if err != nil {
+		return 0, err
+	}
+	m.balances[playerID] = balance
+	m.recordTransaction(playerID, amount, transactionType, description)
+	m.appendAudit(record)
+	return balance, nil
+}
+
+// recordTransaction appends a transaction. Callers must hold m.mu.
+func (m *MemoryDB) recordTransaction(playerID string, amount int64, transactionType, description string) {
+	m.nextTxID++
+	m.transactions = append(m.transactions, memTransaction{
+		ID:          m.nextTxID,
+		PlayerID:    playerID,
+		Amount:      amount,
+		Type:        transactionType,
+		Description: description,
+		CreatedAt:   time.Now(),
+	})
+}
+
+// PingContext fails once the database is closed.
+func (m *MemoryDB) PingContext(ctx context.Context) error {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.closed {
+		return errMemoryDBClosed
+	}
+	return ctx.Err()
+}
+
+// Close closes the database. Later writes fail.
+func (m *MemoryDB) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed = true
+	return nil
+}
+
+// jsonString serializes v the way the SQL implementations store JSON fields.
+func jsonString(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// storedTableState returns the copy of tableState kept in memory, with its
+// JSON fields serialized.
+func storedTableState(tableState *TableState, now time.Time) (*TableState, error) {
+	ts := *tableState
+	var err error
+	if ts.CommunityCards, err = jsonString(tableState.CommunityCards); err != nil {
+		return nil, fmt.Errorf("failed to encode community cards: %v", err)
+	}
+	if ts.DeckState, err = jsonString(tableState.DeckState); err != nil {
+		return nil, fmt.Errorf("failed to encode deck state: %v", err)
+	}
+	if ts.PotState, err = jsonString(tableState.PotState); err != nil {
+		return nil, fmt.Errorf("failed to encode pot state: %v", err)
+	}
+	// Like the SQL backends, timing configuration is not persisted.
+	ts.TimeBank = 0
+	ts.AutoStartDelay = 0
+	ts.LastAction = now.Format(time.RFC3339Nano)
+	return &ts, nil
+}
+
+// storedPlayerState returns the copy of playerState kept in memory, with its
+// hand serialized.
+func storedPlayerState(tableID string, playerState *PlayerState, now time.Time) (*PlayerState, error) {
+	ps := *playerState
+	ps.TableID = tableID
+	hand, err := jsonString(playerState.Hand)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode hand: %v", err)
+	}
+	ps.Hand = hand
+	ps.LastAction = now.Format(time.RFC3339Nano)
+	return &ps, nil
+}
+
+// putTableState stores ts, keeping the creation time of an existing row.
+// Callers must hold m.mu.
+func (m *MemoryDB) putTableState(ts *TableState, now time.Time) {
+	if prev, ok := m.tableStates[ts.ID]; ok {
+		ts.CreatedAt = prev.CreatedAt
+	} else {
+		ts.CreatedAt = now.Format(time.RFC3339Nano)
+	}
+	m.tableStates[ts.ID] = ts
+}
+
+// putPlayerState stores ps. Callers must hold m.mu.
+func (m *MemoryDB) putPlayerState(ps *PlayerState) {
+	if m.playerStates[ps.TableID] == nil {
+		m.playerStates[ps.TableID] = make(map[string]*PlayerState)
+	}
+	m.playerStates[ps.TableID][ps.PlayerID] = ps
+}
+
+// SaveTableState saves the table state to the database
+func (m *MemoryDB) SaveTableState(tableState *TableState) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	ts, err := storedTableState(tableState, now)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.putTableState(ts, now)
+	return nil
+}
+
+// SaveSnapshot saves the table state together with all associated player
+// states atomically: either everything is stored or nothing is.
+func (m *MemoryDB) SaveSnapshot(tableState *TableState, playerStates []*PlayerState) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	// Encode everything up front so a failure leaves the store untouched.
+	now := time.Now()
+	ts, err := storedTableState(tableState, now)
+	if err != nil {
+		return err
+	}
+	stored := make([]*PlayerState, 0, len(playerStates))
+	for _, ps := range playerStates {
+		sps, err := storedPlayerState(tableState.ID, ps, now)
+		if err != nil {
+			return err
+		}
+		stored = append(stored, sps)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.putTableState(ts, now)
+	for _, ps := range stored {
+		m.putPlayerState(ps)
+	}
+	return nil
+}
+
+// LoadTableState loads the table state from the database
+func (m *MemoryDB) LoadTableState(tableID string) (*TableState, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ts, ok := m.tableStates[tableID]
+	if !ok {
+		return nil, fmt.Errorf("table state not found")
+	}
+	out := *ts
+	return &out, nil
+}
+
+// DeleteTableState deletes the table state and its player states from the
+// database
+func (m *MemoryDB) DeleteTableState(tableID string) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.tableStates, tableID)
+	delete(m.playerStates, tableID)
+	return nil
+}
+
+// SavePlayerState saves the player state to the database
+func (m *MemoryDB) SavePlayerState(tableID string, playerState *PlayerState) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	ps, err := storedPlayerState(tableID, playerState, time.Now())
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.putPlayerState(ps)
+	return nil
+}
+
+// LoadPlayerStates loads all player states for a table from the database
+func (m *MemoryDB) LoadPlayerStates(tableID string) ([]*PlayerState, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	states := make([]*PlayerState, 0, len(m.playerStates[tableID]))
+	for _, ps := range m.playerStates[tableID] {
+		out := *ps
+		states = append(states, &out)
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].TableSeat < states[j].TableSeat })
+	return states, nil
+}
+
+// DeletePlayerState deletes a player's state from a table
+func (m *MemoryDB) DeletePlayerState(tableID, playerID string) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.playerStates[tableID], playerID)
+	return nil
+}
+
+// GetAllTableIDs returns all table IDs from the database
+func (m *MemoryDB) GetAllTableIDs() ([]string, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids := make([]string, 0, len(m.tableStates))
+	for id := range m.tableStates {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// recordWithdrawalEvent appends to a withdrawal's audit trail. Callers must
+// hold m.mu.
+func (m *MemoryDB) recordWithdrawalEvent(id int64, status, detail string, now time.Time) {
+	m.wdEvents = append(m.wdEvents, WithdrawalEvent{
+		ID:           int64(len(m.wdEvents) + 1),
+		WithdrawalID: id,
+		Status:       status,
+		Detail:       detail,
+		CreatedAt:    formatTime(now),
+	})
+}
+
+// CreateWithdrawal debits the player's balance and records w as a pending
+// withdrawal, returning its ID. Withdrawals created at or after since that
+// were not reversed count towards dailyLimit; a limit of 0 disables it. The
+// debit is audited with audit.
+func (m *MemoryDB) CreateWithdrawal(w *Withdrawal, dailyLimit int64, since time.Time, audit AuditFunc) (int64, error) {
+	if err := m.beforeWrite(); err != nil {
+		return 0, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	balance, ok := m.balances[w.PlayerID]
+	if !ok {
+		return 0, fmt.Errorf("player not found")
+	}
+	if balance < w.Amount {
+		return 0, ErrInsufficientBalance
+	}
+	if dailyLimit > 0 {
+		var withdrawn int64
+		for _, prev := range m.withdrawals {
+			if prev.PlayerID == w.PlayerID && prev.Status != WithdrawalReversed && !prev.created.Before(since) {
+				withdrawn += prev.Amount
+			}
+		}
+		if withdrawn+w.Amount > dailyLimit {
+			return 0, ErrWithdrawalLimit
+		}
+	}
+
+	id := int64(len(m.withdrawals) + 1)
+	description := fmt.Sprintf("withdrawal %d", id)
+	record, err := m.auditBalance(audit, w.PlayerID, -w.Amount, balance-w.Amount, TransactionWithdrawal, description)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	stored := &memWithdrawal{Withdrawal: *w, created: now}
+	stored.ID = id
+	stored.Status = WithdrawalPending
+	stored.CreatedAt = formatTime(now)
+	stored.UpdatedAt = stored.CreatedAt
+	m.withdrawals = append(m.withdrawals, stored)
+
+	m.balances[w.PlayerID] -= w.Amount
+	m.recordTransaction(w.PlayerID, -w.Amount, TransactionWithdrawal, description)
+	m.appendAudit(record)
+	m.recordWithdrawalEvent(stored.ID, WithdrawalPending, w.Detail, now)
+	return stored.ID, nil
+}
+
+// UpdateWithdrawal moves a withdrawal to status and records detail in its
+// audit trail. Reversing a withdrawal credits the amount back, which is
+// audited with audit.
+func (m *MemoryDB) UpdateWithdrawal(id int64, status, detail string, audit AuditFunc) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if id <= 0 || id > int64(len(m.withdrawals)) {
+		return ErrWithdrawalNotFound
+	}
+	w := m.withdrawals[id-1]
+	if err := checkWithdrawalTransition(w.Status, status); err != nil {
+		return err
+	}
+
+	var record *AuditRecord
+	description := fmt.Sprintf("withdrawal %d reversed", id)
+	if status == WithdrawalReversed {
+		var err error
+		record, err = m.auditBalance(audit, w.PlayerID, w.Amount, m.balances[w.PlayerID]+w.Amount,
+			TransactionWithdrawalReversal, description)
+		if err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	w.Status = status
+	w.Detail = detail
+	w.UpdatedAt = formatTime(now)
+	m.recordWithdrawalEvent(id, status, detail, now)
+	if status == WithdrawalReversed {
+		m.balances[w.PlayerID] += w.Amount
+		m.recordTransaction(w.PlayerID, w.Amount, TransactionWithdrawalReversal, description)
+		m.appendAudit(record)
+	}
+	return nil
+}
+
+// GetWithdrawal returns the withdrawal with the given ID.
+func (m *MemoryDB) GetWithdrawal(id int64) (*Withdrawal, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if id <= 0 || id > int64(len(m.withdrawals)) {
+		return nil, ErrWithdrawalNotFound
+	}
+	w := m.withdrawals[id-1].Withdrawal
+	return &w, nil
+}
+
+// GetWithdrawals returns the player's withdrawals, newest first, optionally
+// restricted to one status.
+func (m *MemoryDB) GetWithdrawals(playerID, status string, limit int) ([]*Withdrawal, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var ws []*Withdrawal
+	for i := len(m.withdrawals) - 1; i >= 0; i-- {
+		w := m.withdrawals[i].Withdrawal
+		if w.PlayerID != playerID || (status != "" && w.Status != status) {
+			continue
+		}
+		ws = append(ws, &w)
+		if limit > 0 && len(ws) == limit {
+			break
+		}
+	}
+	return ws, nil
+}
+
+// GetWithdrawalEvents returns a withdrawal's audit trail, oldest first.
+func (m *MemoryDB) GetWithdrawalEvents(id int64) ([]WithdrawalEvent, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var events []WithdrawalEvent
+	for _, e := range m.wdEvents {
+		if e.WithdrawalID == id {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
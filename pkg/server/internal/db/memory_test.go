@@ -0,0 +1,76 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryDBFailNthWrite(t *testing.T) {
+	m := NewMemoryDB()
+	require.NoError(t, m.UpdatePlayerBalance("alice", 1_000, "deposit", "seed"))
+
+	// The second write from now fails and applies nothing.
+	m.FailNthWrite(2, nil)
+	require.NoError(t, m.UpdatePlayerBalance("alice", -100, "table buy-in", "joined table"))
+	err := m.UpdatePlayerBalance("alice", -200, "table buy-in", "joined table")
+	require.ErrorIs(t, err, ErrInjectedFault)
+	require.NoError(t, m.UpdatePlayerBalance("alice", -300, "table buy-in", "joined table"))
+
+	balance, err := m.GetPlayerBalance("alice")
+	require.NoError(t, err)
+	require.Equal(t, int64(600), balance)
+	require.Equal(t, 4, m.Writes())
+}
+
+func TestMemoryDBFailedSnapshotIsAtomic(t *testing.T) {
+	m := NewMemoryDB()
+	ts := &TableState{ID: "table_1", HostID: "alice", Pot: 40, GamePhase: "PRE_FLOP"}
+	players := []*PlayerState{{PlayerID: "alice", Balance: 980}, {PlayerID: "bob", TableSeat: 1, Balance: 980}}
+	require.NoError(t, m.SaveSnapshot(ts, players))
+
+	custom := errors.New("disk full")
+	m.FailNthWrite(1, custom)
+	ts.Pot, ts.GamePhase = 80, "FLOP"
+	players[0].Balance, players[1].Balance = 960, 960
+	players = append(players, &PlayerState{PlayerID: "carol", TableSeat: 2})
+	require.ErrorIs(t, m.SaveSnapshot(ts, players), custom)
+
+	// Neither the table nor any player reflects the failed snapshot.
+	loaded, err := m.LoadTableState("table_1")
+	require.NoError(t, err)
+	require.Equal(t, int64(40), loaded.Pot)
+	require.Equal(t, "PRE_FLOP", loaded.GamePhase)
+	loadedPlayers, err := m.LoadPlayerStates("table_1")
+	require.NoError(t, err)
+	require.Len(t, loadedPlayers, 2)
+	for _, ps := range loadedPlayers {
+		require.Equal(t, int64(980), ps.Balance)
+	}
+
+	// Stored values are copies: mutating the caller's structs or the loaded
+	// ones does not leak into the store.
+	loaded.Pot = 1
+	again, err := m.LoadTableState("table_1")
+	require.NoError(t, err)
+	require.Equal(t, int64(40), again.Pot)
+}
+
+func TestMemoryDBLatency(t *testing.T) {
+	m := NewMemoryDB()
+	m.SetLatency(20 * time.Millisecond)
+
+	start := time.Now()
+	require.NoError(t, m.SaveTableState(&TableState{ID: "table_1"}))
+	_, err := m.LoadTableState("table_1")
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestMemoryDBClosed(t *testing.T) {
+	m := NewMemoryDB()
+	require.NoError(t, m.Close())
+	require.Error(t, m.UpdatePlayerBalance("alice", 1, "deposit", "seed"))
+}
@@ -0,0 +1,372 @@
+package db
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// migrationFS holds the ordered schema migrations, one directory per SQL
+// dialect. Files are named NNNN_description.sql and applied in ascending
+// version order. Both dialects share version numbers.
+//
+//go:embed migrations/sqlite/*.sql migrations/postgres/*.sql
+var migrationFS embed.FS
+
+// dialect captures the SQL differences between the supported backends that
+// matter to the migration runner and to queries shared between backends.
+type dialect struct {
+	dir               string
+	tableExistsQuery  string
+	columnExistsQuery string
+	recordQuery       string
+	numberedParams    bool // Bind parameters are $1, $2, ... rather than ?
+}
+
+var (
+	sqliteDialect = dialect{
+		dir:               "migrations/sqlite",
+		tableExistsQuery:  "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?",
+		columnExistsQuery: "SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?",
+		recordQuery:       "INSERT INTO schema_version (version, name) VALUES (?, ?)",
+	}
+	postgresDialect = dialect{
+		dir:               "migrations/postgres",
+		tableExistsQuery:  "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = $1",
+		columnExistsQuery: "SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2",
+		recordQuery:       "INSERT INTO schema_version (version, name) VALUES ($1, $2)",
+		numberedParams:    true,
+	}
+)
+
+// rebind rewrites the ? bind parameters of query into the dialect's syntax.
+// Queries passed to it must not contain literal question marks.
+func (d dialect) rebind(query string) string {
+	if !d.numberedParams {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			fmt.Fprintf(&b, "$%d", n)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// timeArg converts t to a value comparable with the dialect's timestamp
+// columns. SQLite stores CURRENT_TIMESTAMP as text, compared lexically.
+func (d dialect) timeArg(t time.Time) interface{} {
+	if d.numberedParams {
+		return t
+	}
+	return t.UTC().Format(sqliteTimeFormat)
+}
+
+// legacyColumns maps the migrations that only add a column to that column.
+// Databases written before schema versioning added such columns on startup,
+// so they may already have one without having recorded its migration.
+var legacyColumns = map[int]struct{ table, column string }{
+	2: {"table_states", "pot_state"},
+}
+
+// Migration is a single up migration of the database schema.
+type Migration struct {
+	Version int
+	Name    string
+	SQL     string
+}
+
+// MigrationResult summarizes an upgrade of the database schema.
+type MigrationResult struct {
+	FromVersion int
+	ToVersion   int
+	Applied     []Migration
+	BackupPath  string // Empty when no backup was needed
+}
+
+// Migrations returns all embedded SQLite migrations sorted by version.
+func Migrations() ([]Migration, error) {
+	return sqliteDialect.migrations()
+}
+
+// PostgresMigrations returns all embedded PostgreSQL migrations sorted by
+// version.
+func PostgresMigrations() ([]Migration, error) {
+	return postgresDialect.migrations()
+}
+
+// migrations loads the dialect's embedded migrations sorted by version.
+func (d dialect) migrations() ([]Migration, error) {
+	entries, err := migrationFS.ReadDir(d.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %v", err)
+	}
+
+	migrations := make([]Migration, 0, len(entries))
+	seen := make(map[int]string, len(entries))
+	for _, entry := range entries {
+		fileName := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(fileName, ".sql") {
+			continue
+		}
+
+		base := strings.TrimSuffix(fileName, ".sql")
+		versionStr, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file name: %s", fileName)
+		}
+		version, err := strconv.Atoi(versionStr)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %s", fileName)
+		}
+		if prev, dup := seen[version]; dup {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", version, prev, fileName)
+		}
+		seen[version] = fileName
+
+		data, err := migrationFS.ReadFile(path.Join(d.dir, fileName))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %v", fileName, err)
+		}
+
+		migrations = append(migrations, Migration{
+			Version: version,
+			Name:    name,
+			SQL:     string(data),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// ensureSchemaVersionTable creates the schema_version bookkeeping table.
+func ensureSchemaVersionTable(db *sql.DB) error {
+	// Valid for both dialects.
+	_, err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS schema_version (
+			version INTEGER PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	return err
+}
+
+// schemaVersion returns the highest applied migration version, or 0 for a
+// database that predates versioning (or is empty).
+func (d dialect) schemaVersion(db *sql.DB) (int, error) {
+	exists, err := d.tableExists(db, "schema_version")
+	if err != nil || !exists {
+		return 0, err
+	}
+
+	var version sql.NullInt64
+	if err := db.QueryRow("SELECT MAX(version) FROM schema_version").Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %v", err)
+	}
+	return int(version.Int64), nil
+}
+
+// tableExists reports whether the named table exists.
+func (d dialect) tableExists(db *sql.DB, name string) (bool, error) {
+	var count int
+	err := db.QueryRow(d.tableExistsQuery, name).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// columnExists reports whether the named table has the named column.
+func (d dialect) columnExists(tx *sql.Tx, table, column string) (bool, error) {
+	var count int
+	err := tx.QueryRow(d.columnExistsQuery, table, column).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// schemaVersion returns the highest applied SQLite migration version.
+func schemaVersion(db *sql.DB) (int, error) {
+	return sqliteDialect.schemaVersion(db)
+}
+
+// tableExists reports whether the named SQLite table exists.
+func tableExists(db *sql.DB, name string) (bool, error) {
+	return sqliteDialect.tableExists(db, name)
+}
+
+// hasUserTables reports whether the database holds any tables, i.e. whether
+// it is an existing database rather than a freshly created file.
+func hasUserTables(db *sql.DB) (bool, error) {
+	var count int
+	err := db.QueryRow(
+		"SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'",
+	).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// pendingMigrations returns the current schema version and the migrations
+// that still need to be applied.
+func (d dialect) pendingMigrations(db *sql.DB) (int, []Migration, error) {
+	all, err := d.migrations()
+	if err != nil {
+		return 0, nil, err
+	}
+	current, err := d.schemaVersion(db)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var pending []Migration
+	for _, m := range all {
+		if m.Version > current {
+			pending = append(pending, m)
+		}
+	}
+	return current, pending, nil
+}
+
+// backupDatabase writes a consistent copy of the database next to the
+// original file. In-memory databases are not backed up.
+func backupDatabase(db *sql.DB, dbPath string, fromVersion int) (string, error) {
+	if dbPath == "" || strings.Contains(dbPath, ":memory:") {
+		return "", nil
+	}
+	if _, err := os.Stat(dbPath); err != nil {
+		return "", nil
+	}
+
+	backupPath := fmt.Sprintf("%s.v%d-%s.bak", dbPath, fromVersion,
+		time.Now().UTC().Format("20060102T150405.000000000"))
+	if _, err := db.Exec("VACUUM INTO ?", backupPath); err != nil {
+		return "", fmt.Errorf("failed to back up database to %s: %v", backupPath, err)
+	}
+	return backupPath, nil
+}
+
+// applyMigration runs a single migration and records it in schema_version
+// within one transaction. A migration adding a column the database already
+// has (see legacyColumns) is only recorded.
+func (d dialect) applyMigration(db *sql.DB, m Migration) (err error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	present := false
+	if col, ok := legacyColumns[m.Version]; ok {
+		present, err = d.columnExists(tx, col.table, col.column)
+		if err != nil {
+			return fmt.Errorf("migration %04d_%s failed: %v", m.Version, m.Name, err)
+		}
+	}
+	if !present {
+		if _, err = tx.Exec(m.SQL); err != nil {
+			return fmt.Errorf("migration %04d_%s failed: %v", m.Version, m.Name, err)
+		}
+	}
+	if _, err = tx.Exec(d.recordQuery, m.Version, m.Name); err != nil {
+		return fmt.Errorf("failed to record migration %04d_%s: %v", m.Version, m.Name, err)
+	}
+	return tx.Commit()
+}
+
+// applyPending applies the given migrations in order, recording progress in
+// result.
+func (d dialect) applyPending(db *sql.DB, pending []Migration, result *MigrationResult) error {
+	if err := ensureSchemaVersionTable(db); err != nil {
+		return fmt.Errorf("failed to create schema_version table: %v", err)
+	}
+	for _, m := range pending {
+		if err := d.applyMigration(db, m); err != nil {
+			return err
+		}
+		result.Applied = append(result.Applied, m)
+		result.ToVersion = m.Version
+	}
+	return nil
+}
+
+// upgrade applies all pending SQLite migrations. Existing databases are
+// backed up before the first migration runs.
+func upgrade(db *sql.DB, dbPath string) (*MigrationResult, error) {
+	current, pending, err := sqliteDialect.pendingMigrations(db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MigrationResult{FromVersion: current, ToVersion: current}
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	existing, err := hasUserTables(db)
+	if err != nil {
+		return nil, err
+	}
+	if existing {
+		if result.BackupPath, err = backupDatabase(db, dbPath, current); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := sqliteDialect.applyPending(db, pending, result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// PendingMigrations opens the database at dbPath without modifying it and
+// returns its current schema version along with the migrations that would be
+// applied on the next upgrade.
+func PendingMigrations(dbPath string) (int, []Migration, error) {
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		// A missing database would be created from scratch.
+		all, err := Migrations()
+		return 0, all, err
+	}
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer db.Close()
+
+	return sqliteDialect.pendingMigrations(db)
+}
+
+// Migrate upgrades the database at dbPath to the latest schema version,
+// backing it up first when it already holds data.
+func Migrate(dbPath string) (*MigrationResult, error) {
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	return upgrade(db, dbPath)
+}
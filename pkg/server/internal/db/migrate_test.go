@@ -0,0 +1,184 @@
+package db
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationsOrdered(t *testing.T) {
+	migrations, err := Migrations()
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	for i, m := range migrations {
+		require.Equal(t, i+1, m.Version, "migrations must be contiguous starting at 1")
+		require.NotEmpty(t, m.Name)
+		require.NotEmpty(t, m.SQL)
+	}
+}
+
+func TestNewDBFreshDatabase(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "poker.db")
+
+	database, err := NewDB(dbPath)
+	require.NoError(t, err)
+	defer database.Close()
+
+	all, err := Migrations()
+	require.NoError(t, err)
+	version, err := schemaVersion(database.DB)
+	require.NoError(t, err)
+	require.Equal(t, all[len(all)-1].Version, version)
+
+	// Fresh databases have nothing worth backing up.
+	backups, err := filepath.Glob(dbPath + ".v*.bak")
+	require.NoError(t, err)
+	require.Empty(t, backups)
+}
+
+func TestNewDBUpgradesLegacyDatabase(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "poker.db")
+
+	// Build a pre-versioning database: the initial schema without a
+	// schema_version table, holding some data.
+	all, err := Migrations()
+	require.NoError(t, err)
+	legacy, err := sql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	_, err = legacy.Exec(all[0].SQL)
+	require.NoError(t, err)
+	_, err = legacy.Exec("INSERT INTO players (id, name, balance) VALUES ('alice', 'alice', 500)")
+	require.NoError(t, err)
+	require.NoError(t, legacy.Close())
+
+	// An unversioned database reports every migration as pending (the
+	// initial schema is idempotent) and a dry run leaves it untouched.
+	current, pending, err := PendingMigrations(dbPath)
+	require.NoError(t, err)
+	require.Equal(t, 0, current)
+	require.Len(t, pending, len(all))
+	legacy, err = sql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	exists, err := tableExists(legacy, "schema_version")
+	require.NoError(t, err)
+	require.False(t, exists)
+	require.NoError(t, legacy.Close())
+
+	database, err := NewDB(dbPath)
+	require.NoError(t, err)
+	defer database.Close()
+
+	version, err := schemaVersion(database.DB)
+	require.NoError(t, err)
+	require.Equal(t, all[len(all)-1].Version, version)
+
+	balance, err := database.GetPlayerBalance("alice")
+	require.NoError(t, err)
+	require.Equal(t, int64(500), balance)
+
+	// Columns added by later migrations are usable.
+	require.NoError(t, database.SaveTableState(&TableState{ID: "t1", HostID: "alice", PotState: map[string]int{"num_players": 2}}))
+	ts, err := database.LoadTableState("t1")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"num_players":2}`, ts.PotState.(string))
+
+	// A backup of the legacy database was taken before upgrading.
+	backups, err := filepath.Glob(dbPath + ".v0-*.bak")
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	backup, err := sql.Open("sqlite3", backups[0])
+	require.NoError(t, err)
+	defer backup.Close()
+	var backupBalance int64
+	require.NoError(t, backup.QueryRow("SELECT balance FROM players WHERE id = 'alice'").Scan(&backupBalance))
+	require.Equal(t, int64(500), backupBalance)
+	exists, err = tableExists(backup, "schema_version")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// Reopening does not migrate (or back up) again.
+	require.NoError(t, database.Close())
+	database, err = NewDB(dbPath)
+	require.NoError(t, err)
+	defer database.Close()
+	backups, err = filepath.Glob(dbPath + ".v*.bak")
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+}
+
+func TestNewDBUpgradesDatabaseWithPotState(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "poker.db")
+
+	// Databases from before versioning may already have the pot_state
+	// column, added on startup, without a schema_version table.
+	all, err := Migrations()
+	require.NoError(t, err)
+	legacy, err := sql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	_, err = legacy.Exec(all[0].SQL)
+	require.NoError(t, err)
+	_, err = legacy.Exec("ALTER TABLE table_states ADD COLUMN pot_state TEXT DEFAULT ''")
+	require.NoError(t, err)
+	_, err = legacy.Exec(`INSERT INTO table_states (id, host_id, buy_in, min_players, max_players,
+		small_blind, big_blind, min_balance, starting_chips, pot_state)
+		VALUES ('t1', 'alice', 0, 2, 6, 10, 20, 0, 1000, '{"num_players":2}')`)
+	require.NoError(t, err)
+	require.NoError(t, legacy.Close())
+
+	database, err := NewDB(dbPath)
+	require.NoError(t, err)
+	defer database.Close()
+
+	version, err := schemaVersion(database.DB)
+	require.NoError(t, err)
+	require.Equal(t, all[len(all)-1].Version, version)
+
+	// 0002 is recorded as applied and the existing pot state is kept.
+	var recorded int
+	require.NoError(t, database.DB.QueryRow("SELECT COUNT(*) FROM schema_version WHERE version = 2").Scan(&recorded))
+	require.Equal(t, 1, recorded)
+	ts, err := database.LoadTableState("t1")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"num_players":2}`, ts.PotState.(string))
+}
+
+func TestNewDBRepairsSwappedTransactions(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "poker.db")
+
+	// UpdateBalance and ProcessTip used to store the caller's text as the
+	// type and their own label as the description.
+	all, err := Migrations()
+	require.NoError(t, err)
+	legacy, err := sql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	_, err = legacy.Exec(all[0].SQL)
+	require.NoError(t, err)
+	_, err = legacy.Exec(`INSERT INTO transactions (player_id, amount, type, description) VALUES
+		('alice', 500, 'promo credit', 'balance update'),
+		('alice', -50, 'gg', 'tip sent'),
+		('alice', 20, '', 'tip received'),
+		('alice', -100, 'table buy-in', 'joined table'),
+		('alice', -10, 'tip sent', 'tip sent')`)
+	require.NoError(t, err)
+	require.NoError(t, legacy.Close())
+
+	database, err := NewDB(dbPath)
+	require.NoError(t, err)
+	defer database.Close()
+
+	txs, err := database.GetTransactions(TransactionFilter{PlayerID: "alice"})
+	require.NoError(t, err)
+	got := make([][2]string, len(txs))
+	for i, tx := range txs {
+		got[i] = [2]string{tx.Type, tx.Description}
+	}
+	require.Equal(t, [][2]string{
+		{"tip sent", "tip sent"}, // Already in the current order
+		{"table buy-in", "joined table"},
+		{"tip received", ""},
+		{"tip sent", "gg"},
+		{"balance update", "promo credit"},
+	}, got)
+}
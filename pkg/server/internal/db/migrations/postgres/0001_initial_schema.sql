@@ -0,0 +1,65 @@
+-- Initial schema: players, their balance transactions and persisted table
+-- and player state.
+
+CREATE TABLE IF NOT EXISTS players (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	balance BIGINT NOT NULL DEFAULT 0,
+	created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS transactions (
+	id BIGSERIAL PRIMARY KEY,
+	player_id TEXT NOT NULL REFERENCES players(id),
+	amount BIGINT NOT NULL,
+	type TEXT NOT NULL,
+	description TEXT,
+	created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS transactions_player_id_idx ON transactions (player_id);
+
+CREATE TABLE IF NOT EXISTS table_states (
+	id TEXT PRIMARY KEY,
+	host_id TEXT NOT NULL,
+	buy_in BIGINT NOT NULL,
+	min_players INTEGER NOT NULL,
+	max_players INTEGER NOT NULL,
+	small_blind BIGINT NOT NULL,
+	big_blind BIGINT NOT NULL,
+	min_balance BIGINT NOT NULL,
+	starting_chips BIGINT NOT NULL,
+	game_started BOOLEAN NOT NULL DEFAULT FALSE,
+	game_phase TEXT NOT NULL DEFAULT 'WAITING',
+	dealer INTEGER DEFAULT -1,
+	current_player INTEGER DEFAULT -1,
+	current_bet BIGINT DEFAULT 0,
+	pot BIGINT DEFAULT 0,
+	round_num INTEGER DEFAULT 0,
+	bet_round INTEGER DEFAULT 0,
+	community_cards TEXT DEFAULT '[]',
+	deck_state TEXT DEFAULT '[]',
+	created_at TIMESTAMPTZ DEFAULT now(),
+	last_action TIMESTAMPTZ DEFAULT now()
+);
+
+-- Player rows may be written before the first snapshot of their table, so
+-- there is no foreign key to table_states; DeleteTableState removes them.
+CREATE TABLE IF NOT EXISTS player_states (
+	player_id TEXT NOT NULL,
+	table_id TEXT NOT NULL,
+	table_seat INTEGER NOT NULL,
+	is_ready BOOLEAN NOT NULL DEFAULT FALSE,
+	balance BIGINT NOT NULL DEFAULT 0,
+	starting_balance BIGINT NOT NULL DEFAULT 0,
+	has_bet BIGINT NOT NULL DEFAULT 0,
+	has_folded BOOLEAN NOT NULL DEFAULT FALSE,
+	is_all_in BOOLEAN NOT NULL DEFAULT FALSE,
+	is_dealer BOOLEAN NOT NULL DEFAULT FALSE,
+	is_turn BOOLEAN NOT NULL DEFAULT FALSE,
+	game_state TEXT NOT NULL DEFAULT 'AT_TABLE',
+	hand TEXT DEFAULT '[]',
+	hand_description TEXT DEFAULT '',
+	last_action TIMESTAMPTZ DEFAULT now(),
+	PRIMARY KEY (player_id, table_id)
+);
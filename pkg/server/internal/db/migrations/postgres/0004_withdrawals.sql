@@ -0,0 +1,28 @@
+-- Withdrawals pay player balances back out. Each withdrawal debits the
+-- balance up front and moves through pending -> sent -> confirmed, or ends
+-- reversed with the amount credited back. withdrawal_events keeps the audit
+-- trail of every status change.
+
+CREATE TABLE IF NOT EXISTS withdrawals (
+	id BIGSERIAL PRIMARY KEY,
+	player_id TEXT NOT NULL REFERENCES players(id),
+	amount BIGINT NOT NULL,
+	method TEXT NOT NULL,
+	destination TEXT NOT NULL DEFAULT '',
+	status TEXT NOT NULL,
+	detail TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ DEFAULT now(),
+	updated_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS withdrawals_player_id_idx ON withdrawals (player_id, id);
+
+CREATE TABLE IF NOT EXISTS withdrawal_events (
+	id BIGSERIAL PRIMARY KEY,
+	withdrawal_id BIGINT NOT NULL REFERENCES withdrawals(id),
+	status TEXT NOT NULL,
+	detail TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS withdrawal_events_withdrawal_id_idx ON withdrawal_events (withdrawal_id, id);
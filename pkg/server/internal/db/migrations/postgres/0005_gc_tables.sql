@@ -0,0 +1,11 @@
+-- gc_tables binds poker tables to Bison Relay group chats, where the bot
+-- posts the tables' public events.
+
+CREATE TABLE IF NOT EXISTS gc_tables (
+	table_id TEXT PRIMARY KEY,
+	gc_id TEXT NOT NULL,
+	bound_by TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS gc_tables_gc_id_idx ON gc_tables (gc_id);
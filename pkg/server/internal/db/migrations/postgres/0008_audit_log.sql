@@ -0,0 +1,28 @@
+-- audit_log is the append-only, hash-chained record of every money and game
+-- affecting action. Each record hashes the one before it, so a changed or
+-- removed record breaks the chain. Updates and deletes are refused.
+
+CREATE TABLE IF NOT EXISTS audit_log (
+	seq BIGINT PRIMARY KEY,
+	created_at_ns BIGINT NOT NULL,
+	actor TEXT NOT NULL DEFAULT '',
+	player_id TEXT NOT NULL DEFAULT '',
+	table_id TEXT NOT NULL DEFAULT '',
+	hand BIGINT NOT NULL DEFAULT 0,
+	action TEXT NOT NULL,
+	amount BIGINT NOT NULL DEFAULT 0,
+	balance BIGINT NOT NULL DEFAULT 0,
+	detail TEXT NOT NULL DEFAULT '',
+	prev_hash TEXT NOT NULL,
+	hash TEXT NOT NULL
+);
+
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_log_append_only ON audit_log;
+CREATE TRIGGER audit_log_append_only BEFORE UPDATE OR DELETE ON audit_log
+	FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
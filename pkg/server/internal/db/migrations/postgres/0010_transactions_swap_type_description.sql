@@ -0,0 +1,13 @@
+-- Before GetTransactions existed, UpdateBalance and ProcessTip stored the
+-- caller's text as the transaction type and their own label ('balance update',
+-- 'tip sent', 'tip received') as the description. Swap those rows back so the
+-- history shows them like the ones recorded since. Rows whose type already is
+-- a known transaction type were written in the current order and are left
+-- alone.
+
+UPDATE transactions
+SET type = description, description = type
+WHERE description IN ('balance update', 'tip sent', 'tip received')
+	AND type NOT IN ('deposit', 'tip', 'table buy-in', 'table refund', 'tip sent',
+		'tip received', 'balance update', 'admin adjustment', 'withdrawal',
+		'withdrawal reversal');
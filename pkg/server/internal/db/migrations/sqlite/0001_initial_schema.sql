@@ -0,0 +1,63 @@
+-- Initial schema: players, their balance transactions and persisted table
+-- and player state.
+
+CREATE TABLE IF NOT EXISTS players (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	balance INTEGER NOT NULL DEFAULT 0,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS transactions (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	player_id TEXT NOT NULL,
+	amount INTEGER NOT NULL,
+	type TEXT NOT NULL,
+	description TEXT,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (player_id) REFERENCES players(id)
+);
+
+CREATE TABLE IF NOT EXISTS table_states (
+	id TEXT PRIMARY KEY,
+	host_id TEXT NOT NULL,
+	buy_in INTEGER NOT NULL,
+	min_players INTEGER NOT NULL,
+	max_players INTEGER NOT NULL,
+	small_blind INTEGER NOT NULL,
+	big_blind INTEGER NOT NULL,
+	min_balance INTEGER NOT NULL,
+	starting_chips INTEGER NOT NULL,
+	game_started BOOLEAN NOT NULL DEFAULT FALSE,
+	game_phase TEXT NOT NULL DEFAULT 'WAITING',
+	dealer INTEGER DEFAULT -1,
+	current_player INTEGER DEFAULT -1,
+	current_bet INTEGER DEFAULT 0,
+	pot INTEGER DEFAULT 0,
+	round_num INTEGER DEFAULT 0,
+	bet_round INTEGER DEFAULT 0,
+	community_cards TEXT DEFAULT '[]',
+	deck_state TEXT DEFAULT '[]',
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	last_action TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS player_states (
+	player_id TEXT NOT NULL,
+	table_id TEXT NOT NULL,
+	table_seat INTEGER NOT NULL,
+	is_ready BOOLEAN NOT NULL DEFAULT FALSE,
+	balance INTEGER NOT NULL DEFAULT 0,
+	starting_balance INTEGER NOT NULL DEFAULT 0,
+	has_bet INTEGER NOT NULL DEFAULT 0,
+	has_folded BOOLEAN NOT NULL DEFAULT FALSE,
+	is_all_in BOOLEAN NOT NULL DEFAULT FALSE,
+	is_dealer BOOLEAN NOT NULL DEFAULT FALSE,
+	is_turn BOOLEAN NOT NULL DEFAULT FALSE,
+	game_state TEXT NOT NULL DEFAULT 'AT_TABLE',
+	hand TEXT DEFAULT '[]',
+	hand_description TEXT DEFAULT '',
+	last_action TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (player_id, table_id),
+	FOREIGN KEY (table_id) REFERENCES table_states(id) ON DELETE CASCADE
+);
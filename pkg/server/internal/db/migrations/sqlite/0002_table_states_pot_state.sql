@@ -0,0 +1,4 @@
+-- Persist the side-pot structure and per-player contributions of the hand in
+-- progress so it can be settled exactly after a restart.
+
+ALTER TABLE table_states ADD COLUMN pot_state TEXT DEFAULT '';
@@ -0,0 +1,4 @@
+-- Index transactions for per-player history queries, which page through a
+-- player's transactions by descending id.
+
+CREATE INDEX IF NOT EXISTS transactions_player_id_id_idx ON transactions (player_id, id);
@@ -0,0 +1,30 @@
+-- Withdrawals pay player balances back out. Each withdrawal debits the
+-- balance up front and moves through pending -> sent -> confirmed, or ends
+-- reversed with the amount credited back. withdrawal_events keeps the audit
+-- trail of every status change.
+
+CREATE TABLE IF NOT EXISTS withdrawals (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	player_id TEXT NOT NULL,
+	amount INTEGER NOT NULL,
+	method TEXT NOT NULL,
+	destination TEXT NOT NULL DEFAULT '',
+	status TEXT NOT NULL,
+	detail TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (player_id) REFERENCES players(id)
+);
+
+CREATE INDEX IF NOT EXISTS withdrawals_player_id_idx ON withdrawals (player_id, id);
+
+CREATE TABLE IF NOT EXISTS withdrawal_events (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	withdrawal_id INTEGER NOT NULL,
+	status TEXT NOT NULL,
+	detail TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (withdrawal_id) REFERENCES withdrawals(id)
+);
+
+CREATE INDEX IF NOT EXISTS withdrawal_events_withdrawal_id_idx ON withdrawal_events (withdrawal_id, id);
@@ -0,0 +1,25 @@
+-- table_access restricts who can see and join a table: private tables are
+-- hidden from the lobby and joined by invitation, and tables with a password
+-- require it to join. Tables without a row are public.
+
+CREATE TABLE IF NOT EXISTS table_access (
+	table_id TEXT PRIMARY KEY,
+	private BOOLEAN NOT NULL DEFAULT FALSE,
+	password_salt TEXT NOT NULL DEFAULT '',
+	password_hash TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- table_invites holds the invite codes issued by table hosts. An invite for
+-- a player also puts them on the table's invite list.
+
+CREATE TABLE IF NOT EXISTS table_invites (
+	code TEXT PRIMARY KEY,
+	table_id TEXT NOT NULL,
+	invitee_id TEXT NOT NULL DEFAULT '',
+	created_by TEXT NOT NULL DEFAULT '',
+	expires_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS table_invites_table_id_idx ON table_invites (table_id);
@@ -0,0 +1,11 @@
+-- table_bans lists the players a table host has barred from rejoining the
+-- table. Bans are dropped when the table closes.
+
+CREATE TABLE IF NOT EXISTS table_bans (
+	table_id TEXT NOT NULL,
+	player_id TEXT NOT NULL,
+	banned_by TEXT NOT NULL DEFAULT '',
+	reason TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (table_id, player_id)
+);
@@ -0,0 +1,28 @@
+-- audit_log is the append-only, hash-chained record of every money and game
+-- affecting action. Each record hashes the one before it, so a changed or
+-- removed record breaks the chain. Updates and deletes are refused.
+
+CREATE TABLE IF NOT EXISTS audit_log (
+	seq INTEGER PRIMARY KEY,
+	created_at_ns INTEGER NOT NULL,
+	actor TEXT NOT NULL DEFAULT '',
+	player_id TEXT NOT NULL DEFAULT '',
+	table_id TEXT NOT NULL DEFAULT '',
+	hand INTEGER NOT NULL DEFAULT 0,
+	action TEXT NOT NULL,
+	amount INTEGER NOT NULL DEFAULT 0,
+	balance INTEGER NOT NULL DEFAULT 0,
+	detail TEXT NOT NULL DEFAULT '',
+	prev_hash TEXT NOT NULL,
+	hash TEXT NOT NULL
+);
+
+CREATE TRIGGER IF NOT EXISTS audit_log_no_update BEFORE UPDATE ON audit_log
+BEGIN
+	SELECT RAISE(ABORT, 'audit_log is append-only');
+END;
+
+CREATE TRIGGER IF NOT EXISTS audit_log_no_delete BEFORE DELETE ON audit_log
+BEGIN
+	SELECT RAISE(ABORT, 'audit_log is append-only');
+END;
@@ -0,0 +1,10 @@
+-- table_waitlist holds the players waiting for a seat at a full table, in
+-- the order they joined the list. Entries are dropped when the player sits
+-- down, leaves the list or lets a seat offer lapse, and when the table closes.
+
+CREATE TABLE IF NOT EXISTS table_waitlist (
+	table_id TEXT NOT NULL,
+	player_id TEXT NOT NULL,
+	joined_at_ns BIGINT NOT NULL,
+	PRIMARY KEY (table_id, player_id)
+);
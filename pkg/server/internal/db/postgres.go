@@ -0,0 +1,401 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	_ "github.com/lib/pq" // PostgreSQL driver
+)
+
+// migrationLockKey is the advisory lock taken while migrating a PostgreSQL
+// database so that several servers sharing it do not race each other.
+const migrationLockKey = 0x706f6b6572 // "poker"
+
+// PostgresDB is a PostgreSQL backed implementation of the server database. It
+// lets the bot and several game servers share one database.
+type PostgresDB struct {
+	*sql.DB
+}
+
+// NewPostgresDB connects to the PostgreSQL database described by dsn and
+// brings its schema up to date.
+func NewPostgresDB(dsn string) (*PostgresDB, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to postgres: %v", err)
+	}
+
+	if _, err := upgradePostgres(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &PostgresDB{db}, nil
+}
+
+// upgradePostgres applies all pending PostgreSQL migrations while holding
+// the migration advisory lock. Operators are expected to back up PostgreSQL
+// with their own tooling (e.g. pg_dump), so no backup is taken here.
+func upgradePostgres(db *sql.DB) (*MigrationResult, error) {
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockKey); err != nil {
+		return nil, fmt.Errorf("failed to acquire migration lock: %v", err)
+	}
+	defer conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", migrationLockKey)
+
+	// Read the version only once the lock is held, another server may have
+	// just finished migrating.
+	current, pending, err := postgresDialect.pendingMigrations(db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MigrationResult{FromVersion: current, ToVersion: current}
+	if err := postgresDialect.applyPending(db, pending, result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// PendingPostgresMigrations returns the current schema version of the
+// PostgreSQL database described by dsn and the migrations that would be
+// applied on the next upgrade. The database is not modified.
+func PendingPostgresMigrations(dsn string) (int, []Migration, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer db.Close()
+
+	return postgresDialect.pendingMigrations(db)
+}
+
+// MigratePostgres upgrades the PostgreSQL database described by dsn to the
+// latest schema version.
+func MigratePostgres(dsn string) (*MigrationResult, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	return upgradePostgres(db)
+}
+
+// GetPlayerBalance returns the current balance of a player
+func (db *PostgresDB) GetPlayerBalance(playerID string) (int64, error) {
+	var balance int64
+	err := db.QueryRow("SELECT balance FROM players WHERE id = $1", playerID).Scan(&balance)
+	if err == sql.ErrNoRows {
+		return 0, fmt.Errorf("player not found")
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get player balance: %v", err)
+	}
+	return balance, nil
+}
+
+// UpdatePlayerBalance updates a player's balance and records the transaction
+func (db *PostgresDB) UpdatePlayerBalance(playerID string, amount int64, transactionType, description string) error {
+	_, err := db.UpdatePlayerBalanceAudited(playerID, amount, transactionType, description, nil)
+	return err
+}
+
+// UpdatePlayerBalanceAudited updates a player's balance and records the
+// transaction along with its audit record, completed by audit, returning the
+// new balance.
+func (db *PostgresDB) UpdatePlayerBalanceAudited(playerID string, amount int64, transactionType, description string, audit AuditFunc) (int64, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// Update player balance
+	var balance int64
+	err = tx.QueryRow(`
+		INSERT INTO players (id, name, balance)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (id) DO UPDATE SET balance = players.balance + EXCLUDED.balance
+		RETURNING balance
+	`, playerID, playerID, amount).Scan(&balance)
+	if err != nil {
+		return 0, err
+	}
+
+	// Record transaction
+	_, err = tx.Exec(`
+		INSERT INTO transactions (player_id, amount, type, description)
+		VALUES ($1, $2, $3, $4)
+	`, playerID, amount, transactionType, description)
+	if err != nil {
+		return 0, err
+	}
+
+	err = postgresDialect.auditBalance(tx, audit, playerID, amount, balance, transactionType, description)
+	if err != nil {
+		return 0, err
+	}
+	return balance, tx.Commit()
+}
+
+// Close closes the database connection
+func (db *PostgresDB) Close() error {
+	return db.DB.Close()
+}
+
+// pgUpsertTableState inserts or updates a table_states row. created_at is
+// kept from the original insert.
+const pgUpsertTableState = `
+	INSERT INTO table_states (
+		id, host_id, buy_in, min_players, max_players, small_blind, big_blind,
+		min_balance, starting_chips, game_started, game_phase, dealer,
+		current_player, current_bet, pot, round_num, bet_round,
+		community_cards, deck_state, pot_state, last_action
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
+	ON CONFLICT (id) DO UPDATE SET
+		host_id         = EXCLUDED.host_id,
+		buy_in          = EXCLUDED.buy_in,
+		min_players     = EXCLUDED.min_players,
+		max_players     = EXCLUDED.max_players,
+		small_blind     = EXCLUDED.small_blind,
+		big_blind       = EXCLUDED.big_blind,
+		min_balance     = EXCLUDED.min_balance,
+		starting_chips  = EXCLUDED.starting_chips,
+		game_started    = EXCLUDED.game_started,
+		game_phase      = EXCLUDED.game_phase,
+		dealer          = EXCLUDED.dealer,
+		current_player  = EXCLUDED.current_player,
+		current_bet     = EXCLUDED.current_bet,
+		pot             = EXCLUDED.pot,
+		round_num       = EXCLUDED.round_num,
+		bet_round       = EXCLUDED.bet_round,
+		community_cards = EXCLUDED.community_cards,
+		deck_state      = EXCLUDED.deck_state,
+		pot_state       = EXCLUDED.pot_state,
+		last_action     = EXCLUDED.last_action
+`
+
+// pgUpsertPlayerState inserts or updates a player_states row.
+const pgUpsertPlayerState = `
+	INSERT INTO player_states (
+		player_id, table_id, table_seat, is_ready,
+		balance, starting_balance, has_bet, has_folded, is_all_in,
+		is_dealer, is_turn, game_state, hand, hand_description, last_action
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	ON CONFLICT (player_id, table_id) DO UPDATE SET
+		table_seat       = EXCLUDED.table_seat,
+		is_ready         = EXCLUDED.is_ready,
+		balance          = EXCLUDED.balance,
+		starting_balance = EXCLUDED.starting_balance,
+		has_bet          = EXCLUDED.has_bet,
+		has_folded       = EXCLUDED.has_folded,
+		is_all_in        = EXCLUDED.is_all_in,
+		is_dealer        = EXCLUDED.is_dealer,
+		is_turn          = EXCLUDED.is_turn,
+		game_state       = EXCLUDED.game_state,
+		hand             = EXCLUDED.hand,
+		hand_description = EXCLUDED.hand_description,
+		last_action      = EXCLUDED.last_action
+`
+
+// tableStateArgs returns the pgUpsertTableState arguments for tableState.
+func tableStateArgs(tableState *TableState) []interface{} {
+	communityCardsJSON, _ := json.Marshal(tableState.CommunityCards)
+	deckStateJSON, _ := json.Marshal(tableState.DeckState)
+	potStateJSON, _ := json.Marshal(tableState.PotState)
+
+	return []interface{}{
+		tableState.ID, tableState.HostID, tableState.BuyIn, tableState.MinPlayers, tableState.MaxPlayers,
+		tableState.SmallBlind, tableState.BigBlind, tableState.MinBalance, tableState.StartingChips,
+		tableState.GameStarted, tableState.GamePhase, tableState.Dealer, tableState.CurrentPlayer,
+		tableState.CurrentBet, tableState.Pot, tableState.Round, tableState.BetRound,
+		string(communityCardsJSON), string(deckStateJSON), string(potStateJSON), time.Now(),
+	}
+}
+
+// playerStateArgs returns the pgUpsertPlayerState arguments for playerState.
+func playerStateArgs(tableID string, playerState *PlayerState) []interface{} {
+	handJSON, _ := json.Marshal(playerState.Hand)
+
+	return []interface{}{
+		playerState.PlayerID, tableID, playerState.TableSeat, playerState.IsReady,
+		playerState.Balance, playerState.StartingBalance, playerState.HasBet, playerState.HasFolded,
+		playerState.IsAllIn, playerState.IsDealer, playerState.IsTurn, playerState.GameState,
+		string(handJSON), playerState.HandDescription, time.Now(),
+	}
+}
+
+// SaveTableState saves the table state to the database
+func (db *PostgresDB) SaveTableState(tableState *TableState) error {
+	_, err := db.Exec(pgUpsertTableState, tableStateArgs(tableState)...)
+	return err
+}
+
+// LoadTableState loads the table state from the database
+func (db *PostgresDB) LoadTableState(tableID string) (*TableState, error) {
+	var ts TableState
+	var communityCardsJSON, deckStateJSON, potStateJSON sql.NullString
+
+	err := db.QueryRow(`
+		SELECT id, host_id, buy_in, min_players, max_players, small_blind, big_blind,
+		       min_balance, starting_chips, game_started, game_phase, dealer,
+		       current_player, current_bet, pot, round_num, bet_round,
+		       community_cards, deck_state, pot_state, created_at, last_action
+		FROM table_states WHERE id = $1
+	`, tableID).Scan(
+		&ts.ID, &ts.HostID, &ts.BuyIn, &ts.MinPlayers, &ts.MaxPlayers,
+		&ts.SmallBlind, &ts.BigBlind, &ts.MinBalance, &ts.StartingChips,
+		&ts.GameStarted, &ts.GamePhase, &ts.Dealer, &ts.CurrentPlayer,
+		&ts.CurrentBet, &ts.Pot, &ts.Round, &ts.BetRound,
+		&communityCardsJSON, &deckStateJSON, &potStateJSON, &ts.CreatedAt, &ts.LastAction,
+	)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("table state not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load table state: %v", err)
+	}
+
+	// Preserve the raw JSON strings, as the SQLite implementation does.
+	ts.CommunityCards = communityCardsJSON.String
+	ts.DeckState = deckStateJSON.String
+	ts.PotState = potStateJSON.String
+
+	return &ts, nil
+}
+
+// DeleteTableState deletes the table state and its player states from the
+// database
+func (db *PostgresDB) DeleteTableState(tableID string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM player_states WHERE table_id = $1", tableID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM table_states WHERE id = $1", tableID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SavePlayerState saves the player state to the database
+func (db *PostgresDB) SavePlayerState(tableID string, playerState *PlayerState) error {
+	_, err := db.Exec(pgUpsertPlayerState, playerStateArgs(tableID, playerState)...)
+	return err
+}
+
+// LoadPlayerStates loads all player states for a table from the database
+func (db *PostgresDB) LoadPlayerStates(tableID string) ([]*PlayerState, error) {
+	rows, err := db.Query(`
+		SELECT player_id, table_id, table_seat, is_ready,
+		       balance, starting_balance, has_bet, has_folded, is_all_in,
+		       is_dealer, is_turn, game_state, hand, hand_description, last_action
+		FROM player_states WHERE table_id = $1
+	`, tableID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var playerStates []*PlayerState
+	for rows.Next() {
+		var ps PlayerState
+		var handJSON, handDescription sql.NullString
+
+		err := rows.Scan(
+			&ps.PlayerID, &ps.TableID, &ps.TableSeat, &ps.IsReady,
+			&ps.Balance, &ps.StartingBalance, &ps.HasBet, &ps.HasFolded, &ps.IsAllIn,
+			&ps.IsDealer, &ps.IsTurn, &ps.GameState, &handJSON, &handDescription,
+			&ps.LastAction,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Keep the raw JSON string, as the SQLite implementation does.
+		ps.Hand = handJSON.String
+		ps.HandDescription = handDescription.String
+
+		playerStates = append(playerStates, &ps)
+	}
+
+	return playerStates, rows.Err()
+}
+
+// DeletePlayerState deletes a player's state from a table
+func (db *PostgresDB) DeletePlayerState(tableID, playerID string) error {
+	_, err := db.Exec("DELETE FROM player_states WHERE table_id = $1 AND player_id = $2", tableID, playerID)
+	return err
+}
+
+// GetAllTableIDs returns all table IDs from the database
+func (db *PostgresDB) GetAllTableIDs() ([]string, error) {
+	rows, err := db.Query("SELECT id FROM table_states")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tableIDs []string
+	for rows.Next() {
+		var tableID string
+		if err := rows.Scan(&tableID); err != nil {
+			return nil, err
+		}
+		tableIDs = append(tableIDs, tableID)
+	}
+
+	return tableIDs, rows.Err()
+}
+
+// SaveSnapshot saves the provided table state together with all associated
+// player states atomically.
+func (db *PostgresDB) SaveSnapshot(tableState *TableState, playerStates []*PlayerState) (err error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	// In case of any failure ensure we rollback.
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.Exec(pgUpsertTableState, tableStateArgs(tableState)...); err != nil {
+		return err
+	}
+
+	stmt, err := tx.Prepare(pgUpsertPlayerState)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, ps := range playerStates {
+		if _, err = stmt.Exec(playerStateArgs(tableState.ID, ps)...); err != nil {
+			return err
+		}
+	}
+
+	// Commit the full snapshot.
+	return tx.Commit()
+}
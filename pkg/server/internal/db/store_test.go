@@ -0,0 +1,521 @@
+package db
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/server/pgtest"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(pgtest.Main(m))
+}
+
+// store is the set of operations shared by every backend.
+type store interface {
+	GetPlayerBalance(playerID string) (int64, error)
+	UpdatePlayerBalance(playerID string, amount int64, transactionType, description string) error
+	UpdatePlayerBalanceAudited(playerID string, amount int64, transactionType, description string, audit AuditFunc) (int64, error)
+	GetTransactions(filter TransactionFilter) ([]Transaction, error)
+	SaveTableState(tableState *TableState) error
+	SaveSnapshot(tableState *TableState, playerStates []*PlayerState) error
+	LoadTableState(tableID string) (*TableState, error)
+	DeleteTableState(tableID string) error
+	SavePlayerState(tableID string, playerState *PlayerState) error
+	LoadPlayerStates(tableID string) ([]*PlayerState, error)
+	DeletePlayerState(tableID, playerID string) error
+	GetAllTableIDs() ([]string, error)
+	CreateWithdrawal(w *Withdrawal, dailyLimit int64, since time.Time, audit AuditFunc) (int64, error)
+	UpdateWithdrawal(id int64, status, detail string, audit AuditFunc) error
+	GetWithdrawal(id int64) (*Withdrawal, error)
+	GetWithdrawals(playerID, status string, limit int) ([]*Withdrawal, error)
+	GetWithdrawalEvents(id int64) ([]WithdrawalEvent, error)
+	SaveGCTable(b GCTable) error
+	DeleteGCTable(tableID string) error
+	GetGCTables() ([]GCTable, error)
+	SaveTableAccess(a TableAccess) error
+	GetTableAccess(tableID string) (*TableAccess, error)
+	DeleteTableAccess(tableID string) error
+	SaveTableInvite(inv TableInvite) error
+	GetTableInvites(tableID string) ([]TableInvite, error)
+	SaveTableBan(b TableBan) error
+	GetTableBan(tableID, playerID string) (*TableBan, error)
+	AddWaitlistEntry(e WaitlistEntry) error
+	DeleteWaitlistEntry(tableID, playerID string) error
+	GetWaitlistEntries() ([]WaitlistEntry, error)
+	AppendAuditRecord(r AuditRecord) error
+	GetLastAuditRecord() (*AuditRecord, error)
+	GetAuditRecords(afterSeq int64, limit int) ([]AuditRecord, error)
+	Close() error
+}
+
+// forEachBackend runs fn against a fresh in-memory database, a fresh SQLite
+// database and a fresh PostgreSQL schema.
+func forEachBackend(t *testing.T, fn func(t *testing.T, s store)) {
+	t.Run("memory", func(t *testing.T) {
+		s := NewMemoryDB()
+		defer s.Close()
+		fn(t, s)
+	})
+	t.Run("sqlite", func(t *testing.T) {
+		s, err := NewDB(filepath.Join(t.TempDir(), "poker.db"))
+		require.NoError(t, err)
+		defer s.Close()
+		fn(t, s)
+	})
+	t.Run("postgres", func(t *testing.T) {
+		s, err := NewPostgresDB(pgtest.DSN(t))
+		require.NoError(t, err)
+		defer s.Close()
+		fn(t, s)
+	})
+}
+
+func TestStoreBalances(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s store) {
+		_, err := s.GetPlayerBalance("alice")
+		require.EqualError(t, err, "player not found")
+
+		require.NoError(t, s.UpdatePlayerBalance("alice", 1_000, "deposit", "seed"))
+		require.NoError(t, s.UpdatePlayerBalance("alice", -300, "table buy-in", "joined table"))
+
+		balance, err := s.GetPlayerBalance("alice")
+		require.NoError(t, err)
+		require.Equal(t, int64(700), balance)
+	})
+}
+
+func TestStoreSnapshotRoundTrip(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s store) {
+		ts := &TableState{
+			ID: "table_1", HostID: "alice", BuyIn: 1_000, MinPlayers: 2, MaxPlayers: 6,
+			SmallBlind: 10, BigBlind: 20, MinBalance: 1_000, StartingChips: 1_000,
+			GameStarted: true, GamePhase: "FLOP", Dealer: 1, CurrentPlayer: 0,
+			CurrentBet: 40, Pot: 120, Round: 3, BetRound: 1,
+			CommunityCards: []string{"As", "Kd", "7c"},
+			DeckState:      map[string]int{"remaining": 45},
+			PotState:       map[string]int{"num_players": 2},
+		}
+		players := []*PlayerState{
+			{PlayerID: "alice", TableSeat: 0, IsReady: true, Balance: 940, StartingBalance: 1_000,
+				HasBet: 40, GameState: "IN_GAME", Hand: []string{"Ah", "Ad"}},
+			{PlayerID: "bob", TableSeat: 1, IsReady: true, Balance: 0, StartingBalance: 1_000,
+				HasBet: 20, IsAllIn: true, IsDealer: true, GameState: "ALL_IN", HandDescription: "pair"},
+		}
+		require.NoError(t, s.SaveSnapshot(ts, players))
+
+		// Saving again updates in place.
+		ts.Pot = 160
+		require.NoError(t, s.SaveSnapshot(ts, players))
+
+		loaded, err := s.LoadTableState("table_1")
+		require.NoError(t, err)
+		require.Equal(t, "alice", loaded.HostID)
+		require.Equal(t, int64(160), loaded.Pot)
+		require.Equal(t, "FLOP", loaded.GamePhase)
+		require.Equal(t, 3, loaded.Round)
+		require.True(t, loaded.GameStarted)
+		require.JSONEq(t, `["As","Kd","7c"]`, loaded.CommunityCards.(string))
+		require.JSONEq(t, `{"remaining":45}`, loaded.DeckState.(string))
+		require.JSONEq(t, `{"num_players":2}`, loaded.PotState.(string))
+
+		loadedPlayers, err := s.LoadPlayerStates("table_1")
+		require.NoError(t, err)
+		require.Len(t, loadedPlayers, 2)
+		byID := make(map[string]*PlayerState)
+		for _, ps := range loadedPlayers {
+			byID[ps.PlayerID] = ps
+		}
+		require.Equal(t, int64(940), byID["alice"].Balance)
+		require.JSONEq(t, `["Ah","Ad"]`, byID["alice"].Hand.(string))
+		require.True(t, byID["bob"].IsAllIn)
+		require.True(t, byID["bob"].IsDealer)
+		require.Equal(t, "ALL_IN", byID["bob"].GameState)
+		require.Equal(t, "pair", byID["bob"].HandDescription)
+
+		ids, err := s.GetAllTableIDs()
+		require.NoError(t, err)
+		require.Equal(t, []string{"table_1"}, ids)
+
+		require.NoError(t, s.DeletePlayerState("table_1", "bob"))
+		loadedPlayers, err = s.LoadPlayerStates("table_1")
+		require.NoError(t, err)
+		require.Len(t, loadedPlayers, 1)
+
+		require.NoError(t, s.DeleteTableState("table_1"))
+		_, err = s.LoadTableState("table_1")
+		require.Error(t, err)
+	})
+}
+
+func TestStorePlayerStateBeforeTable(t *testing.T) {
+	// Players are persisted on join, possibly before the table's first
+	// snapshot has been written.
+	forEachBackend(t, func(t *testing.T, s store) {
+		require.NoError(t, s.SavePlayerState("table_2", &PlayerState{
+			PlayerID: "carol", TableSeat: 2, GameState: "AT_TABLE",
+		}))
+		players, err := s.LoadPlayerStates("table_2")
+		require.NoError(t, err)
+		require.Len(t, players, 1)
+		require.Equal(t, 2, players[0].TableSeat)
+	})
+}
+
+func TestStoreTransactions(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s store) {
+		require.NoError(t, s.UpdatePlayerBalance("alice", 1_000, "deposit", "seed"))
+		require.NoError(t, s.UpdatePlayerBalance("bob", 500, "deposit", "seed"))
+		require.NoError(t, s.UpdatePlayerBalance("alice", -300, "table buy-in", "joined table"))
+		require.NoError(t, s.UpdatePlayerBalance("alice", 250, "table refund", "left table"))
+
+		all, err := s.GetTransactions(TransactionFilter{PlayerID: "alice"})
+		require.NoError(t, err)
+		require.Len(t, all, 3)
+		require.Equal(t, "table refund", all[0].Type)
+		require.Equal(t, int64(250), all[0].Amount)
+		require.Equal(t, "left table", all[0].Description)
+		require.Equal(t, "deposit", all[2].Type)
+		for _, tx := range all {
+			require.Equal(t, "alice", tx.PlayerID)
+			created, err := time.Parse(time.RFC3339, tx.CreatedAt)
+			require.NoError(t, err)
+			require.WithinDuration(t, time.Now(), created, time.Minute)
+		}
+
+		// Paging by id.
+		page, err := s.GetTransactions(TransactionFilter{PlayerID: "alice", Limit: 2})
+		require.NoError(t, err)
+		require.Equal(t, []int64{all[0].ID, all[1].ID}, []int64{page[0].ID, page[1].ID})
+		page, err = s.GetTransactions(TransactionFilter{PlayerID: "alice", Limit: 2, BeforeID: page[1].ID})
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		require.Equal(t, all[2].ID, page[0].ID)
+
+		// Type filter.
+		byType, err := s.GetTransactions(TransactionFilter{PlayerID: "alice", Types: []string{"deposit", "table refund"}})
+		require.NoError(t, err)
+		require.Len(t, byType, 2)
+
+		// Date range.
+		now := time.Now()
+		inRange, err := s.GetTransactions(TransactionFilter{PlayerID: "alice", Since: now.Add(-time.Hour), Until: now.Add(time.Hour)})
+		require.NoError(t, err)
+		require.Len(t, inRange, 3)
+		future, err := s.GetTransactions(TransactionFilter{PlayerID: "alice", Since: now.Add(time.Hour)})
+		require.NoError(t, err)
+		require.Empty(t, future)
+		past, err := s.GetTransactions(TransactionFilter{PlayerID: "alice", Until: now.Add(-time.Hour)})
+		require.NoError(t, err)
+		require.Empty(t, past)
+
+		// No player matches every player's transactions.
+		ledger, err := s.GetTransactions(TransactionFilter{Types: []string{"deposit"}})
+		require.NoError(t, err)
+		require.Len(t, ledger, 2)
+		require.Equal(t, "bob", ledger[0].PlayerID)
+		require.Equal(t, "alice", ledger[1].PlayerID)
+	})
+}
+
+func TestStoreWithdrawals(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s store) {
+		since := time.Now().Add(-24 * time.Hour)
+
+		_, err := s.CreateWithdrawal(&Withdrawal{PlayerID: "nobody", Amount: 1, Method: "tip"}, 0, since, nil)
+		require.EqualError(t, err, "player not found")
+
+		require.NoError(t, s.UpdatePlayerBalance("alice", 1_000, "deposit", "seed"))
+		_, err = s.CreateWithdrawal(&Withdrawal{PlayerID: "alice", Amount: 1_001, Method: "tip"}, 0, since, nil)
+		require.ErrorIs(t, err, ErrInsufficientBalance)
+
+		id, err := s.CreateWithdrawal(&Withdrawal{PlayerID: "alice", Amount: 400, Method: "tip", Detail: "requested"}, 700, since, nil)
+		require.NoError(t, err)
+		balance, err := s.GetPlayerBalance("alice")
+		require.NoError(t, err)
+		require.Equal(t, int64(600), balance)
+
+		// The limit counts withdrawals that were not reversed.
+		_, err = s.CreateWithdrawal(&Withdrawal{PlayerID: "alice", Amount: 301, Method: "tip"}, 700, since, nil)
+		require.ErrorIs(t, err, ErrWithdrawalLimit)
+		balance, err = s.GetPlayerBalance("alice")
+		require.NoError(t, err)
+		require.Equal(t, int64(600), balance, "a rejected withdrawal must not debit")
+
+		require.NoError(t, s.UpdateWithdrawal(id, WithdrawalSent, "tip queued", nil))
+		require.NoError(t, s.UpdateWithdrawal(id, WithdrawalSent, "attempt 1 failed, retrying", nil))
+		require.NoError(t, s.UpdateWithdrawal(id, WithdrawalReversed, "tip failed", nil))
+		require.ErrorIs(t, s.UpdateWithdrawal(id, WithdrawalConfirmed, "", nil), ErrWithdrawalTransition)
+		require.ErrorIs(t, s.UpdateWithdrawal(id+100, WithdrawalSent, "", nil), ErrWithdrawalNotFound)
+
+		balance, err = s.GetPlayerBalance("alice")
+		require.NoError(t, err)
+		require.Equal(t, int64(1_000), balance)
+
+		w, err := s.GetWithdrawal(id)
+		require.NoError(t, err)
+		require.Equal(t, WithdrawalReversed, w.Status)
+		require.Equal(t, "tip failed", w.Detail)
+		require.Equal(t, int64(400), w.Amount)
+
+		events, err := s.GetWithdrawalEvents(id)
+		require.NoError(t, err)
+		var statuses []string
+		for _, e := range events {
+			statuses = append(statuses, e.Status)
+		}
+		require.Equal(t, []string{WithdrawalPending, WithdrawalSent, WithdrawalSent, WithdrawalReversed}, statuses)
+
+		// Once reversed, the amount no longer counts towards the limit.
+		id2, err := s.CreateWithdrawal(&Withdrawal{PlayerID: "alice", Amount: 700, Method: "address", Destination: "Dsabc"}, 700, since, nil)
+		require.NoError(t, err)
+		require.NoError(t, s.UpdateWithdrawal(id2, WithdrawalConfirmed, "paid", nil))
+
+		ws, err := s.GetWithdrawals("alice", "", 0)
+		require.NoError(t, err)
+		require.Len(t, ws, 2)
+		require.Equal(t, id2, ws[0].ID)
+		require.Equal(t, "Dsabc", ws[0].Destination)
+		ws, err = s.GetWithdrawals("alice", WithdrawalReversed, 0)
+		require.NoError(t, err)
+		require.Len(t, ws, 1)
+
+		txs, err := s.GetTransactions(TransactionFilter{
+			PlayerID: "alice",
+			Types:    []string{TransactionWithdrawal, TransactionWithdrawalReversal},
+		})
+		require.NoError(t, err)
+		require.Len(t, txs, 3)
+		require.Equal(t, int64(-700), txs[0].Amount)
+	})
+}
+
+func TestStoreGCTables(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s store) {
+		bindings, err := s.GetGCTables()
+		require.NoError(t, err)
+		require.Empty(t, bindings)
+
+		require.NoError(t, s.SaveGCTable(GCTable{TableID: "t2", GCID: "gc1", BoundBy: "alice"}))
+		require.NoError(t, s.SaveGCTable(GCTable{TableID: "t1", GCID: "gc1", BoundBy: "alice"}))
+		// Rebinding a table replaces its group chat.
+		require.NoError(t, s.SaveGCTable(GCTable{TableID: "t2", GCID: "gc2", BoundBy: "bob"}))
+
+		bindings, err = s.GetGCTables()
+		require.NoError(t, err)
+		require.Len(t, bindings, 2)
+		require.Equal(t, "t1", bindings[0].TableID)
+		require.Equal(t, "gc1", bindings[0].GCID)
+		require.Equal(t, "t2", bindings[1].TableID)
+		require.Equal(t, "gc2", bindings[1].GCID)
+		require.Equal(t, "bob", bindings[1].BoundBy)
+		_, err = time.Parse(time.RFC3339, bindings[1].CreatedAt)
+		require.NoError(t, err)
+
+		require.NoError(t, s.DeleteGCTable("t1"))
+		require.NoError(t, s.DeleteGCTable("missing"))
+		bindings, err = s.GetGCTables()
+		require.NoError(t, err)
+		require.Len(t, bindings, 1)
+		require.Equal(t, "t2", bindings[0].TableID)
+	})
+}
+
+func TestStoreTableAccess(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s store) {
+		access, err := s.GetTableAccess("t1")
+		require.NoError(t, err)
+		require.Nil(t, access)
+
+		require.NoError(t, s.SaveTableAccess(TableAccess{TableID: "t1", Private: true}))
+		require.NoError(t, s.SaveTableAccess(TableAccess{TableID: "t1", PasswordSalt: "00", PasswordHash: "ff"}))
+		access, err = s.GetTableAccess("t1")
+		require.NoError(t, err)
+		require.Equal(t, &TableAccess{TableID: "t1", PasswordSalt: "00", PasswordHash: "ff"}, access)
+
+		now := time.Now().UTC().Truncate(time.Second)
+		require.NoError(t, s.SaveTableInvite(TableInvite{Code: "b", TableID: "t1", CreatedBy: "alice",
+			ExpiresAt: now.Add(2 * time.Hour)}))
+		require.NoError(t, s.SaveTableInvite(TableInvite{Code: "a", TableID: "t1", InviteeID: "bob",
+			CreatedBy: "alice", ExpiresAt: now.Add(time.Hour)}))
+		require.NoError(t, s.SaveTableInvite(TableInvite{Code: "c", TableID: "t2", ExpiresAt: now}))
+		require.Error(t, s.SaveTableInvite(TableInvite{Code: "a", TableID: "t2", ExpiresAt: now}))
+
+		invites, err := s.GetTableInvites("t1")
+		require.NoError(t, err)
+		require.Len(t, invites, 2)
+		require.Equal(t, "a", invites[0].Code)
+		require.Equal(t, "bob", invites[0].InviteeID)
+		require.Equal(t, "alice", invites[0].CreatedBy)
+		require.True(t, now.Add(time.Hour).Equal(invites[0].ExpiresAt), invites[0].ExpiresAt)
+		require.Equal(t, "b", invites[1].Code)
+		_, err = time.Parse(time.RFC3339, invites[1].CreatedAt)
+		require.NoError(t, err)
+
+		require.NoError(t, s.DeleteTableAccess("t1"))
+		access, err = s.GetTableAccess("t1")
+		require.NoError(t, err)
+		require.Nil(t, access)
+		invites, err = s.GetTableInvites("t1")
+		require.NoError(t, err)
+		require.Empty(t, invites)
+		invites, err = s.GetTableInvites("t2")
+		require.NoError(t, err)
+		require.Len(t, invites, 1)
+	})
+}
+
+func TestStoreTableBans(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s store) {
+		ban, err := s.GetTableBan("t1", "bob")
+		require.NoError(t, err)
+		require.Nil(t, ban)
+
+		require.NoError(t, s.SaveTableBan(TableBan{TableID: "t1", PlayerID: "bob", BannedBy: "alice"}))
+		require.NoError(t, s.SaveTableBan(TableBan{TableID: "t1", PlayerID: "bob", BannedBy: "alice", Reason: "spam"}))
+		require.NoError(t, s.SaveTableBan(TableBan{TableID: "t2", PlayerID: "bob", BannedBy: "carol"}))
+		ban, err = s.GetTableBan("t1", "bob")
+		require.NoError(t, err)
+		require.NotNil(t, ban)
+		require.Equal(t, "alice", ban.BannedBy)
+		require.Equal(t, "spam", ban.Reason)
+		_, err = time.Parse(time.RFC3339, ban.CreatedAt)
+		require.NoError(t, err)
+
+		ban, err = s.GetTableBan("t1", "carol")
+		require.NoError(t, err)
+		require.Nil(t, ban)
+
+		// Closing a table drops its bans only.
+		require.NoError(t, s.DeleteTableAccess("t1"))
+		ban, err = s.GetTableBan("t1", "bob")
+		require.NoError(t, err)
+		require.Nil(t, ban)
+		ban, err = s.GetTableBan("t2", "bob")
+		require.NoError(t, err)
+		require.NotNil(t, ban)
+	})
+}
+
+func TestStoreWaitlist(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s store) {
+		entries, err := s.GetWaitlistEntries()
+		require.NoError(t, err)
+		require.Empty(t, entries)
+
+		require.NoError(t, s.AddWaitlistEntry(WaitlistEntry{TableID: "t1", PlayerID: "carol", JoinedAt: 20}))
+		require.NoError(t, s.AddWaitlistEntry(WaitlistEntry{TableID: "t1", PlayerID: "bob", JoinedAt: 10}))
+		require.NoError(t, s.AddWaitlistEntry(WaitlistEntry{TableID: "t2", PlayerID: "bob", JoinedAt: 5}))
+		// Joining again keeps the player's place.
+		require.NoError(t, s.AddWaitlistEntry(WaitlistEntry{TableID: "t1", PlayerID: "bob", JoinedAt: 30}))
+
+		entries, err = s.GetWaitlistEntries()
+		require.NoError(t, err)
+		require.Equal(t, []WaitlistEntry{
+			{TableID: "t1", PlayerID: "bob", JoinedAt: 10},
+			{TableID: "t1", PlayerID: "carol", JoinedAt: 20},
+			{TableID: "t2", PlayerID: "bob", JoinedAt: 5},
+		}, entries)
+
+		require.NoError(t, s.DeleteWaitlistEntry("t1", "bob"))
+		require.NoError(t, s.DeleteTableAccess("t2"))
+		entries, err = s.GetWaitlistEntries()
+		require.NoError(t, err)
+		require.Equal(t, []WaitlistEntry{{TableID: "t1", PlayerID: "carol", JoinedAt: 20}}, entries)
+	})
+}
+
+func TestStoreAuditLog(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s store) {
+		last, err := s.GetLastAuditRecord()
+		require.NoError(t, err)
+		require.Nil(t, last)
+
+		records := []AuditRecord{
+			{Seq: 1, Time: 100, Actor: "alice", PlayerID: "alice", Action: "buy-in", Amount: -100, Balance: 900, PrevHash: "", Hash: "h1"},
+			{Seq: 2, Time: 200, Actor: "alice", PlayerID: "alice", TableID: "t1", Hand: 3, Action: "bet", Amount: 20, Balance: 980, Detail: "raise", PrevHash: "h1", Hash: "h2"},
+			{Seq: 3, Time: 300, Actor: "server", PlayerID: "bob", TableID: "t1", Hand: 3, Action: "payout", Amount: 40, Balance: 1020, PrevHash: "h2", Hash: "h3"},
+		}
+		for _, r := range records {
+			require.NoError(t, s.AppendAuditRecord(r))
+		}
+		err = s.AppendAuditRecord(AuditRecord{Seq: 2, Action: "forged", PrevHash: "h1", Hash: "x"})
+		require.ErrorIs(t, err, ErrAuditSeqTaken)
+
+		last, err = s.GetLastAuditRecord()
+		require.NoError(t, err)
+		require.Equal(t, &records[2], last)
+
+		page, err := s.GetAuditRecords(0, 2)
+		require.NoError(t, err)
+		require.Equal(t, records[:2], page)
+		page, err = s.GetAuditRecords(2, 2)
+		require.NoError(t, err)
+		require.Equal(t, records[2:], page)
+		page, err = s.GetAuditRecords(3, 2)
+		require.NoError(t, err)
+		require.Empty(t, page)
+	})
+}
+
+func TestStoreAuditedBalanceChanges(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, s store) {
+		require.NoError(t, s.UpdatePlayerBalance("alice", 1_000, "deposit", "seed"))
+		seq := int64(0)
+		audit := func(r AuditRecord) AuditRecord {
+			seq++
+			r.Seq, r.Actor, r.Hash = seq, "admin", fmt.Sprintf("h%d", seq)
+			return r
+		}
+
+		balance, err := s.UpdatePlayerBalanceAudited("alice", -300, "admin adjustment", "fee", audit)
+		require.NoError(t, err)
+		require.Equal(t, int64(700), balance)
+		id, err := s.CreateWithdrawal(&Withdrawal{PlayerID: "alice", Amount: 200, Method: "tip"}, 0, time.Time{}, audit)
+		require.NoError(t, err)
+		require.NoError(t, s.UpdateWithdrawal(id, WithdrawalSent, "tip queued", audit))
+		require.NoError(t, s.UpdateWithdrawal(id, WithdrawalReversed, "tip failed", audit))
+
+		records, err := s.GetAuditRecords(0, 10)
+		require.NoError(t, err)
+		require.Equal(t, []AuditRecord{
+			{Seq: 1, Actor: "admin", PlayerID: "alice", Action: "admin adjustment", Amount: -300, Balance: 700, Detail: "fee", Hash: "h1"},
+			{Seq: 2, Actor: "admin", PlayerID: "alice", Action: TransactionWithdrawal, Amount: -200, Balance: 500,
+				Detail: fmt.Sprintf("withdrawal %d", id), Hash: "h2"},
+			{Seq: 3, Actor: "admin", PlayerID: "alice", Action: TransactionWithdrawalReversal, Amount: 200, Balance: 700,
+				Detail: fmt.Sprintf("withdrawal %d reversed", id), Hash: "h3"},
+		}, records)
+
+		// A change whose record cannot be appended is not made.
+		seq = 0
+		_, err = s.UpdatePlayerBalanceAudited("alice", -100, "admin adjustment", "fee", audit)
+		require.ErrorIs(t, err, ErrAuditSeqTaken)
+		_, err = s.CreateWithdrawal(&Withdrawal{PlayerID: "alice", Amount: 100, Method: "tip"}, 0, time.Time{}, audit)
+		require.ErrorIs(t, err, ErrAuditSeqTaken)
+		balance, err = s.GetPlayerBalance("alice")
+		require.NoError(t, err)
+		require.Equal(t, int64(700), balance)
+		withdrawals, err := s.GetWithdrawals("alice", "", 0)
+		require.NoError(t, err)
+		require.Len(t, withdrawals, 1)
+		txs, err := s.GetTransactions(TransactionFilter{PlayerID: "alice"})
+		require.NoError(t, err)
+		require.Len(t, txs, 4)
+	})
+}
+
+func TestAuditLogAppendOnly(t *testing.T) {
+	s, err := NewDB(filepath.Join(t.TempDir(), "poker.db"))
+	require.NoError(t, err)
+	defer s.Close()
+
+	require.NoError(t, s.AppendAuditRecord(AuditRecord{Seq: 1, Action: "deposit", Amount: 5, Hash: "h1"}))
+	_, err = s.Exec("UPDATE audit_log SET amount = 500 WHERE seq = 1")
+	require.ErrorContains(t, err, "append-only")
+	_, err = s.Exec("DELETE FROM audit_log")
+	require.ErrorContains(t, err, "append-only")
+}
@@ -0,0 +1,157 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// Transaction represents a player's transaction
+type Transaction struct {
+	ID          int64
+	PlayerID    string
+	Amount      int64
+	Type        string
+	Description string
+	CreatedAt   string // RFC 3339, UTC
+}
+
+// TransactionFilter selects the transactions returned by GetTransactions.
+// Results are ordered newest first.
+type TransactionFilter struct {
+	PlayerID string    // Empty matches every player
+	Types    []string  // Empty matches every type
+	Since    time.Time // Inclusive lower bound on creation time; zero for none
+	Until    time.Time // Exclusive upper bound on creation time; zero for none
+	BeforeID int64     // Only transactions with a lower ID, for paging; 0 for none
+	Limit    int       // Maximum number of results; 0 for no limit
+}
+
+// sqliteTimeFormat is the layout of SQLite's CURRENT_TIMESTAMP, which is how
+// transaction times are stored. Bounds are compared as text in this layout.
+const sqliteTimeFormat = "2006-01-02 15:04:05"
+
+// transactionsQuery builds the query for filter in dialect d.
+func (d dialect) transactionsQuery(filter TransactionFilter) (string, []interface{}) {
+	var (
+		where []string
+		args  []interface{}
+	)
+
+	if filter.PlayerID != "" {
+		where = append(where, "player_id = ?")
+		args = append(args, filter.PlayerID)
+	}
+	if len(filter.Types) > 0 {
+		where = append(where, "type IN (?"+strings.Repeat(", ?", len(filter.Types)-1)+")")
+		for _, t := range filter.Types {
+			args = append(args, t)
+		}
+	}
+	if !filter.Since.IsZero() {
+		where = append(where, "created_at >= ?")
+		args = append(args, d.timeArg(filter.Since))
+	}
+	if !filter.Until.IsZero() {
+		where = append(where, "created_at < ?")
+		args = append(args, d.timeArg(filter.Until))
+	}
+	if filter.BeforeID > 0 {
+		where = append(where, "id < ?")
+		args = append(args, filter.BeforeID)
+	}
+
+	query := "SELECT id, player_id, amount, type, description, created_at FROM transactions"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY id DESC"
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+	return d.rebind(query), args
+}
+
+// scanTransactions reads the rows returned by a transactionsQuery.
+func scanTransactions(rows *sql.Rows) ([]Transaction, error) {
+	defer rows.Close()
+
+	var txs []Transaction
+	for rows.Next() {
+		var (
+			tx          Transaction
+			description sql.NullString
+			createdAt   time.Time
+		)
+		if err := rows.Scan(&tx.ID, &tx.PlayerID, &tx.Amount, &tx.Type, &description, &createdAt); err != nil {
+			return nil, err
+		}
+		tx.Description = description.String
+		tx.CreatedAt = formatTime(createdAt)
+		txs = append(txs, tx)
+	}
+	return txs, rows.Err()
+}
+
+// GetTransactions returns the transactions matching filter, newest first.
+func (db *DB) GetTransactions(filter TransactionFilter) ([]Transaction, error) {
+	query, args := sqliteDialect.transactionsQuery(filter)
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanTransactions(rows)
+}
+
+// GetTransactions returns the transactions matching filter, newest first.
+func (db *PostgresDB) GetTransactions(filter TransactionFilter) ([]Transaction, error) {
+	query, args := postgresDialect.transactionsQuery(filter)
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanTransactions(rows)
+}
+
+// GetTransactions returns the transactions matching filter, newest first.
+func (m *MemoryDB) GetTransactions(filter TransactionFilter) ([]Transaction, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	types := make(map[string]bool, len(filter.Types))
+	for _, t := range filter.Types {
+		types[t] = true
+	}
+
+	var txs []Transaction
+	for i := len(m.transactions) - 1; i >= 0; i-- {
+		tx := m.transactions[i]
+		switch {
+		case filter.PlayerID != "" && tx.PlayerID != filter.PlayerID:
+			continue
+		case len(types) > 0 && !types[tx.Type]:
+			continue
+		case !filter.Since.IsZero() && tx.CreatedAt.Before(filter.Since):
+			continue
+		case !filter.Until.IsZero() && !tx.CreatedAt.Before(filter.Until):
+			continue
+		case filter.BeforeID > 0 && tx.ID >= filter.BeforeID:
+			continue
+		}
+		txs = append(txs, Transaction{
+			ID:          tx.ID,
+			PlayerID:    tx.PlayerID,
+			Amount:      tx.Amount,
+			Type:        tx.Type,
+			Description: tx.Description,
+			CreatedAt:   formatTime(tx.CreatedAt),
+		})
+		if filter.Limit > 0 && len(txs) == filter.Limit {
+			break
+		}
+	}
+	return txs, nil
+}
@@ -0,0 +1,137 @@
+package db
+
+import (
+	"database/sql"
+	"sort"
+)
+
+// WaitlistEntry is a player waiting for a seat at a full table.
+type WaitlistEntry struct {
+	TableID  string
+	PlayerID string
+	JoinedAt int64 // Unix nanoseconds; orders the players of a table
+}
+
+func (d dialect) addWaitlistEntry(db *sql.DB, e WaitlistEntry) error {
+	_, err := db.Exec(d.rebind(`INSERT INTO table_waitlist (table_id, player_id, joined_at_ns)
+		VALUES (?, ?, ?) ON CONFLICT (table_id, player_id) DO NOTHING`),
+		e.TableID, e.PlayerID, e.JoinedAt)
+	return err
+}
+
+func (d dialect) deleteWaitlistEntry(db *sql.DB, tableID, playerID string) error {
+	_, err := db.Exec(d.rebind("DELETE FROM table_waitlist WHERE table_id = ? AND player_id = ?"),
+		tableID, playerID)
+	return err
+}
+
+func (d dialect) getWaitlistEntries(db *sql.DB) ([]WaitlistEntry, error) {
+	rows, err := db.Query(`SELECT table_id, player_id, joined_at_ns FROM table_waitlist
+		ORDER BY table_id, joined_at_ns, player_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []WaitlistEntry
+	for rows.Next() {
+		var e WaitlistEntry
+		if err := rows.Scan(&e.TableID, &e.PlayerID, &e.JoinedAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+// AddWaitlistEntry puts a player on the waitlist of a table. A player already
+// on it keeps their place.
+func (db *DB) AddWaitlistEntry(e WaitlistEntry) error {
+	return sqliteDialect.addWaitlistEntry(db.DB, e)
+}
+
+// DeleteWaitlistEntry takes a player off the waitlist of a table.
+func (db *DB) DeleteWaitlistEntry(tableID, playerID string) error {
+	return sqliteDialect.deleteWaitlistEntry(db.DB, tableID, playerID)
+}
+
+// GetWaitlistEntries returns the waitlists of every table, ordered by table
+// and then by the time players joined.
+func (db *DB) GetWaitlistEntries() ([]WaitlistEntry, error) {
+	return sqliteDialect.getWaitlistEntries(db.DB)
+}
+
+// AddWaitlistEntry puts a player on the waitlist of a table. A player already
+// on it keeps their place.
+func (db *PostgresDB) AddWaitlistEntry(e WaitlistEntry) error {
+	return postgresDialect.addWaitlistEntry(db.DB, e)
+}
+
+// DeleteWaitlistEntry takes a player off the waitlist of a table.
+func (db *PostgresDB) DeleteWaitlistEntry(tableID, playerID string) error {
+	return postgresDialect.deleteWaitlistEntry(db.DB, tableID, playerID)
+}
+
+// GetWaitlistEntries returns the waitlists of every table, ordered by table
+// and then by the time players joined.
+func (db *PostgresDB) GetWaitlistEntries() ([]WaitlistEntry, error) {
+	return postgresDialect.getWaitlistEntries(db.DB)
+}
+
+// AddWaitlistEntry puts a player on the waitlist of a table. A player already
+// on it keeps their place.
+func (m *MemoryDB) AddWaitlistEntry(e WaitlistEntry) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entries := m.waitlists[e.TableID]
+	if entries == nil {
+		entries = make(map[string]WaitlistEntry)
+		m.waitlists[e.TableID] = entries
+	}
+	if _, ok := entries[e.PlayerID]; !ok {
+		entries[e.PlayerID] = e
+	}
+	return nil
+}
+
+// DeleteWaitlistEntry takes a player off the waitlist of a table.
+func (m *MemoryDB) DeleteWaitlistEntry(tableID, playerID string) error {
+	if err := m.beforeWrite(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.waitlists[tableID], playerID)
+	return nil
+}
+
+// GetWaitlistEntries returns the waitlists of every table, ordered by table
+// and then by the time players joined.
+func (m *MemoryDB) GetWaitlistEntries() ([]WaitlistEntry, error) {
+	m.beforeRead()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var entries []WaitlistEntry
+	for _, waitlist := range m.waitlists {
+		for _, e := range waitlist {
+			entries = append(entries, e)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		switch {
+		case a.TableID != b.TableID:
+			return a.TableID < b.TableID
+		case a.JoinedAt != b.JoinedAt:
+			return a.JoinedAt < b.JoinedAt
+		}
+		return a.PlayerID < b.PlayerID
+	})
+	return entries, nil
+}
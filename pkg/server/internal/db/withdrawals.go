@@ -0,0 +1,368 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Withdrawal statuses. A withdrawal starts pending once the amount has been
+// debited, becomes sent when the payout was handed to the payment network and
+// ends either confirmed or reversed, in which case the amount is credited
+// back to the player.
+const (
+	WithdrawalPending   = "pending"
+	WithdrawalSent      = "sent"
+	WithdrawalConfirmed = "confirmed"
+	WithdrawalReversed  = "reversed"
+)
+
+// Transaction types recorded for withdrawals.
+const (
+	TransactionWithdrawal         = "withdrawal"
+	TransactionWithdrawalReversal = "withdrawal reversal"
+)
+
+var (
+	// ErrInsufficientBalance is returned when a withdrawal exceeds the
+	// player's balance.
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	// ErrWithdrawalLimit is returned when a withdrawal would exceed the
+	// daily withdrawal limit.
+	ErrWithdrawalLimit = errors.New("daily withdrawal limit exceeded")
+	// ErrWithdrawalNotFound is returned for unknown withdrawal IDs.
+	ErrWithdrawalNotFound = errors.New("withdrawal not found")
+	// ErrWithdrawalTransition is returned when a withdrawal cannot move to
+	// the requested status from its current one.
+	ErrWithdrawalTransition = errors.New("invalid withdrawal status transition")
+)
+
+// Withdrawal is a request to pay part of a player's balance out.
+type Withdrawal struct {
+	ID          int64
+	PlayerID    string
+	Amount      int64
+	Method      string // How the payout is made, e.g. a Bison Relay tip
+	Destination string // Payout address, when the method needs one
+	Status      string
+	Detail      string // Latest status detail
+	CreatedAt   string // RFC 3339, UTC
+	UpdatedAt   string // RFC 3339, UTC
+}
+
+// WithdrawalEvent is an entry of a withdrawal's audit trail.
+type WithdrawalEvent struct {
+	ID           int64
+	WithdrawalID int64
+	Status       string
+	Detail       string
+	CreatedAt    string // RFC 3339, UTC
+}
+
+// withdrawalTransitions lists the statuses each status may move to. Moving to
+// the current status records an event without changing the withdrawal.
+var withdrawalTransitions = map[string][]string{
+	WithdrawalPending: {WithdrawalPending, WithdrawalSent, WithdrawalConfirmed, WithdrawalReversed},
+	WithdrawalSent:    {WithdrawalSent, WithdrawalConfirmed, WithdrawalReversed},
+}
+
+// checkWithdrawalTransition reports whether a withdrawal may move from one
+// status to another.
+func checkWithdrawalTransition(from, to string) error {
+	for _, s := range withdrawalTransitions[from] {
+		if s == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s to %s", ErrWithdrawalTransition, from, to)
+}
+
+// formatTime formats a stored timestamp the way the Database API reports it.
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+const withdrawalColumns = "id, player_id, amount, method, destination, status, detail, created_at, updated_at"
+
+// scanWithdrawal reads a row selected with withdrawalColumns.
+func scanWithdrawal(row interface{ Scan(...interface{}) error }) (*Withdrawal, error) {
+	var (
+		w                    Withdrawal
+		createdAt, updatedAt time.Time
+	)
+	err := row.Scan(&w.ID, &w.PlayerID, &w.Amount, &w.Method, &w.Destination,
+		&w.Status, &w.Detail, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+	w.CreatedAt = formatTime(createdAt)
+	w.UpdatedAt = formatTime(updatedAt)
+	return &w, nil
+}
+
+// createWithdrawal debits the player and records w as pending in one
+// transaction, returning its ID. Withdrawals created at or after since that
+// were not reversed count towards dailyLimit; a limit of 0 disables it. The
+// debit is audited with audit.
+func (d dialect) createWithdrawal(db *sql.DB, w *Withdrawal, dailyLimit int64, since time.Time, audit AuditFunc) (id int64, err error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// The conditional debit also locks the player's row, serializing
+	// concurrent withdrawals by the same player.
+	var balance int64
+	err = tx.QueryRow(d.rebind("UPDATE players SET balance = balance - ? WHERE id = ? AND balance >= ? RETURNING balance"),
+		w.Amount, w.PlayerID, w.Amount).Scan(&balance)
+	if errors.Is(err, sql.ErrNoRows) {
+		var exists int
+		err = tx.QueryRow(d.rebind("SELECT COUNT(*) FROM players WHERE id = ?"), w.PlayerID).Scan(&exists)
+		if err != nil {
+			return 0, err
+		}
+		if exists == 0 {
+			return 0, fmt.Errorf("player not found")
+		}
+		return 0, ErrInsufficientBalance
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if dailyLimit > 0 {
+		var withdrawn int64
+		err = tx.QueryRow(d.rebind(`
+			SELECT COALESCE(SUM(amount), 0) FROM withdrawals
+			WHERE player_id = ? AND status <> ? AND created_at >= ?
+		`), w.PlayerID, WithdrawalReversed, d.timeArg(since)).Scan(&withdrawn)
+		if err != nil {
+			return 0, err
+		}
+		if withdrawn+w.Amount > dailyLimit {
+			return 0, ErrWithdrawalLimit
+		}
+	}
+
+	err = tx.QueryRow(d.rebind(`
+		INSERT INTO withdrawals (player_id, amount, method, destination, status, detail)
+		VALUES (?, ?, ?, ?, ?, ?)
+		RETURNING id
+	`), w.PlayerID, w.Amount, w.Method, w.Destination, WithdrawalPending, w.Detail).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	description := fmt.Sprintf("withdrawal %d", id)
+	if _, err = tx.Exec(d.rebind("INSERT INTO transactions (player_id, amount, type, description) VALUES (?, ?, ?, ?)"),
+		w.PlayerID, -w.Amount, TransactionWithdrawal, description); err != nil {
+		return 0, err
+	}
+	if err = d.auditBalance(tx, audit, w.PlayerID, -w.Amount, balance, TransactionWithdrawal, description); err != nil {
+		return 0, err
+	}
+	if _, err = tx.Exec(d.rebind("INSERT INTO withdrawal_events (withdrawal_id, status, detail) VALUES (?, ?, ?)"),
+		id, WithdrawalPending, w.Detail); err != nil {
+		return 0, err
+	}
+	return id, tx.Commit()
+}
+
+// updateWithdrawal moves a withdrawal to status, recording detail in its audit
+// trail. Reversing a withdrawal credits its amount back to the player, which
+// is audited with audit.
+func (d dialect) updateWithdrawal(db *sql.DB, id int64, status, detail string, audit AuditFunc) (err error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Claim the row first so concurrent updates of the same withdrawal
+	// serialize before its current status is read.
+	res, err := tx.Exec(d.rebind("UPDATE withdrawals SET updated_at = CURRENT_TIMESTAMP WHERE id = ?"), id)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return ErrWithdrawalNotFound
+	}
+
+	var (
+		playerID, current string
+		amount            int64
+	)
+	err = tx.QueryRow(d.rebind("SELECT player_id, amount, status FROM withdrawals WHERE id = ?"), id).
+		Scan(&playerID, &amount, &current)
+	if err != nil {
+		return err
+	}
+	if err = checkWithdrawalTransition(current, status); err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec(d.rebind("UPDATE withdrawals SET status = ?, detail = ? WHERE id = ?"),
+		status, detail, id); err != nil {
+		return err
+	}
+	if _, err = tx.Exec(d.rebind("INSERT INTO withdrawal_events (withdrawal_id, status, detail) VALUES (?, ?, ?)"),
+		id, status, detail); err != nil {
+		return err
+	}
+	if status == WithdrawalReversed {
+		var balance int64
+		if err = tx.QueryRow(d.rebind("UPDATE players SET balance = balance + ? WHERE id = ? RETURNING balance"),
+			amount, playerID).Scan(&balance); err != nil {
+			return err
+		}
+		description := fmt.Sprintf("withdrawal %d reversed", id)
+		if _, err = tx.Exec(d.rebind("INSERT INTO transactions (player_id, amount, type, description) VALUES (?, ?, ?, ?)"),
+			playerID, amount, TransactionWithdrawalReversal, description); err != nil {
+			return err
+		}
+		if err = d.auditBalance(tx, audit, playerID, amount, balance, TransactionWithdrawalReversal, description); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// getWithdrawal returns the withdrawal with the given ID.
+func (d dialect) getWithdrawal(db *sql.DB, id int64) (*Withdrawal, error) {
+	w, err := scanWithdrawal(db.QueryRow(d.rebind("SELECT "+withdrawalColumns+" FROM withdrawals WHERE id = ?"), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrWithdrawalNotFound
+	}
+	return w, err
+}
+
+// getWithdrawals returns the player's withdrawals, newest first, optionally
+// restricted to the given status and limited to limit results (0 for all).
+func (d dialect) getWithdrawals(db *sql.DB, playerID, status string, limit int) ([]*Withdrawal, error) {
+	query := "SELECT " + withdrawalColumns + " FROM withdrawals WHERE player_id = ?"
+	args := []interface{}{playerID}
+	if status != "" {
+		query += " AND status = ?"
+		args = append(args, status)
+	}
+	query += " ORDER BY id DESC"
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := db.Query(d.rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ws []*Withdrawal
+	for rows.Next() {
+		w, err := scanWithdrawal(rows)
+		if err != nil {
+			return nil, err
+		}
+		ws = append(ws, w)
+	}
+	return ws, rows.Err()
+}
+
+// getWithdrawalEvents returns a withdrawal's audit trail, oldest first.
+func (d dialect) getWithdrawalEvents(db *sql.DB, id int64) ([]WithdrawalEvent, error) {
+	rows, err := db.Query(d.rebind(`
+		SELECT id, withdrawal_id, status, detail, created_at FROM withdrawal_events
+		WHERE withdrawal_id = ? ORDER BY id
+	`), id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []WithdrawalEvent
+	for rows.Next() {
+		var (
+			e         WithdrawalEvent
+			createdAt time.Time
+		)
+		if err := rows.Scan(&e.ID, &e.WithdrawalID, &e.Status, &e.Detail, &createdAt); err != nil {
+			return nil, err
+		}
+		e.CreatedAt = formatTime(createdAt)
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+// CreateWithdrawal debits the player's balance and records w as a pending
+// withdrawal, returning its ID. Withdrawals created at or after since that
+// were not reversed count towards dailyLimit; a limit of 0 disables it. The
+// debit is audited with audit.
+func (db *DB) CreateWithdrawal(w *Withdrawal, dailyLimit int64, since time.Time, audit AuditFunc) (int64, error) {
+	return sqliteDialect.createWithdrawal(db.DB, w, dailyLimit, since, audit)
+}
+
+// UpdateWithdrawal moves a withdrawal to status and records detail in its
+// audit trail. Reversing a withdrawal credits the amount back, which is
+// audited with audit.
+func (db *DB) UpdateWithdrawal(id int64, status, detail string, audit AuditFunc) error {
+	return sqliteDialect.updateWithdrawal(db.DB, id, status, detail, audit)
+}
+
+// GetWithdrawal returns the withdrawal with the given ID.
+func (db *DB) GetWithdrawal(id int64) (*Withdrawal, error) {
+	return sqliteDialect.getWithdrawal(db.DB, id)
+}
+
+// GetWithdrawals returns the player's withdrawals, newest first, optionally
+// restricted to one status.
+func (db *DB) GetWithdrawals(playerID, status string, limit int) ([]*Withdrawal, error) {
+	return sqliteDialect.getWithdrawals(db.DB, playerID, status, limit)
+}
+
+// GetWithdrawalEvents returns a withdrawal's audit trail, oldest first.
+func (db *DB) GetWithdrawalEvents(id int64) ([]WithdrawalEvent, error) {
+	return sqliteDialect.getWithdrawalEvents(db.DB, id)
+}
+
+// CreateWithdrawal debits the player's balance and records w as a pending
+// withdrawal, returning its ID. Withdrawals created at or after since that
+// were not reversed count towards dailyLimit; a limit of 0 disables it. The
+// debit is audited with audit.
+func (db *PostgresDB) CreateWithdrawal(w *Withdrawal, dailyLimit int64, since time.Time, audit AuditFunc) (int64, error) {
+	return postgresDialect.createWithdrawal(db.DB, w, dailyLimit, since, audit)
+}
+
+// UpdateWithdrawal moves a withdrawal to status and records detail in its
+// audit trail. Reversing a withdrawal credits the amount back, which is
+// audited with audit.
+func (db *PostgresDB) UpdateWithdrawal(id int64, status, detail string, audit AuditFunc) error {
+	return postgresDialect.updateWithdrawal(db.DB, id, status, detail, audit)
+}
+
+// GetWithdrawal returns the withdrawal with the given ID.
+func (db *PostgresDB) GetWithdrawal(id int64) (*Withdrawal, error) {
+	return postgresDialect.getWithdrawal(db.DB, id)
+}
+
+// GetWithdrawals returns the player's withdrawals, newest first, optionally
+// restricted to one status.
+func (db *PostgresDB) GetWithdrawals(playerID, status string, limit int) ([]*Withdrawal, error) {
+	return postgresDialect.getWithdrawals(db.DB, playerID, status, limit)
+}
+
+// GetWithdrawalEvents returns a withdrawal's audit trail, oldest first.
+func (db *PostgresDB) GetWithdrawalEvents(id int64) ([]WithdrawalEvent, error) {
+	return postgresDialect.getWithdrawalEvents(db.DB, id)
+}
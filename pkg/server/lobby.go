@@ -2,7 +2,9 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/vctt94/pokerbisonrelay/pkg/poker"
@@ -16,6 +18,13 @@ func (s *Server) CreateTable(ctx context.Context, req *pokerrpc.CreateTableReque
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if s.draining {
+		return nil, status.Error(codes.Unavailable, drainingMessage)
+	}
+	if isAIPlayerID(req.PlayerId) {
+		return nil, status.Error(codes.InvalidArgument, reservedIDMessage)
+	}
+
 	// Get creator's DCR balance
 	creatorBalance, err := s.db.GetPlayerBalance(req.PlayerId)
 	if err != nil {
@@ -41,19 +50,47 @@ func (s *Server) CreateTable(ctx context.Context, req *pokerrpc.CreateTableReque
 	gameLog := s.logBackend.Logger("GAME")
 
 	cfg := poker.TableConfig{
-		ID:             fmt.Sprintf("table_%d", time.Now().UnixNano()),
-		Log:            tblLog,
-		GameLog:        gameLog,
-		HostID:         req.PlayerId,
-		BuyIn:          req.BuyIn,
-		MinPlayers:     int(req.MinPlayers),
-		MaxPlayers:     int(req.MaxPlayers),
-		SmallBlind:     req.SmallBlind,
-		BigBlind:       req.BigBlind,
-		MinBalance:     req.MinBalance,
-		StartingChips:  startingChips,
-		TimeBank:       timeBank,
-		AutoStartDelay: time.Duration(req.AutoStartMs) * time.Millisecond,
+		ID:              fmt.Sprintf("table_%d", time.Now().UnixNano()),
+		Log:             tblLog,
+		GameLog:         gameLog,
+		HostID:          req.PlayerId,
+		BuyIn:           req.BuyIn,
+		MinPlayers:      int(req.MinPlayers),
+		MaxPlayers:      int(req.MaxPlayers),
+		SmallBlind:      req.SmallBlind,
+		BigBlind:        req.BigBlind,
+		MinBalance:      req.MinBalance,
+		StartingChips:   startingChips,
+		TimeBank:        timeBank,
+		AutoStartDelay:  time.Duration(req.AutoStartMs) * time.Millisecond,
+		CheckInvariants: s.checkInvariants.Load(),
+
+		SitOutAfterTimeouts: int(req.SitOutAfterTimeouts),
+		SitOutLimit:         sitOutLimit(req.SitOutLimitSeconds),
+		TimeBankReserve:     timeBankReserve(req.TimeBankReserveSeconds),
+		MaxSpectators:       int(req.MaxSpectators),
+		SpectatorDelay:      spectatorDelay(req.SpectatorDelaySeconds),
+	}
+
+	// Restrict access to private and password-protected tables, dropping
+	// the restrictions again if the table is not created after all.
+	access, err := newTableAccess(cfg.ID, req.Private, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	created := false
+	if access != nil {
+		if err := s.db.SaveTableAccess(*access); err != nil {
+			return nil, fmt.Errorf("failed to save table access: %v", err)
+		}
+		defer func() {
+			if created {
+				return
+			}
+			if err := s.db.DeleteTableAccess(cfg.ID); err != nil {
+				s.log.Errorf("Failed to delete access of table %s that was not created: %v", cfg.ID, err)
+			}
+		}()
 	}
 
 	// Create table
@@ -72,12 +109,13 @@ func (s *Server) CreateTable(ctx context.Context, req *pokerrpc.CreateTableReque
 	}
 
 	// Deduct buy-in
-	if err := s.db.UpdatePlayerBalance(req.PlayerId, -req.BuyIn, "table buy-in", "created table"); err != nil {
+	if _, err := s.updateBalance(req.PlayerId, req.PlayerId, table, -req.BuyIn, TransactionBuyIn, "created table"); err != nil {
 		return nil, err
 	}
 
 	// Register table
 	s.tables[cfg.ID] = table
+	created = true
 
 	return &pokerrpc.CreateTableResponse{TableId: cfg.ID}, nil
 }
@@ -104,6 +142,10 @@ func (s *Server) saveUserAsPlayerState(tableID string, user *poker.User) error {
 }
 
 func (s *Server) JoinTable(ctx context.Context, req *pokerrpc.JoinTableRequest) (*pokerrpc.JoinTableResponse, error) {
+	if isAIPlayerID(req.PlayerId) {
+		return &pokerrpc.JoinTableResponse{Success: false, Message: reservedIDMessage}, nil
+	}
+
 	s.mu.RLock()
 	table, ok := s.tables[req.TableId]
 	s.mu.RUnlock()
@@ -127,6 +169,7 @@ func (s *Server) JoinTable(ctx context.Context, req *pokerrpc.JoinTableRequest)
 		} else {
 			s.log.Errorf("Failed to build PLAYER_JOINED event: %v", err)
 		}
+		s.sendChatHistory(req.TableId, req.PlayerId)
 
 		return &pokerrpc.JoinTableResponse{
 			Success:    true,
@@ -135,7 +178,23 @@ func (s *Server) JoinTable(ctx context.Context, req *pokerrpc.JoinTableRequest)
 		}, nil
 	}
 
-	// New player joining – verify balance.
+	s.mu.RLock()
+	draining := s.draining
+	s.mu.RUnlock()
+	if draining {
+		return &pokerrpc.JoinTableResponse{Success: false, Message: drainingMessage}, nil
+	}
+
+	// New player joining – check the table's invite list and password.
+	denied, err := s.joinDenied(table, req)
+	if err != nil {
+		return nil, err
+	}
+	if denied != "" {
+		return &pokerrpc.JoinTableResponse{Success: false, Message: denied}, nil
+	}
+
+	// Verify balance.
 	dcrBalance, err := s.db.GetPlayerBalance(req.PlayerId)
 	if err != nil {
 		return nil, err
@@ -144,27 +203,24 @@ func (s *Server) JoinTable(ctx context.Context, req *pokerrpc.JoinTableRequest)
 		return &pokerrpc.JoinTableResponse{Success: false, Message: "Insufficient DCR balance for buy-in"}, nil
 	}
 
-	// Determine next free seat.
-	occupied := make(map[int]bool)
-	for _, u := range table.GetUsers() {
-		occupied[u.TableSeat] = true
+	// Add user to table, in the seat they chose or hold.
+	seat, err := seatFor(table, req.PlayerId, req.Seat)
+	if errors.Is(err, poker.ErrNoFreeSeat) {
+		return &pokerrpc.JoinTableResponse{Success: false, Message: fullTableMessage}, nil
 	}
-	seat := 0
-	for i := 0; i < config.MaxPlayers; i++ {
-		if !occupied[i] {
-			seat = i
-			break
-		}
+	if err != nil {
+		return &pokerrpc.JoinTableResponse{Success: false, Message: err.Error()}, nil
 	}
-
-	// Add user to table.
 	newUser, err := table.AddNewUser(req.PlayerId, req.PlayerId, dcrBalance, seat)
+	if errors.Is(err, poker.ErrNoFreeSeat) {
+		return &pokerrpc.JoinTableResponse{Success: false, Message: fullTableMessage}, nil
+	}
 	if err != nil {
 		return &pokerrpc.JoinTableResponse{Success: false, Message: err.Error()}, nil
 	}
 
 	// Deduct buy-in.
-	if err := s.db.UpdatePlayerBalance(req.PlayerId, -config.BuyIn, "table buy-in", "joined table"); err != nil {
+	if _, err := s.updateBalance(req.PlayerId, req.PlayerId, table, -config.BuyIn, TransactionBuyIn, "joined table"); err != nil {
 		table.RemoveUser(req.PlayerId)
 		return nil, err
 	}
@@ -175,6 +231,8 @@ func (s *Server) JoinTable(ctx context.Context, req *pokerrpc.JoinTableRequest)
 	if err := s.saveUserAsPlayerState(req.TableId, newUser); err != nil {
 		s.log.Errorf("Failed to save new player state: %v", err)
 	}
+	// A waitlisted player no longer waits once seated.
+	s.removeFromWaitlist(req.TableId, req.PlayerId)
 
 	// Publish typed PLAYER_JOINED event
 	if evt, err := s.buildGameEvent(
@@ -186,15 +244,26 @@ func (s *Server) JoinTable(ctx context.Context, req *pokerrpc.JoinTableRequest)
 	} else {
 		s.log.Errorf("Failed to build PLAYER_JOINED event: %v", err)
 	}
+	s.sendChatHistory(req.TableId, req.PlayerId)
 
 	return &pokerrpc.JoinTableResponse{
 		Success:    true,
 		Message:    "Successfully joined table",
 		NewBalance: newUser.DCRAccountBalance,
+		Seat:       int32(newUser.TableSeat + 1),
 	}, nil
 }
 
 func (s *Server) LeaveTable(ctx context.Context, req *pokerrpc.LeaveTableRequest) (*pokerrpc.LeaveTableResponse, error) {
+	// A table left without players is closed once the server lock is
+	// released.
+	var closing *poker.Table
+	defer func() {
+		if closing != nil {
+			s.finishCloseTable(req.PlayerId, req.TableId, closing)
+		}
+	}()
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -202,10 +271,21 @@ func (s *Server) LeaveTable(ctx context.Context, req *pokerrpc.LeaveTableRequest
 	if !ok {
 		return &pokerrpc.LeaveTableResponse{Success: false, Message: "Table not found"}, nil
 	}
+	// A seat given up goes to the next player waiting for one.
+	defer s.offerSeats(req.TableId, table)
 
 	// Get user's current state
 	user := table.GetUser(req.PlayerId)
 	if user == nil {
+		if waiting, offered := s.removeFromWaitlist(req.TableId, req.PlayerId); waiting {
+			if offered {
+				table.ReleaseSeat(req.PlayerId)
+			}
+			return &pokerrpc.LeaveTableResponse{Success: true, Message: "Left the waitlist"}, nil
+		}
+		if table.ReleaseSeat(req.PlayerId) {
+			return &pokerrpc.LeaveTableResponse{Success: true, Message: "Seat reservation released"}, nil
+		}
 		return &pokerrpc.LeaveTableResponse{Success: false, Message: "Player not at table"}, nil
 	}
 
@@ -259,7 +339,7 @@ func (s *Server) LeaveTable(ctx context.Context, req *pokerrpc.LeaveTableRequest
 	if !table.IsGameStarted() {
 		refundAmount = config.BuyIn
 		// Update player's balance in the database
-		err = s.db.UpdatePlayerBalance(req.PlayerId, refundAmount, "table refund", "left table")
+		_, err = s.updateBalance(req.PlayerId, req.PlayerId, table, refundAmount, TransactionRefund, "left table")
 		if err != nil {
 			return nil, err
 		}
@@ -267,48 +347,36 @@ func (s *Server) LeaveTable(ctx context.Context, req *pokerrpc.LeaveTableRequest
 
 	// If the host leaves, transfer host to another player if available
 	if isHost {
-		remainingUsers := table.GetUsers()
-
-		// If there are other users, transfer host to the first available user
-		if len(remainingUsers) > 0 {
-			// Find the first user that is not the leaving host
-			var newHostID string
-			for _, u := range remainingUsers {
-				if u.ID != req.PlayerId {
-					newHostID = u.ID
-					break
-				}
+		// Bots never host: their chips are credited to the host, who paid
+		// for them.
+		var newHostID string
+		for _, u := range table.GetUsers() {
+			if u.ID != req.PlayerId && !isAIPlayerID(u.ID) {
+				newHostID = u.ID
+				break
 			}
+		}
 
-			if newHostID != "" {
-				// Transfer host ownership by updating the config
-				err = s.transferTableHost(req.TableId, newHostID)
-				if err != nil {
-					return &pokerrpc.LeaveTableResponse{Success: false, Message: err.Error()}, nil
-				}
-
-				// Save updated table state (async)
-				s.saveTableStateAsync(req.TableId, "host transferred")
-
-				return &pokerrpc.LeaveTableResponse{
-					Success: true,
-					Message: fmt.Sprintf("Successfully left table. Host transferred to %s", newHostID),
-				}, nil
+		if newHostID != "" {
+			// Transfer host ownership by updating the config
+			err = s.transferTableHost(req.TableId, newHostID)
+			if err != nil {
+				return &pokerrpc.LeaveTableResponse{Success: false, Message: err.Error()}, nil
 			}
-		}
 
-		// If no other players remain, close the table
-		delete(s.tables, req.TableId)
-		err = s.db.DeleteTableState(req.TableId)
-		if err != nil {
-			s.log.Errorf("Failed to delete table state from database: %v", err)
-		}
+			// Save updated table state (async)
+			s.saveTableStateAsync(req.TableId, "host transferred")
 
-		// Clean up the save mutex for this table
-		s.saveMu.Lock()
-		delete(s.saveMutexes, req.TableId)
-		s.saveMu.Unlock()
+			return &pokerrpc.LeaveTableResponse{
+				Success: true,
+				Message: fmt.Sprintf("Successfully left table. Host transferred to %s", newHostID),
+			}, nil
+		}
 
+		// If no other players remain, close the table, cashing out its bots
+		// to the leaving host.
+		table.BeginClose()
+		closing = table
 		return &pokerrpc.LeaveTableResponse{
 			Success: true,
 			Message: "Host left - table closed (no other players)",
@@ -354,29 +422,72 @@ func (s *Server) GetTables(ctx context.Context, req *pokerrpc.GetTablesRequest)
 	// Build response using regular table methods (no server lock held)
 	tables := make([]*pokerrpc.Table, 0, len(tableRefs))
 	for _, table := range tableRefs {
-		config := table.GetConfig()
-		users := table.GetUsers()
-		game := table.GetGame()
-
-		protoTable := &pokerrpc.Table{
-			Id:              config.ID,
-			HostId:          config.HostID,
-			SmallBlind:      config.SmallBlind,
-			BigBlind:        config.BigBlind,
-			MaxPlayers:      int32(table.GetMaxPlayers()),
-			MinPlayers:      int32(table.GetMinPlayers()),
-			CurrentPlayers:  int32(len(users)),
-			MinBalance:      config.MinBalance,
-			BuyIn:           config.BuyIn,
-			GameStarted:     game != nil,
-			AllPlayersReady: table.AreAllPlayersReady(),
+		access, err := s.db.GetTableAccess(table.GetConfig().ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		visible, err := s.tableVisible(table, access, req.PlayerId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if visible {
+			tables = append(tables, s.tableInfo(table, access))
 		}
-		tables = append(tables, protoTable)
 	}
 
 	return &pokerrpc.GetTablesResponse{Tables: tables}, nil
 }
 
+// TableInfo returns the lobby entry of a table, private or not, or nil when
+// the table does not exist. It serves in-process callers that act on behalf
+// of the table host.
+func (s *Server) TableInfo(tableID string) *pokerrpc.Table {
+	s.mu.RLock()
+	table, ok := s.tables[tableID]
+	s.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+	access, err := s.db.GetTableAccess(tableID)
+	if err != nil {
+		s.log.Errorf("Failed to load access of table %s: %v", tableID, err)
+	}
+	return s.tableInfo(table, access)
+}
+
+// tableInfo builds the lobby entry of a table with its waitlist and
+// spectator count.
+func (s *Server) tableInfo(table *poker.Table, access *TableAccess) *pokerrpc.Table {
+	config := table.GetConfig()
+	users := table.GetUsers()
+	game := table.GetGame()
+
+	protoTable := &pokerrpc.Table{
+		Id:              config.ID,
+		HostId:          config.HostID,
+		SmallBlind:      config.SmallBlind,
+		BigBlind:        config.BigBlind,
+		MaxPlayers:      int32(table.GetMaxPlayers()),
+		MinPlayers:      int32(table.GetMinPlayers()),
+		CurrentPlayers:  int32(len(users)),
+		MinBalance:      config.MinBalance,
+		BuyIn:           config.BuyIn,
+		GameStarted:     game != nil,
+		AllPlayersReady: table.AreAllPlayersReady(),
+		TimeBankSeconds: int32(config.TimeBank / time.Second),
+		Waitlist:        s.waitlist(config.ID),
+		Spectators:      int32(s.spectatorCount(config.ID)),
+	}
+	for _, r := range table.Reservations() {
+		protoTable.ReservedSeats = append(protoTable.ReservedSeats, int32(r.Seat+1))
+	}
+	if access != nil {
+		protoTable.Private = access.Private
+		protoTable.PasswordProtected = access.PasswordHash != ""
+	}
+	return protoTable
+}
+
 func (s *Server) GetPlayerCurrentTable(ctx context.Context, req *pokerrpc.GetPlayerCurrentTableRequest) (*pokerrpc.GetPlayerCurrentTableResponse, error) {
 	// Get table references with server lock
 	s.mu.RLock()
@@ -414,12 +525,7 @@ func (s *Server) GetBalance(ctx context.Context, req *pokerrpc.GetBalanceRequest
 }
 
 func (s *Server) UpdateBalance(ctx context.Context, req *pokerrpc.UpdateBalanceRequest) (*pokerrpc.UpdateBalanceResponse, error) {
-	err := s.db.UpdatePlayerBalance(req.PlayerId, req.Amount, req.Description, "balance update")
-	if err != nil {
-		return nil, err
-	}
-
-	balance, err := s.db.GetPlayerBalance(req.PlayerId)
+	balance, err := s.updateBalance(req.PlayerId, req.PlayerId, nil, req.Amount, TransactionBalanceAdmin, req.Description)
 	if err != nil {
 		return nil, err
 	}
@@ -431,27 +537,195 @@ func (s *Server) UpdateBalance(ctx context.Context, req *pokerrpc.UpdateBalanceR
 }
 
 func (s *Server) ProcessTip(ctx context.Context, req *pokerrpc.ProcessTipRequest) (*pokerrpc.ProcessTipResponse, error) {
-	err := s.db.UpdatePlayerBalance(req.FromPlayerId, -req.Amount, req.Message, "tip sent")
+	_, err := s.updateBalance(req.FromPlayerId, req.FromPlayerId, nil, -req.Amount, TransactionTipSent, req.Message)
 	if err != nil {
 		return nil, err
 	}
-	err = s.db.UpdatePlayerBalance(req.ToPlayerId, req.Amount, req.Message, "tip received")
+	balance, err := s.updateBalance(req.FromPlayerId, req.ToPlayerId, nil, req.Amount, TransactionTipReceived, req.Message)
 	if err != nil {
 		return nil, err
 	}
 
-	balance, err := s.db.GetPlayerBalance(req.ToPlayerId)
+	return &pokerrpc.ProcessTipResponse{
+		Success:    true,
+		Message:    "Tip processed successfully",
+		NewBalance: balance,
+	}, nil
+}
+
+const (
+	// defaultTransactionsPageSize is used when GetTransactions does not
+	// request a page size.
+	defaultTransactionsPageSize = 50
+	// maxTransactionsPageSize caps the page size GetTransactions returns.
+	maxTransactionsPageSize = 500
+)
+
+// GetTransactions returns a page of the player's transaction history, newest
+// first, optionally filtered by type and creation time.
+func (s *Server) GetTransactions(ctx context.Context, req *pokerrpc.GetTransactionsRequest) (*pokerrpc.GetTransactionsResponse, error) {
+	if req.PlayerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id is required")
+	}
+	return s.transactionsPage(req)
+}
+
+// transactionsPage returns the page of transactions req asks for. An empty
+// player ID matches every player.
+func (s *Server) transactionsPage(req *pokerrpc.GetTransactionsRequest) (*pokerrpc.GetTransactionsResponse, error) {
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultTransactionsPageSize
+	} else if pageSize > maxTransactionsPageSize {
+		pageSize = maxTransactionsPageSize
+	}
+
+	// Fetch one extra row to learn whether another page follows.
+	filter := TransactionFilter{PlayerID: req.PlayerId, Types: req.Types, Limit: pageSize + 1}
+	if req.PageToken != "" {
+		beforeID, err := strconv.ParseInt(req.PageToken, 10, 64)
+		if err != nil || beforeID <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		filter.BeforeID = beforeID
+	}
+	if req.Since > 0 {
+		filter.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		filter.Until = time.Unix(req.Until, 0)
+	}
+	if req.Since > 0 && req.Until > 0 && req.Until <= req.Since {
+		return nil, status.Error(codes.InvalidArgument, "until must be after since")
+	}
+
+	txs, err := s.db.GetTransactions(filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pokerrpc.GetTransactionsResponse{}
+	if len(txs) > pageSize {
+		txs = txs[:pageSize]
+		resp.NextPageToken = strconv.FormatInt(txs[len(txs)-1].ID, 10)
+	}
+	resp.Transactions = make([]*pokerrpc.Transaction, 0, len(txs))
+	for _, tx := range txs {
+		resp.Transactions = append(resp.Transactions, &pokerrpc.Transaction{
+			Id:          tx.ID,
+			PlayerId:    tx.PlayerID,
+			Amount:      tx.Amount,
+			Type:        tx.Type,
+			Description: tx.Description,
+			CreatedAt:   unixTime(tx.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// unixTime converts an RFC 3339 time reported by the database to Unix
+// seconds, or 0 when it cannot be parsed.
+func unixTime(s string) int64 {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+// defaultWithdrawalsLimit is the number of withdrawals GetWithdrawals returns
+// when no limit is requested.
+const defaultWithdrawalsLimit = 20
+
+// withdrawalToRPC converts a withdrawal to its RPC representation.
+func withdrawalToRPC(w *Withdrawal) *pokerrpc.Withdrawal {
+	return &pokerrpc.Withdrawal{
+		Id:          w.ID,
+		Amount:      w.Amount,
+		Method:      w.Method,
+		Destination: w.Destination,
+		Status:      w.Status,
+		Detail:      w.Detail,
+		CreatedAt:   unixTime(w.CreatedAt),
+		UpdatedAt:   unixTime(w.UpdatedAt),
+	}
+}
+
+// getWithdrawals returns the withdrawal pipeline or an error when the server
+// was started without one.
+func (s *Server) getWithdrawals() (*Withdrawals, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.withdrawals == nil {
+		return nil, status.Error(codes.Unimplemented, "withdrawals are not enabled on this server")
+	}
+	return s.withdrawals, nil
+}
+
+// RequestWithdrawal debits amount from the player's balance and pays it out
+// by Bison Relay tip or to the given payout address.
+func (s *Server) RequestWithdrawal(ctx context.Context, req *pokerrpc.RequestWithdrawalRequest) (*pokerrpc.RequestWithdrawalResponse, error) {
+	w, err := s.getWithdrawals()
 	if err != nil {
 		return nil, err
 	}
+	if req.PlayerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id is required")
+	}
+
+	withdrawal, err := w.Request(ctx, req.PlayerId, req.Amount, req.PayoutAddress)
+	switch {
+	case withdrawal != nil && err != nil:
+		// The payout failed and the withdrawal was reversed.
+		return nil, status.Errorf(codes.Unavailable, "withdrawal %d reversed: %v", withdrawal.ID, err)
+	case errors.Is(err, ErrInvalidWithdrawalAmount):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrInsufficientBalance):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrWithdrawalLimit):
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	case err != nil && err.Error() == "player not found":
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	return &pokerrpc.ProcessTipResponse{
-		Success:    true,
-		Message:    "Tip processed successfully",
+	balance, err := s.db.GetPlayerBalance(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pokerrpc.RequestWithdrawalResponse{
+		Withdrawal: withdrawalToRPC(withdrawal),
 		NewBalance: balance,
 	}, nil
 }
 
+// GetWithdrawals returns the player's most recent withdrawals.
+func (s *Server) GetWithdrawals(ctx context.Context, req *pokerrpc.GetWithdrawalsRequest) (*pokerrpc.GetWithdrawalsResponse, error) {
+	w, err := s.getWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+	if req.PlayerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id is required")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultWithdrawalsLimit
+	}
+	withdrawals, err := w.List(req.PlayerId, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pokerrpc.GetWithdrawalsResponse{DailyLimit: w.DailyLimit()}
+	for _, wd := range withdrawals {
+		resp.Withdrawals = append(resp.Withdrawals, withdrawalToRPC(wd))
+	}
+	return resp, nil
+}
+
 func (s *Server) SetPlayerReady(ctx context.Context, req *pokerrpc.SetPlayerReadyRequest) (*pokerrpc.SetPlayerReadyResponse, error) {
 	// First acquire server lock to get table reference
 	s.mu.RLock()
@@ -482,42 +756,14 @@ func (s *Server) SetPlayerReady(ctx context.Context, req *pokerrpc.SetPlayerRead
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to build PLAYER_READY event: %v", err))
 	}
 	s.eventProcessor.PublishEvent(event)
-	// If all players are ready and the game hasn't started yet, start the game
-	if allReady && !gameStarted {
-		if errStart := table.StartGame(); errStart != nil {
+	// If all players are ready and the game hasn't started yet, start the
+	// game, unless the host paused or is closing the table or the server
+	// drains.
+	if allReady && !gameStarted && !table.IsPaused() && !table.IsClosing() && !table.IsDraining() {
+		// The game waits while fewer than two players sit in.
+		if errStart := s.startTableGame(req.TableId, table, req.PlayerId); errStart != nil && !errors.Is(errStart, poker.ErrNotEnoughSittingIn) {
 			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to start game: %v", errStart))
 		}
-
-		// Publish typed GAME_STARTED event *after* the game has been
-		// successfully created so that the emitted snapshot reflects the brand-new
-		// game state (dealer, blinds, current player, etc.). Without this, the first
-		// game update received by the clients would still be in the pre-start state
-		// which prevents the UI from progressing to the actual hand.
-		if gameStartedEvent, errGS := s.buildGameEvent(
-			pokerrpc.NotificationType_GAME_STARTED,
-			req.TableId,
-			GameStartedPayload{PlayerIDs: []string{req.PlayerId}},
-		); errGS == nil {
-			s.eventProcessor.PublishEvent(gameStartedEvent)
-		} else {
-			s.log.Errorf("Failed to build GAME_STARTED event: %v", errGS)
-		}
-
-		// Attach callback to broadcast NEW_HAND_STARTED events triggered by auto-start logic
-		if g := table.GetGame(); g != nil {
-			g.SetOnNewHandStartedCallback(func() {
-				// Publish typed NEW_HAND_STARTED event
-				if evt, err := s.buildGameEvent(
-					pokerrpc.NotificationType_NEW_HAND_STARTED,
-					req.TableId,
-					NewHandStartedPayload{},
-				); err == nil {
-					s.eventProcessor.PublishEvent(evt)
-				} else {
-					s.log.Errorf("Failed to build NEW_HAND_STARTED event: %v", err)
-				}
-			})
-		}
 	}
 
 	return &pokerrpc.SetPlayerReadyResponse{
@@ -527,6 +773,48 @@ func (s *Server) SetPlayerReady(ctx context.Context, req *pokerrpc.SetPlayerRead
 	}, nil
 }
 
+// startTableGame starts the game of a table whose players are all ready and
+// publishes GAME_STARTED.
+func (s *Server) startTableGame(tableID string, table *poker.Table, playerID string) error {
+	if err := table.StartGame(); err != nil {
+		return err
+	}
+	s.metrics.observeHandStarted()
+
+	// Publish typed GAME_STARTED event *after* the game has been
+	// successfully created so that the emitted snapshot reflects the brand-new
+	// game state (dealer, blinds, current player, etc.). Without this, the first
+	// game update received by the clients would still be in the pre-start state
+	// which prevents the UI from progressing to the actual hand.
+	if gameStartedEvent, errGS := s.buildGameEvent(
+		pokerrpc.NotificationType_GAME_STARTED,
+		tableID,
+		GameStartedPayload{PlayerIDs: []string{playerID}},
+	); errGS == nil {
+		s.eventProcessor.PublishEvent(gameStartedEvent)
+	} else {
+		s.log.Errorf("Failed to build GAME_STARTED event: %v", errGS)
+	}
+
+	// Attach callback to broadcast NEW_HAND_STARTED events triggered by auto-start logic
+	if g := table.GetGame(); g != nil {
+		g.SetOnNewHandStartedCallback(func() {
+			s.metrics.observeHandStarted()
+			// Publish typed NEW_HAND_STARTED event
+			if evt, err := s.buildGameEvent(
+				pokerrpc.NotificationType_NEW_HAND_STARTED,
+				tableID,
+				NewHandStartedPayload{},
+			); err == nil {
+				s.eventProcessor.PublishEvent(evt)
+			} else {
+				s.log.Errorf("Failed to build NEW_HAND_STARTED event: %v", err)
+			}
+		})
+	}
+	return nil
+}
+
 func (s *Server) SetPlayerUnready(ctx context.Context, req *pokerrpc.SetPlayerUnreadyRequest) (*pokerrpc.SetPlayerUnreadyResponse, error) {
 	// First acquire server lock to get table reference
 	s.mu.RLock()
@@ -616,5 +904,38 @@ func (s *Server) processTableEvents(eventChan <-chan poker.TableEvent) {
 			continue
 		}
 		s.eventProcessor.PublishEvent(ev)
+		if event.Type == pokerrpc.NotificationType_SHOWDOWN_RESULT {
+			s.auditShowdown(event)
+		}
+
+		// A table the host is closing closes once its current hand ends, and
+		// players who sat out for too long are removed between hands
+		switch event.Type {
+		case pokerrpc.NotificationType_SHOWDOWN_RESULT, pokerrpc.NotificationType_GAME_ENDED:
+			s.mu.RLock()
+			table := s.tables[event.TableID]
+			s.mu.RUnlock()
+			if table != nil && table.IsClosing() {
+				s.finishCloseTable(table.GetConfig().HostID, event.TableID, table)
+			} else if table != nil {
+				s.removeExpiredSitOuts(event.TableID)
+			}
+		case pokerrpc.NotificationType_PLAYER_SAT_OUT:
+			if pl, ok := event.Payload.(poker.SitOutEvent); ok {
+				s.scheduleSitOutRemoval(event.TableID, pl.PlayerID)
+			}
+		case pokerrpc.NotificationType_CHECK_MADE, pokerrpc.NotificationType_PLAYER_FOLDED:
+			// Turns the table played for players out of time
+			if pl, ok := event.Payload.(poker.AutoActionEvent); ok {
+				s.mu.RLock()
+				table := s.tables[event.TableID]
+				s.mu.RUnlock()
+				action := AuditCheck
+				if event.Type == pokerrpc.NotificationType_PLAYER_FOLDED {
+					action = AuditFold
+				}
+				s.auditTableAction(AuditActorServer, pl.PlayerID, table, action, 0, "timed out")
+			}
+		}
 	}
 }
@@ -0,0 +1,225 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// healthCheckTimeout bounds the database ping of a health check.
+const healthCheckTimeout = 5 * time.Second
+
+// serverMetrics holds the Prometheus metrics of a server. Gauges describing
+// the current state are computed from the server when scraped; the rest are
+// updated as things happen. A nil *serverMetrics records nothing.
+type serverMetrics struct {
+	registry *prometheus.Registry
+
+	handsStarted   prometheus.Counter
+	actionDuration *prometheus.HistogramVec
+	eventsDropped  prometheus.Counter
+	saveDuration   prometheus.Histogram
+	saveFailures   prometheus.Counter
+
+	mu         sync.Mutex
+	handStarts []time.Time // Hands started in the last minute, oldest first
+}
+
+// newServerMetrics creates the metrics of s in their own registry.
+func newServerMetrics(s *Server) *serverMetrics {
+	m := &serverMetrics{
+		registry: prometheus.NewRegistry(),
+		handsStarted: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "poker_hands_started_total",
+			Help: "Hands dealt since the server started.",
+		}),
+		actionDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "poker_action_duration_seconds",
+			Help:    "Time taken to handle a player action.",
+			Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+		}, []string{"action"}),
+		eventsDropped: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "poker_events_dropped_total",
+			Help: "Game events dropped because the event queue was full or stopped.",
+		}),
+		saveDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "poker_db_save_duration_seconds",
+			Help:    "Time taken to persist a table.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 14),
+		}),
+		saveFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "poker_db_save_failures_total",
+			Help: "Table saves that failed.",
+		}),
+	}
+
+	gauge := func(name, help string, f func() float64) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts{Name: name, Help: help}, f)
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.handsStarted,
+		m.actionDuration,
+		m.eventsDropped,
+		m.saveDuration,
+		m.saveFailures,
+		gauge("poker_tables_active", "Tables open on the server.", func() float64 {
+			return float64(len(s.allTables()))
+		}),
+		gauge("poker_players_seated", "Players seated at a table.", func() float64 {
+			return float64(len(s.seatedPlayers()))
+		}),
+		gauge("poker_hands_per_minute", "Hands dealt in the last minute.", func() float64 {
+			return float64(m.handsLastMinute())
+		}),
+		gauge("poker_event_queue_depth", "Game events waiting to be processed.", func() float64 {
+			if s.eventProcessor == nil {
+				return 0
+			}
+			return float64(len(s.eventProcessor.queue))
+		}),
+		gauge("poker_notification_streams", "Open notification streams.", func() float64 {
+			s.notificationMu.RLock()
+			defer s.notificationMu.RUnlock()
+			return float64(len(s.notificationStreams))
+		}),
+		gauge("poker_game_streams", "Open game streams.", func() float64 {
+			s.gameStreamsMu.RLock()
+			defer s.gameStreamsMu.RUnlock()
+			n := 0
+			for _, streams := range s.gameStreams {
+				n += len(streams)
+			}
+			return float64(n)
+		}),
+		gauge("poker_escrow_atoms", "Atoms held at tables on behalf of seated players.", func() float64 {
+			return float64(s.escrowAtoms())
+		}),
+	)
+	return m
+}
+
+// observeHandStarted counts a hand being dealt.
+func (m *serverMetrics) observeHandStarted() {
+	if m == nil {
+		return
+	}
+	m.handsStarted.Inc()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handStarts = append(m.handStarts, time.Now())
+	m.pruneHandStarts()
+}
+
+// handsLastMinute returns the number of hands dealt in the last minute.
+func (m *serverMetrics) handsLastMinute() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pruneHandStarts()
+	return len(m.handStarts)
+}
+
+// pruneHandStarts forgets hands dealt over a minute ago. Callers must hold
+// m.mu.
+func (m *serverMetrics) pruneHandStarts() {
+	cutoff := time.Now().Add(-time.Minute)
+	i := 0
+	for i < len(m.handStarts) && m.handStarts[i].Before(cutoff) {
+		i++
+	}
+	m.handStarts = m.handStarts[i:]
+}
+
+// observeAction records how long handling a player action took, from start.
+// It is meant to be deferred.
+func (m *serverMetrics) observeAction(action string, start time.Time) {
+	if m == nil {
+		return
+	}
+	m.actionDuration.WithLabelValues(action).Observe(time.Since(start).Seconds())
+}
+
+// observeSave records a table save that took d and failed when err is set.
+func (m *serverMetrics) observeSave(d time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	m.saveDuration.Observe(d.Seconds())
+	if err != nil {
+		m.saveFailures.Inc()
+	}
+}
+
+// observeEventDropped counts a game event that could not be queued.
+func (m *serverMetrics) observeEventDropped() {
+	if m == nil {
+		return
+	}
+	m.eventsDropped.Inc()
+}
+
+// escrowAtoms returns the atoms bought in at every table and not yet cashed
+// out, valued at the chips the players hold.
+func (s *Server) escrowAtoms() int64 {
+	var total int64
+	for _, table := range s.allTables() {
+		var chips int64
+		for _, n := range table.ChipCounts() {
+			chips += n
+		}
+		total += chipsToAtoms(table.GetConfig(), chips)
+	}
+	return total
+}
+
+// CheckHealth returns why the server cannot serve players: the database is
+// unreachable or an event worker is stuck. It returns nil when healthy.
+func (s *Server) CheckHealth(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	if err := s.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("database: %w", err)
+	}
+	if err := s.eventProcessor.CheckWorkers(); err != nil {
+		return fmt.Errorf("event processor: %w", err)
+	}
+	return nil
+}
+
+// MonitorHandler returns the HTTP handler of the monitoring endpoints:
+// /metrics in the Prometheus format, /healthz, which fails while
+// CheckHealth does, and /readyz, which also fails while the server drains.
+func (s *Server) MonitorHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(s.metrics.registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeHealth(w, s.CheckHealth(r.Context()))
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		err := s.CheckHealth(r.Context())
+		if err == nil && s.IsDraining() {
+			err = fmt.Errorf("draining")
+		}
+		writeHealth(w, err)
+	})
+	return mux
+}
+
+// writeHealth answers a health check: 200 "ok", or 503 with the error.
+func writeHealth(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintln(w, err)
+		return
+	}
+	fmt.Fprintln(w, "ok")
+}
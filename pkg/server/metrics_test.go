@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// getMonitor requests path from the monitoring endpoints of srv.
+func getMonitor(t *testing.T, srv *Server, path string) (int, string) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	srv.MonitorHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	body, err := io.ReadAll(rec.Result().Body)
+	require.NoError(t, err)
+	return rec.Code, string(body)
+}
+
+func TestMetrics(t *testing.T) {
+	srv, _, tableID := newHostTest(t)
+	startHostTestGame(t, srv, tableID)
+	folder := srv.tables[tableID].GetCurrentPlayerID()
+	_, err := srv.FoldBet(context.Background(), &pokerrpc.FoldBetRequest{PlayerId: folder, TableId: tableID})
+	require.NoError(t, err)
+
+	// Both players bought in for 100 atoms.
+	code, body := getMonitor(t, srv, "/metrics")
+	require.Equal(t, http.StatusOK, code)
+	for _, line := range []string{
+		"poker_tables_active 1",
+		"poker_players_seated 2",
+		"poker_escrow_atoms 200",
+		"poker_notification_streams 0",
+		`poker_action_duration_seconds_count{action="fold"} 1`,
+	} {
+		assert.Contains(t, body, line+"\n")
+	}
+	for _, name := range []string{"poker_hands_started_total", "poker_hands_per_minute",
+		"poker_event_queue_depth", "poker_events_dropped_total", "poker_db_save_duration_seconds_count"} {
+		assert.Contains(t, body, name+" ")
+	}
+	assert.GreaterOrEqual(t, srv.metrics.handsLastMinute(), 1)
+}
+
+func TestHandsPerMinuteWindow(t *testing.T) {
+	srv, _ := newAccessTest(t)
+	m := srv.metrics
+	m.handStarts = []time.Time{time.Now().Add(-2 * time.Minute), time.Now().Add(-30 * time.Second)}
+	m.observeHandStarted()
+	assert.Equal(t, 2, m.handsLastMinute())
+}
+
+func TestHealthEndpoints(t *testing.T) {
+	srv, database := newAccessTest(t)
+
+	code, body := getMonitor(t, srv, "/healthz")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok\n", body)
+	code, _ = getMonitor(t, srv, "/readyz")
+	assert.Equal(t, http.StatusOK, code)
+
+	// A draining server is alive but takes no new players.
+	srv.StartDrain(time.Minute)
+	code, _ = getMonitor(t, srv, "/healthz")
+	assert.Equal(t, http.StatusOK, code)
+	code, body = getMonitor(t, srv, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Contains(t, body, "draining")
+
+	require.NoError(t, database.Close())
+	code, body = getMonitor(t, srv, "/healthz")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Contains(t, body, "database")
+}
+
+func TestCheckWorkersStuck(t *testing.T) {
+	srv, _ := newAccessTest(t)
+	require.NoError(t, srv.eventProcessor.CheckWorkers())
+
+	w := srv.eventProcessor.workers[0]
+	w.busySince.Store(time.Now().Add(-2 * eventStallTimeout).UnixNano())
+	assert.ErrorContains(t, srv.eventProcessor.CheckWorkers(), "stuck")
+	w.busySince.Store(0)
+
+	srv.eventProcessor.Stop()
+	assert.ErrorContains(t, srv.CheckHealth(context.Background()), "not running")
+}
@@ -8,24 +8,11 @@ import (
 
 // broadcastNotification sends a notification to a specific player
 func (s *Server) sendNotificationToPlayer(playerID string, notification *pokerrpc.Notification) {
-	s.notificationMu.RLock()
-	notifStream, exists := s.notificationStreams[playerID]
-	s.notificationMu.RUnlock()
-
-	if !exists {
-		return // Player doesn't have an active notification stream
-	}
-
-	select {
-	case <-notifStream.done:
-		return // Stream is closed
-	default:
-		// Send notification, ignore errors as client might have disconnected
-		notifStream.stream.Send(notification)
-	}
+	s.notifyPlayer(playerID, notification)
 }
 
 // broadcastNotificationToTable sends a notification to all players at a table
+// and to its spectators
 func (s *Server) broadcastNotificationToTable(tableID string, notification *pokerrpc.Notification) {
 	s.mu.RLock()
 	table, exists := s.tables[tableID]
@@ -39,14 +26,26 @@ func (s *Server) broadcastNotificationToTable(tableID string, notification *poke
 	for _, user := range users {
 		s.sendNotificationToPlayer(user.ID, notification)
 	}
+	if relay := s.getTableRelay(); relay != nil {
+		relay.RelayTableNotification(tableID, notification)
+	}
+	s.feedSpectators(tableID, spectatorItem{notification: notification})
 }
 
-// notifyPlayers sends a notification to specific players
+// notifyPlayers sends a table notification to specific players, to the
+// table relay and to the spectators
 // This version doesn't acquire the server mutex, requiring player IDs to be passed as parameters
 func (s *Server) notifyPlayers(playerIDs []string, notification *pokerrpc.Notification) {
 	for _, playerID := range playerIDs {
 		s.notifyPlayer(playerID, notification)
 	}
+	if notification.TableId == "" {
+		return
+	}
+	if relay := s.getTableRelay(); relay != nil {
+		relay.RelayTableNotification(notification.TableId, notification)
+	}
+	s.feedSpectators(notification.TableId, spectatorItem{notification: notification})
 }
 
 // NotificationSender interface implementation
@@ -158,39 +157,90 @@ func (s *Server) SendShowdownResult(tableID string, winners []*pokerrpc.Winner,
 	}()
 }
 
-// notifyPlayer sends a notification to a specific player
+// broadcast sends a notification to every open notification stream and
+// returns how many streams it was sent to.
+func (s *Server) broadcast(notification *pokerrpc.Notification) int {
+	s.notificationMu.RLock()
+	streams := make([]*NotificationStream, 0, len(s.notificationStreams))
+	for _, ns := range s.notificationStreams {
+		streams = append(streams, ns)
+	}
+	s.notificationMu.RUnlock()
+
+	sent := 0
+	for _, ns := range streams {
+		select {
+		case <-ns.done:
+			continue
+		default:
+		}
+		if err := ns.stream.Send(notification); err == nil {
+			sent++
+		}
+	}
+	return sent
+}
+
+// notifyPlayer sends a notification to a specific player through their
+// notification stream, or through the notification relay when they have none.
 // This version only uses the notification mutex, not the main server mutex
 func (s *Server) notifyPlayer(playerID string, notification *pokerrpc.Notification) {
 	s.notificationMu.RLock()
 	notifStream, exists := s.notificationStreams[playerID]
+	relay := s.notificationRelay
 	s.notificationMu.RUnlock()
 
-	if !exists {
-		return // Player doesn't have an active notification stream
+	if exists {
+		select {
+		case <-notifStream.done:
+			// Stream is closed; fall back to the relay
+		default:
+			// Send notification, ignore errors as client might have disconnected
+			notifStream.stream.Send(notification)
+			return
+		}
 	}
 
-	select {
-	case <-notifStream.done:
-		return // Stream is closed
-	default:
-		// Send notification, ignore errors as client might have disconnected
-		notifStream.stream.Send(notification)
+	if relay != nil && !isAIPlayerID(playerID) {
+		relay.RelayNotification(playerID, notification)
 	}
 }
 
-// sendGameStateUpdates sends pre-built game states to players
-// This version only uses the game streams mutex, not the main server mutex
+// sendGameStateUpdates sends pre-built game states to players, through the
+// notification relay for players without a game stream.
+// This version only uses the game streams and notification mutexes, not the
+// main server mutex
 func (s *Server) sendGameStateUpdates(tableID string, playerGameStates map[string]*pokerrpc.GameUpdate) {
 	s.gameStreamsMu.RLock()
-	playerStreams, exists := s.gameStreams[tableID]
+	playerStreams := make(map[string]pokerrpc.PokerService_StartGameStreamServer, len(s.gameStreams[tableID]))
+	for playerID, stream := range s.gameStreams[tableID] {
+		playerStreams[playerID] = stream
+	}
 	s.gameStreamsMu.RUnlock()
 
-	if !exists || len(playerStreams) == 0 {
+	s.notificationMu.RLock()
+	relay := s.notificationRelay
+	s.notificationMu.RUnlock()
+
+	if len(playerStreams) == 0 && relay == nil {
 		return
 	}
 
 	s.log.Debugf("sendGameStateUpdates: broadcasting to %d players on table %s", len(playerStreams), tableID)
 
+	// Relay synchronously so relayed updates keep the order of the events
+	if relay != nil {
+		for playerID, gameState := range playerGameStates {
+			if _, ok := playerStreams[playerID]; !ok && !isAIPlayerID(playerID) {
+				relay.RelayGameUpdate(playerID, gameState)
+			}
+		}
+	}
+
+	if len(playerStreams) == 0 {
+		return
+	}
+
 	// Send pre-built game states to each player stream
 	// Use a single goroutine to avoid goroutine explosion
 	go func() {
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/vctt94/pokerbisonrelay/pkg/poker"
 	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
@@ -12,6 +13,10 @@ import (
 )
 
 func (s *Server) StartGameStream(req *pokerrpc.StartGameStreamRequest, stream pokerrpc.PokerService_StartGameStreamServer) error {
+	if req.Spectate {
+		return s.spectateTable(req, stream)
+	}
+
 	// Register the stream
 	s.gameStreamsMu.Lock()
 	if s.gameStreams[req.TableId] == nil {
@@ -50,6 +55,8 @@ func (s *Server) StartGameStream(req *pokerrpc.StartGameStreamRequest, stream po
 }
 
 func (s *Server) MakeBet(ctx context.Context, req *pokerrpc.MakeBetRequest) (*pokerrpc.MakeBetResponse, error) {
+	defer s.metrics.observeAction("bet", time.Now())
+
 	s.mu.RLock()
 	table, ok := s.tables[req.TableId]
 	s.mu.RUnlock()
@@ -68,6 +75,7 @@ func (s *Server) MakeBet(ctx context.Context, req *pokerrpc.MakeBetRequest) (*po
 	if err := table.MakeBet(req.PlayerId, req.Amount); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	s.auditTableAction(req.PlayerId, req.PlayerId, table, AuditBet, req.Amount, "")
 
 	// Publish typed BET_MADE event
 	if evt, err := s.buildGameEvent(
@@ -97,6 +105,8 @@ func (s *Server) MakeBet(ctx context.Context, req *pokerrpc.MakeBetRequest) (*po
 }
 
 func (s *Server) FoldBet(ctx context.Context, req *pokerrpc.FoldBetRequest) (*pokerrpc.FoldBetResponse, error) {
+	defer s.metrics.observeAction("fold", time.Now())
+
 	s.mu.RLock()
 	table, ok := s.tables[req.TableId]
 	s.mu.RUnlock()
@@ -113,6 +123,7 @@ func (s *Server) FoldBet(ctx context.Context, req *pokerrpc.FoldBetRequest) (*po
 	if err := table.HandleFold(req.PlayerId); err != nil {
 		return nil, status.Error(codes.Internal, "failed to process fold: "+err.Error())
 	}
+	s.auditTableAction(req.PlayerId, req.PlayerId, table, AuditFold, 0, "")
 
 	// Publish typed PLAYER_FOLDED event
 	if evt, err := s.buildGameEvent(
@@ -133,6 +144,8 @@ func (s *Server) FoldBet(ctx context.Context, req *pokerrpc.FoldBetRequest) (*po
 
 // Call implements the Call RPC method
 func (s *Server) CallBet(ctx context.Context, req *pokerrpc.CallBetRequest) (*pokerrpc.CallBetResponse, error) {
+	defer s.metrics.observeAction("call", time.Now())
+
 	s.mu.RLock()
 	table, ok := s.tables[req.TableId]
 	s.mu.RUnlock()
@@ -166,6 +179,7 @@ func (s *Server) CallBet(ctx context.Context, req *pokerrpc.CallBetRequest) (*po
 	if delta < 0 {
 		delta = 0 // safety
 	}
+	s.auditTableAction(req.PlayerId, req.PlayerId, table, AuditCall, delta, "")
 
 	// Publish typed CALL_MADE event
 	if evt, err := s.buildGameEvent(
@@ -186,6 +200,8 @@ func (s *Server) CallBet(ctx context.Context, req *pokerrpc.CallBetRequest) (*po
 
 // Check implements the Check RPC method
 func (s *Server) CheckBet(ctx context.Context, req *pokerrpc.CheckBetRequest) (*pokerrpc.CheckBetResponse, error) {
+	defer s.metrics.observeAction("check", time.Now())
+
 	s.mu.RLock()
 	table, ok := s.tables[req.TableId]
 	s.mu.RUnlock()
@@ -202,6 +218,7 @@ func (s *Server) CheckBet(ctx context.Context, req *pokerrpc.CheckBetRequest) (*
 	if err := table.HandleCheck(req.PlayerId); err != nil {
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
+	s.auditTableAction(req.PlayerId, req.PlayerId, table, AuditCheck, 0, "")
 
 	// Publish typed CHECK_MADE event
 	if evt, err := s.buildGameEvent(
@@ -225,6 +242,9 @@ func (s *Server) buildPlayerForUpdate(p *poker.Player, requestingPlayerID string
 		IsReady:    p.IsReady,
 		Folded:     p.GetCurrentStateString() == "FOLDED",
 		CurrentBet: p.HasBet,
+		IsDealer:   p.IsDealer,
+		Seat:       int32(p.TableSeat + 1),
+		SittingOut: p.SittingOut,
 	}
 
 	// Early return if game doesn't exist or player has no cards
@@ -290,15 +310,21 @@ func (s *Server) buildGameStateForPlayer(table *poker.Table, game *poker.Game, r
 		players = make([]*pokerrpc.Player, 0, len(users))
 		for _, user := range users {
 			players = append(players, &pokerrpc.Player{
-				Id:      user.ID,
-				Balance: 0, // No poker chips when no game - Balance field should be poker chips, not DCR
-				IsReady: user.IsReady,
+				Id:         user.ID,
+				Balance:    0, // No poker chips when no game - Balance field should be poker chips, not DCR
+				IsReady:    user.IsReady,
+				Seat:       int32(user.TableSeat + 1),
+				SittingOut: user.SittingOut,
 
 				Hand: make([]*pokerrpc.Card, 0), // Empty hand when no game
 			})
 		}
 	}
 
+	for _, p := range players {
+		p.TimeBankSeconds = timeBankSeconds(table.TimeBankLeft(p.Id))
+	}
+
 	// Build community cards slice
 	communityCards := make([]*pokerrpc.Card, 0)
 	var pot int64 = 0
@@ -333,33 +359,44 @@ func (s *Server) buildGameStateForPlayer(table *poker.Table, game *poker.Game, r
 		GameStarted:     table.IsGameStarted(),
 		PlayersRequired: int32(table.GetMinPlayers()),
 		PlayersJoined:   int32(len(table.GetUsers())),
+
+		ActionDeadlineUnixMs: unixMillis(table.ActionDeadline()),
+		Spectators:           int32(s.spectatorCount(table.GetConfig().ID)),
 	}
 }
 
+// GetGameState returns the current state of a table to a player seated at
+// it. Other players watch the table through a delayed spectator stream
+// instead, so that they cannot pass on the live game to the players.
 func (s *Server) GetGameState(ctx context.Context, req *pokerrpc.GetGameStateRequest) (*pokerrpc.GetGameStateResponse, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	// Older clients name the requesting player in the context metadata
+	playerID := req.PlayerId
+	if playerID == "" {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if playerIDs := md.Get("player-id"); len(playerIDs) > 0 {
+				playerID = playerIDs[0]
+			}
+		}
+	}
+	if playerID == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id is required")
+	}
 
+	s.mu.RLock()
 	table, ok := s.tables[req.TableId]
+	s.mu.RUnlock()
 	if !ok {
 		return nil, status.Error(codes.NotFound, "table not found")
 	}
-
-	// Process any player timeouts before building the state.
-	table.HandleTimeouts()
-
-	// Extract requesting player ID from context metadata
-	requestingPlayerID := ""
-	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		if playerIDs := md.Get("player-id"); len(playerIDs) > 0 {
-			requestingPlayerID = playerIDs[0]
+	if table.GetUser(playerID) == nil {
+		if err := s.checkCanWatch(table, playerID); err != nil {
+			return nil, err
 		}
+		return nil, status.Error(codes.FailedPrecondition, "player not at table; watch the table to follow the game")
 	}
 
-	game := table.GetGame()
-
 	return &pokerrpc.GetGameStateResponse{
-		GameState: s.buildGameStateForPlayer(table, game, requestingPlayerID),
+		GameState: s.buildGameStateForPlayer(table, table.GetGame(), playerID),
 	}, nil
 }
 
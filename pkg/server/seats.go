@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultSeatReservation is how long a seat is held when no lifetime is
+	// given.
+	defaultSeatReservation = 5 * time.Minute
+	// maxSeatReservation bounds how long a seat may be held.
+	maxSeatReservation = 15 * time.Minute
+)
+
+// seatFor returns the seat playerID sits in at table: the one requested,
+// numbered from 1, or when none is, the seat held for them or the first free
+// one.
+func seatFor(table *poker.Table, playerID string, requested int32) (int, error) {
+	if requested == 0 {
+		return table.FreeSeat(playerID)
+	}
+	return int(requested) - 1, nil
+}
+
+// ReserveSeat holds a seat for a player who cannot join the table yet, so
+// that they can top up their balance for the buy-in without losing it. The
+// player must be allowed to join the table.
+func (s *Server) ReserveSeat(ctx context.Context, req *pokerrpc.ReserveSeatRequest) (*pokerrpc.ReserveSeatResponse, error) {
+	if req.PlayerId == "" || req.TableId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id and table_id are required")
+	}
+	if req.TtlSeconds < 0 || req.TtlSeconds > int64(maxSeatReservation/time.Second) {
+		return nil, status.Errorf(codes.InvalidArgument, "reservation lifetime must be at most %v", maxSeatReservation)
+	}
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if ttl == 0 {
+		ttl = defaultSeatReservation
+	}
+	if isAIPlayerID(req.PlayerId) {
+		return &pokerrpc.ReserveSeatResponse{Success: false, Message: reservedIDMessage}, nil
+	}
+
+	s.mu.RLock()
+	table, ok := s.tables[req.TableId]
+	draining := s.draining
+	s.mu.RUnlock()
+	if !ok {
+		return &pokerrpc.ReserveSeatResponse{Success: false, Message: "Table not found"}, nil
+	}
+	if draining {
+		return &pokerrpc.ReserveSeatResponse{Success: false, Message: drainingMessage}, nil
+	}
+
+	denied, err := s.joinDenied(table, &pokerrpc.JoinTableRequest{
+		PlayerId:   req.PlayerId,
+		TableId:    req.TableId,
+		Password:   req.Password,
+		InviteCode: req.InviteCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if denied != "" {
+		return &pokerrpc.ReserveSeatResponse{Success: false, Message: denied}, nil
+	}
+
+	seat, err := seatFor(table, req.PlayerId, req.Seat)
+	if err != nil {
+		return &pokerrpc.ReserveSeatResponse{Success: false, Message: err.Error()}, nil
+	}
+	expires, err := table.ReserveSeat(req.PlayerId, seat, ttl)
+	if err != nil {
+		return &pokerrpc.ReserveSeatResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	s.log.Debugf("Player %s reserved seat %d at table %s until %v", req.PlayerId, seat+1, req.TableId, expires)
+	return &pokerrpc.ReserveSeatResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("Seat %d is held for you until %s", seat+1, expires.Format(time.Kitchen)),
+		Seat:      int32(seat + 1),
+		ExpiresAt: expires.Unix(),
+	}, nil
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestJoinChosenSeat(t *testing.T) {
+	srv, _ := newAccessTest(t)
+	tableID := createAccessTable(t, srv, false, "")
+
+	resp := joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID, Seat: 4})
+	require.True(t, resp.Success, resp.Message)
+	assert.EqualValues(t, 4, resp.Seat)
+
+	resp = joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "carol", TableId: tableID, Seat: 4})
+	assert.False(t, resp.Success)
+	assert.Contains(t, resp.Message, "taken")
+	resp = joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "carol", TableId: tableID, Seat: 7})
+	assert.False(t, resp.Success)
+
+	// Without a choice, the first free seat is taken; the host sits in 1.
+	resp = joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "carol", TableId: tableID})
+	require.True(t, resp.Success, resp.Message)
+	assert.EqualValues(t, 2, resp.Seat)
+
+	state, err := srv.GetGameState(context.Background(), &pokerrpc.GetGameStateRequest{TableId: tableID, PlayerId: "alice"})
+	require.NoError(t, err)
+	seats := make(map[string]int32)
+	for _, p := range state.GameState.Players {
+		seats[p.Id] = p.Seat
+	}
+	assert.Equal(t, map[string]int32{"alice": 1, "carol": 2, "bob": 4}, seats)
+}
+
+func TestReserveSeatWhileToppingUp(t *testing.T) {
+	srv, database := newAccessTest(t)
+	ctx := context.Background()
+	tableID := createAccessTable(t, srv, false, "")
+	require.NoError(t, database.UpdatePlayerBalance("bob", -950, TransactionWithdrawal, "spent"))
+
+	// Bob cannot afford the buy-in, but may hold a seat.
+	resp := joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID})
+	require.False(t, resp.Success)
+	reserved, err := srv.ReserveSeat(ctx, &pokerrpc.ReserveSeatRequest{PlayerId: "bob", TableId: tableID, Seat: 3})
+	require.NoError(t, err)
+	require.True(t, reserved.Success, reserved.Message)
+	assert.EqualValues(t, 3, reserved.Seat)
+	assert.InDelta(t, time.Now().Add(defaultSeatReservation).Unix(), reserved.ExpiresAt, 5)
+	assert.Equal(t, []int32{3}, listedTables(t, srv, "carol")[tableID].ReservedSeats)
+
+	_, err = srv.ReserveSeat(ctx, &pokerrpc.ReserveSeatRequest{PlayerId: "bob", TableId: tableID,
+		TtlSeconds: int64(maxSeatReservation/time.Second) + 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Nobody else takes the held seat.
+	resp = joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "carol", TableId: tableID, Seat: 3})
+	assert.False(t, resp.Success)
+	assert.Contains(t, resp.Message, "reserved")
+	other, err := srv.ReserveSeat(ctx, &pokerrpc.ReserveSeatRequest{PlayerId: "dave", TableId: tableID, Seat: 3})
+	require.NoError(t, err)
+	assert.False(t, other.Success)
+	resp = joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "carol", TableId: tableID})
+	require.True(t, resp.Success, resp.Message)
+	assert.EqualValues(t, 2, resp.Seat)
+
+	// Once topped up, bob sits in the seat he holds.
+	require.NoError(t, database.UpdatePlayerBalance("bob", 100, TransactionDeposit, "top up"))
+	resp = joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID})
+	require.True(t, resp.Success, resp.Message)
+	assert.EqualValues(t, 3, resp.Seat)
+	assert.Empty(t, listedTables(t, srv, "carol")[tableID].ReservedSeats)
+
+	// Leaving drops a reservation of a player who has not sat down.
+	other, err = srv.ReserveSeat(ctx, &pokerrpc.ReserveSeatRequest{PlayerId: "dave", TableId: tableID})
+	require.NoError(t, err)
+	require.True(t, other.Success, other.Message)
+	assert.EqualValues(t, 4, other.Seat)
+	left, err := srv.LeaveTable(ctx, &pokerrpc.LeaveTableRequest{PlayerId: "dave", TableId: tableID})
+	require.NoError(t, err)
+	assert.True(t, left.Success)
+	assert.Empty(t, listedTables(t, srv, "carol")[tableID].ReservedSeats)
+}
+
+func TestReserveSeatNeedsAccess(t *testing.T) {
+	srv, _ := newAccessTest(t)
+	tableID := createAccessTable(t, srv, false, "secret")
+
+	resp, err := srv.ReserveSeat(context.Background(), &pokerrpc.ReserveSeatRequest{PlayerId: "bob", TableId: tableID})
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	assert.Contains(t, resp.Message, "password")
+
+	resp, err = srv.ReserveSeat(context.Background(), &pokerrpc.ReserveSeatRequest{
+		PlayerId: "bob", TableId: tableID, Password: "secret"})
+	require.NoError(t, err)
+	assert.True(t, resp.Success, resp.Message)
+}
@@ -2,6 +2,8 @@ package server
 
 import (
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/decred/slog"
 	"github.com/vctt94/bisonbotkit/logging"
@@ -16,6 +18,29 @@ type NotificationStream struct {
 	done     chan struct{}
 }
 
+// NotificationRelay delivers notifications and game updates to players that
+// have no stream open to receive them, such as players at the table through
+// Bison Relay chat. Relays are called from the server's event handlers and
+// must not block.
+type NotificationRelay interface {
+	// RelayNotification is called for players without a notification
+	// stream.
+	RelayNotification(playerID string, notification *pokerrpc.Notification)
+	// RelayGameUpdate is called for players without a game stream for the
+	// update's table.
+	RelayGameUpdate(playerID string, update *pokerrpc.GameUpdate)
+}
+
+// TableRelay receives the public side of every table once per event: the
+// notifications broadcast to its players and the game state as seen by an
+// onlooker, without hole cards before showdown. It is used to mirror tables
+// to Bison Relay group chats. Relays are called from the server's event
+// handlers and must not block.
+type TableRelay interface {
+	RelayTableNotification(tableID string, notification *pokerrpc.Notification)
+	RelayTableUpdate(tableID string, update *pokerrpc.GameUpdate)
+}
+
 // Server implements both PokerService and LobbyService
 type Server struct {
 	pokerrpc.UnimplementedPokerServiceServer
@@ -26,9 +51,25 @@ type Server struct {
 	tables     map[string]*poker.Table
 	mu         sync.RWMutex
 
+	// Reasons given by hosts for closing tables, kept until the tables close
+	// at the end of their current hand. Protected by mu.
+	closeReasons map[string]string
+	// Held while a table is closed, so that a close racing another one
+	// returns only after the table's players were cashed out.
+	closeMu sync.Mutex
+
+	// Set once the server drains: new tables and joins are refused until
+	// drainDeadline at the latest. Protected by mu.
+	draining      bool
+	drainDeadline time.Time
+	// Closed once a drain finished and every table was persisted.
+	drained chan struct{}
+
 	// Notification streaming
 	notificationStreams map[string]*NotificationStream
 	notificationMu      sync.RWMutex
+	notificationRelay   NotificationRelay // Protected by notificationMu
+	tableRelay          TableRelay        // Protected by notificationMu
 
 	// Game streaming
 	gameStreams   map[string]map[string]pokerrpc.PokerService_StartGameStreamServer // tableID -> playerID -> stream
@@ -43,6 +84,41 @@ type Server struct {
 
 	// Event-driven architecture components
 	eventProcessor *EventProcessor
+
+	metrics *serverMetrics
+
+	// Hash-chained log of money and game actions
+	audit *auditLog
+
+	// Debug mode: tables check the game invariants after every action
+	checkInvariants atomic.Bool
+
+	// Bots seated by table hosts, by player ID
+	aiMu      sync.Mutex
+	aiPlayers map[string]*aiPlayer
+	aiWg      sync.WaitGroup
+
+	// Withdrawal pipeline; nil when withdrawals are disabled
+	withdrawals *Withdrawals
+
+	// Players waiting for a seat at full tables, by table ID
+	waitMu      sync.Mutex
+	waitlists   map[string]*tableWaitlist
+	offerWindow time.Duration // Protected by waitMu
+
+	// Timers removing players who sat out for too long, by table and player
+	// ID; nil once the server stops
+	sitOutMu     sync.Mutex
+	sitOutTimers map[string]*time.Timer
+
+	// Delayed public feeds of the tables being watched, by table ID; nil
+	// once the server stops
+	spectatorMu    sync.Mutex
+	spectatorFeeds map[string]*spectatorFeed
+
+	// Table chats, by table ID
+	chatMu sync.Mutex
+	chats  map[string]*tableChat
 }
 
 // NewServer creates a new poker server
@@ -52,11 +128,21 @@ func NewServer(db Database, logBackend *logging.LogBackend) *Server {
 		logBackend:          logBackend,
 		db:                  db,
 		tables:              make(map[string]*poker.Table),
+		closeReasons:        make(map[string]string),
+		drained:             make(chan struct{}),
 		notificationStreams: make(map[string]*NotificationStream),
 		gameStreams:         make(map[string]map[string]pokerrpc.PokerService_StartGameStreamServer),
 		saveMutexes:         make(map[string]*sync.Mutex),
+		waitlists:           make(map[string]*tableWaitlist),
+		offerWindow:         DefaultSeatOfferWindow,
+		sitOutTimers:        make(map[string]*time.Timer),
+		spectatorFeeds:      make(map[string]*spectatorFeed),
+		chats:               make(map[string]*tableChat),
 	}
 
+	server.metrics = newServerMetrics(server)
+	server.audit = newAuditLog(db, logBackend.Logger("AUDIT"))
+
 	// Initialize event processor for deadlock-free architecture
 	server.eventProcessor = NewEventProcessor(server, 1000, 3) // queue size: 1000, workers: 3
 	server.eventProcessor.Start()
@@ -66,12 +152,67 @@ func NewServer(db Database, logBackend *logging.LogBackend) *Server {
 	if err != nil {
 		server.log.Errorf("Failed to load persisted tables: %v", err)
 	}
+	if err := server.loadWaitlists(); err != nil {
+		server.log.Errorf("Failed to load table waitlists: %v", err)
+	}
 
 	return server
 }
 
+// SetWithdrawals enables the withdrawal RPCs, served by w.
+func (s *Server) SetWithdrawals(w *Withdrawals) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.audit = s.audit
+	s.withdrawals = w
+}
+
+// SetCheckInvariants turns the debug mode on or off in which every table
+// checks the game invariants after each action, logging violations.
+func (s *Server) SetCheckInvariants(on bool) {
+	s.checkInvariants.Store(on)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, table := range s.tables {
+		table.SetCheckInvariants(on)
+	}
+}
+
+// SetNotificationRelay sets the relay that receives notifications and game
+// updates for players without an open stream.
+func (s *Server) SetNotificationRelay(relay NotificationRelay) {
+	s.notificationMu.Lock()
+	defer s.notificationMu.Unlock()
+	s.notificationRelay = relay
+}
+
+// SetTableRelay sets the relay that receives the public events of every
+// table.
+func (s *Server) SetTableRelay(relay TableRelay) {
+	s.notificationMu.Lock()
+	defer s.notificationMu.Unlock()
+	s.tableRelay = relay
+}
+
+// getTableRelay returns the table relay, or nil when none is set.
+func (s *Server) getTableRelay() TableRelay {
+	s.notificationMu.RLock()
+	defer s.notificationMu.RUnlock()
+	return s.tableRelay
+}
+
 // Stop gracefully stops the server
 func (s *Server) Stop() {
+	s.stopAIPlayers(func(*aiPlayer) bool { return true })
+	s.aiWg.Wait()
+	s.stopSeatOffers()
+	s.stopSitOutRemovals()
+	s.stopSpectatorFeeds()
+	s.mu.RLock()
+	for _, table := range s.tables {
+		table.StopClock()
+	}
+	s.mu.RUnlock()
 	if s.eventProcessor != nil {
 		s.eventProcessor.Stop()
 	}
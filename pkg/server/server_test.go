@@ -3,7 +3,8 @@ package server
 import (
 	"context"
 	"fmt"
-	"sync"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -12,7 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/vctt94/bisonbotkit/logging"
 	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
-	"github.com/vctt94/pokerbisonrelay/pkg/server/internal/db"
+	"github.com/vctt94/pokerbisonrelay/pkg/server/pgtest"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -22,170 +23,39 @@ type TestServer struct {
 	*Server
 }
 
-// InMemoryDB implements Database interface for testing
-type InMemoryDB struct {
-	mu                  sync.RWMutex
-	balances            map[string]int64
-	transactions        map[string][]Transaction
-	tableStates         map[string]*db.TableState
-	playerStates        map[string]map[string]*db.PlayerState // tableID -> playerID -> PlayerState
-	disconnectedPlayers map[string]map[string]bool            // tableID -> playerID -> isDisconnected
-}
-
-// NewInMemoryDB creates a new in-memory database for testing
-func NewInMemoryDB() *InMemoryDB {
-	return &InMemoryDB{
-		balances:            make(map[string]int64),
-		transactions:        make(map[string][]Transaction),
-		tableStates:         make(map[string]*db.TableState),
-		playerStates:        make(map[string]map[string]*db.PlayerState),
-		disconnectedPlayers: make(map[string]map[string]bool),
-	}
-}
-
-// GetPlayerBalance returns the current balance of a player
-func (m *InMemoryDB) GetPlayerBalance(playerID string) (int64, error) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
-
-	balance, exists := m.balances[playerID]
-	if !exists {
-		return 0, fmt.Errorf("player not found")
-	}
-	return balance, nil
-}
-
-// UpdatePlayerBalance updates a player's balance and records the transaction
-func (m *InMemoryDB) UpdatePlayerBalance(playerID string, amount int64, transactionType, description string) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
-	m.balances[playerID] += amount
-
-	// Record transaction
-	tx := Transaction{
-		ID:          int64(len(m.transactions[playerID]) + 1),
-		PlayerID:    playerID,
-		Amount:      amount,
-		Type:        transactionType,
-		Description: description,
-		CreatedAt:   time.Now().Format(time.RFC3339),
-	}
-	m.transactions[playerID] = append(m.transactions[playerID], tx)
-
-	return nil
-}
-
-// GetPlayerTransactions returns the transaction history for a player
-func (m *InMemoryDB) GetPlayerTransactions(playerID string, limit int) ([]Transaction, error) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
-
-	transactions := m.transactions[playerID]
-	if limit > 0 && limit < len(transactions) {
-		return transactions[:limit], nil
-	}
-	return transactions, nil
-}
-
-// SaveTableState saves table state to memory
-func (m *InMemoryDB) SaveTableState(tableState *db.TableState) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	m.tableStates[tableState.ID] = tableState
-	return nil
-}
-
-// SaveSnapshot saves the table state and associated player states atomically (in-memory implementation).
-func (m *InMemoryDB) SaveSnapshot(tableState *db.TableState, playerStates []*db.PlayerState) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+// testBackendEnv names the environment variable selecting the database the
+// server tests run on: memory (the default), sqlite or postgres. PostgreSQL
+// is embedded unless pgtest.DSNEnv names a server.
+const testBackendEnv = "POKER_TEST_BACKEND"
 
-	m.tableStates[tableState.ID] = tableState
-
-	// Clear previous player states for this table so the snapshot replaces them.
-	m.playerStates[tableState.ID] = make(map[string]*db.PlayerState)
-	for _, ps := range playerStates {
-		m.playerStates[tableState.ID][ps.PlayerID] = ps
-	}
-	return nil
-}
-
-// LoadTableState loads table state from memory
-func (m *InMemoryDB) LoadTableState(tableID string) (*db.TableState, error) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
-	state, exists := m.tableStates[tableID]
-	if !exists {
-		return nil, fmt.Errorf("table state not found")
-	}
-	return state, nil
-}
-
-// DeleteTableState deletes table state from memory
-func (m *InMemoryDB) DeleteTableState(tableID string) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	delete(m.tableStates, tableID)
-	delete(m.playerStates, tableID)
-	delete(m.disconnectedPlayers, tableID)
-	return nil
-}
-
-// SavePlayerState saves player state to memory
-func (m *InMemoryDB) SavePlayerState(tableID string, playerState *db.PlayerState) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	if m.playerStates[tableID] == nil {
-		m.playerStates[tableID] = make(map[string]*db.PlayerState)
+func TestMain(m *testing.M) {
+	if os.Getenv(testBackendEnv) == "postgres" {
+		os.Exit(pgtest.Main(m))
 	}
-	m.playerStates[tableID][playerState.PlayerID] = playerState
-	return nil
+	os.Exit(m.Run())
 }
 
-// LoadPlayerStates loads all player states for a table from memory
-func (m *InMemoryDB) LoadPlayerStates(tableID string) ([]*db.PlayerState, error) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
-	tablePlayerStates := m.playerStates[tableID]
-	if tablePlayerStates == nil {
-		return []*db.PlayerState{}, nil
-	}
-
-	states := make([]*db.PlayerState, 0, len(tablePlayerStates))
-	for _, state := range tablePlayerStates {
-		states = append(states, state)
-	}
-	return states, nil
-}
-
-// DeletePlayerState deletes player state from memory
-func (m *InMemoryDB) DeletePlayerState(tableID, playerID string) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	if m.playerStates[tableID] != nil {
-		delete(m.playerStates[tableID], playerID)
-	}
-	if m.disconnectedPlayers[tableID] != nil {
-		delete(m.disconnectedPlayers[tableID], playerID)
-	}
-	return nil
-}
-
-// GetAllTableIDs returns all table IDs
-func (m *InMemoryDB) GetAllTableIDs() ([]string, error) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
-	tableIDs := make([]string, 0, len(m.tableStates))
-	for tableID := range m.tableStates {
-		tableIDs = append(tableIDs, tableID)
+// newTestDatabase creates an empty database on the backend selected through
+// testBackendEnv, closed when the test ends.
+func newTestDatabase(t testing.TB) Database {
+	t.Helper()
+	var (
+		database Database
+		err      error
+	)
+	switch backend := os.Getenv(testBackendEnv); backend {
+	case "", "memory":
+		database = NewMemoryDatabase()
+	case "sqlite":
+		database, err = NewDatabase(filepath.Join(t.TempDir(), "poker.db"))
+	case "postgres":
+		database, err = NewPostgresDatabase(pgtest.DSN(t))
+	default:
+		t.Fatalf("unknown %s %q", testBackendEnv, backend)
 	}
-	return tableIDs, nil
-}
-
-// Close closes the database connection
-func (m *InMemoryDB) Close() error {
-	return nil
+	require.NoError(t, err)
+	t.Cleanup(func() { database.Close() })
+	return database
 }
 
 // createTestLogBackend creates a LogBackend for testing
@@ -206,7 +76,7 @@ func createTestLogBackend() *logging.LogBackend {
 func TestPokerService(t *testing.T) {
 	t.Run("GetBalance", func(t *testing.T) {
 		// Create isolated database and server for this test
-		db := NewInMemoryDB()
+		db := newTestDatabase(t)
 		defer db.Close()
 
 		logBackend := createTestLogBackend()
@@ -243,7 +113,7 @@ func TestPokerService(t *testing.T) {
 
 	t.Run("UpdateBalance", func(t *testing.T) {
 		// Create isolated database and server for this test
-		db := NewInMemoryDB()
+		db := newTestDatabase(t)
 		defer db.Close()
 
 		logBackend := createTestLogBackend()
@@ -275,9 +145,85 @@ func TestPokerService(t *testing.T) {
 		assert.Equal(t, int64(500), resp.NewBalance)
 	})
 
+	t.Run("GetTransactions", func(t *testing.T) {
+		// Create isolated database and server for this test
+		db := newTestDatabase(t)
+		defer db.Close()
+
+		logBackend := createTestLogBackend()
+		defer logBackend.Close()
+
+		server := &TestServer{
+			Server: NewServer(db, logBackend),
+		}
+
+		ctx := context.Background()
+		for i := 1; i <= 5; i++ {
+			_, err := server.UpdateBalance(ctx, &pokerrpc.UpdateBalanceRequest{
+				PlayerId:    "player1",
+				Amount:      int64(i * 100),
+				Description: fmt.Sprintf("deposit %d", i),
+			})
+			require.NoError(t, err)
+		}
+		_, err := server.ProcessTip(ctx, &pokerrpc.ProcessTipRequest{
+			FromPlayerId: "player1",
+			ToPlayerId:   "player2",
+			Amount:       50,
+			Message:      "gg",
+		})
+		require.NoError(t, err)
+
+		// Page through everything, newest first.
+		var ids []int64
+		req := &pokerrpc.GetTransactionsRequest{PlayerId: "player1", PageSize: 4}
+		for {
+			resp, err := server.GetTransactions(ctx, req)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Transactions), 4)
+			for _, tx := range resp.Transactions {
+				ids = append(ids, tx.Id)
+			}
+			if resp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = resp.NextPageToken
+		}
+		require.Len(t, ids, 6)
+		for i := 1; i < len(ids); i++ {
+			assert.Greater(t, ids[i-1], ids[i])
+		}
+
+		// Types recorded for tips and manual adjustments.
+		resp, err := server.GetTransactions(ctx, &pokerrpc.GetTransactionsRequest{
+			PlayerId: "player1",
+			Types:    []string{TransactionTipSent},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Transactions, 1)
+		assert.Equal(t, int64(-50), resp.Transactions[0].Amount)
+		assert.Equal(t, "gg", resp.Transactions[0].Description)
+		assert.NotZero(t, resp.Transactions[0].CreatedAt)
+
+		resp, err = server.GetTransactions(ctx, &pokerrpc.GetTransactionsRequest{
+			PlayerId: "player1",
+			Types:    []string{TransactionBalanceAdmin},
+			Since:    time.Now().Add(-time.Hour).Unix(),
+			Until:    time.Now().Add(time.Hour).Unix(),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Transactions, 5)
+		assert.Equal(t, "deposit 5", resp.Transactions[0].Description)
+
+		_, err = server.GetTransactions(ctx, &pokerrpc.GetTransactionsRequest{PlayerId: "player1", PageToken: "bogus"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = server.GetTransactions(ctx, &pokerrpc.GetTransactionsRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
 	t.Run("CreateTable", func(t *testing.T) {
 		// Create isolated database and server for this test
-		db := NewInMemoryDB()
+		db := newTestDatabase(t)
 		defer db.Close()
 
 		logBackend := createTestLogBackend()
@@ -331,7 +277,7 @@ func TestPokerService(t *testing.T) {
 
 	t.Run("GetGameState", func(t *testing.T) {
 		// Create isolated database and server for this test
-		db := NewInMemoryDB()
+		db := newTestDatabase(t)
 		defer db.Close()
 
 		logBackend := createTestLogBackend()
@@ -352,7 +298,7 @@ func TestPokerService(t *testing.T) {
 
 	t.Run("MakeBet", func(t *testing.T) {
 		// Create isolated database and server for this test
-		db := NewInMemoryDB()
+		db := newTestDatabase(t)
 		defer db.Close()
 
 		logBackend := createTestLogBackend()
@@ -419,7 +365,8 @@ func TestPokerService(t *testing.T) {
 		for i := 0; i < 10; i++ {
 			time.Sleep(10 * time.Millisecond)
 			gameState, err := server.GetGameState(ctx, &pokerrpc.GetGameStateRequest{
-				TableId: tableID,
+				TableId:  tableID,
+				PlayerId: player1ID,
 			})
 			require.NoError(t, err)
 			if gameState.GameState.GameStarted {
@@ -431,7 +378,8 @@ func TestPokerService(t *testing.T) {
 
 		// Get game state to find current player
 		gameState, err := server.GetGameState(ctx, &pokerrpc.GetGameStateRequest{
-			TableId: tableID,
+			TableId:  tableID,
+			PlayerId: player1ID,
 		})
 		require.NoError(t, err)
 		currentPlayer := gameState.GameState.CurrentPlayer
@@ -450,7 +398,7 @@ func TestPokerService(t *testing.T) {
 
 func TestPokerGameFlow(t *testing.T) {
 	// Create isolated database and server for this test
-	db := NewInMemoryDB()
+	db := newTestDatabase(t)
 	defer db.Close()
 
 	logBackend := createTestLogBackend()
@@ -527,7 +475,8 @@ func TestPokerGameFlow(t *testing.T) {
 
 		time.Sleep(50 * time.Millisecond)
 		gameState, err := server.GetGameState(ctx, &pokerrpc.GetGameStateRequest{
-			TableId: tableID,
+			TableId:  tableID,
+			PlayerId: alice,
 		})
 		require.NoError(t, err)
 		if gameState.GameState.GameStarted {
@@ -539,7 +488,8 @@ func TestPokerGameFlow(t *testing.T) {
 
 	// Verify game state
 	gameState, err := server.GetGameState(ctx, &pokerrpc.GetGameStateRequest{
-		TableId: tableID,
+		TableId:  tableID,
+		PlayerId: alice,
 	})
 	require.NoError(t, err)
 	assert.True(t, gameState.GameState.GameStarted)
@@ -548,7 +498,7 @@ func TestPokerGameFlow(t *testing.T) {
 
 func TestHostLeavesTableTransfersHost(t *testing.T) {
 	// Create isolated database and server for this test
-	db := NewInMemoryDB()
+	db := newTestDatabase(t)
 	defer db.Close()
 
 	logBackend := createTestLogBackend()
@@ -613,7 +563,7 @@ func TestHostLeavesTableTransfersHost(t *testing.T) {
 
 func TestLastPlayerLeavesTableClosure(t *testing.T) {
 	// Create isolated database and server for this test
-	db := NewInMemoryDB()
+	db := newTestDatabase(t)
 	defer db.Close()
 
 	logBackend := createTestLogBackend()
@@ -671,7 +621,7 @@ func TestLastPlayerLeavesTableClosure(t *testing.T) {
 
 func TestNonHostLeavesTable(t *testing.T) {
 	// Create isolated database and server for this test
-	db := NewInMemoryDB()
+	db := newTestDatabase(t)
 	defer db.Close()
 
 	logBackend := createTestLogBackend()
@@ -736,7 +686,7 @@ func TestNonHostLeavesTable(t *testing.T) {
 
 func TestLeaveTable(t *testing.T) {
 	// Create isolated database and server for this test
-	db := NewInMemoryDB()
+	db := newTestDatabase(t)
 	defer db.Close()
 
 	logBackend := createTestLogBackend()
@@ -760,7 +710,7 @@ func TestLeaveTable(t *testing.T) {
 
 func TestJoinTable(t *testing.T) {
 	// Create isolated database and server for this test
-	db := NewInMemoryDB()
+	db := newTestDatabase(t)
 	defer db.Close()
 
 	logBackend := createTestLogBackend()
@@ -852,7 +802,7 @@ func TestJoinTable(t *testing.T) {
 // snapshot correctly identifies the same player as the current player to act.
 func TestSnapshotRestoresCurrentPlayer(t *testing.T) {
 	// Use the same in-memory DB for the two server instances so that persisted state survives.
-	db := NewInMemoryDB()
+	db := newTestDatabase(t)
 	defer db.Close()
 
 	logBackend := createTestLogBackend()
@@ -915,7 +865,7 @@ func TestSnapshotRestoresCurrentPlayer(t *testing.T) {
 	var currentPlayer string
 	for i := 0; i < 20; i++ {
 		time.Sleep(25 * time.Millisecond)
-		stateResp, err := srv1.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID})
+		stateResp, err := srv1.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID, PlayerId: p1})
 		require.NoError(t, err)
 
 		if stateResp.GameState.GameStarted && stateResp.GameState.CurrentPlayer != "" {
@@ -932,7 +882,7 @@ func TestSnapshotRestoresCurrentPlayer(t *testing.T) {
 	srv2 := &TestServer{Server: NewServer(db, logBackend)}
 
 	// After restoration, the same player should still be the current player to act.
-	restoredState, err := srv2.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID})
+	restoredState, err := srv2.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID, PlayerId: p1})
 	require.NoError(t, err)
 	assert.Equal(t, currentPlayer, restoredState.GameState.CurrentPlayer, "current player should be restored correctly from snapshot")
 }
@@ -947,7 +897,7 @@ func (ts *TestServer) Close() {
 // Add new test to verify correct blind posting and balances in heads-up game
 func TestBlindPostingAndBalances(t *testing.T) {
 	// Create isolated in-memory DB and server
-	db := NewInMemoryDB()
+	db := newTestDatabase(t)
 	defer db.Close()
 
 	logBackend := createTestLogBackend()
@@ -1006,7 +956,7 @@ func TestBlindPostingAndBalances(t *testing.T) {
 	waitFor := func(cond func(*pokerrpc.GameUpdate) bool) *pokerrpc.GameUpdate {
 		deadline := time.Now().Add(2 * time.Second)
 		for time.Now().Before(deadline) {
-			st, err := srv.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID})
+			st, err := srv.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID, PlayerId: p1})
 			require.NoError(t, err)
 			if cond(st.GameState) {
 				return st.GameState
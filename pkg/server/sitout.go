@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultSitOutLimit is how long a player may sit out before they are cashed
+// out and removed from the table, when the table does not set its own limit.
+const DefaultSitOutLimit = 10 * time.Minute
+
+// sitOutRemovalReason is the reason given to players removed for sitting out.
+const sitOutRemovalReason = "sat out for too long"
+
+// sitOutLimit returns the sit-out limit asked for at table creation.
+func sitOutLimit(seconds int32) time.Duration {
+	if seconds <= 0 {
+		return DefaultSitOutLimit
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// SitOut keeps a player's seat while they are away: they are not dealt into
+// the following hands and post no blinds. Players who sit out for longer
+// than the table's limit are cashed out and removed.
+func (s *Server) SitOut(ctx context.Context, req *pokerrpc.SitOutRequest) (*pokerrpc.SitOutResponse, error) {
+	if req.PlayerId == "" || req.TableId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id and table_id are required")
+	}
+	s.mu.RLock()
+	table, ok := s.tables[req.TableId]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, status.Error(codes.NotFound, "table not found")
+	}
+
+	err := table.SitOut(req.PlayerId)
+	if errors.Is(err, poker.ErrAlreadySittingOut) {
+		return &pokerrpc.SitOutResponse{Success: false, Message: "You are already sitting out"}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	msg := "You are sitting out from the next hand"
+	if limit := table.GetConfig().SitOutLimit; limit > 0 {
+		msg = fmt.Sprintf("%s; sit in within %v to keep your seat", msg, limit)
+	}
+	return &pokerrpc.SitOutResponse{Success: true, Message: msg}, nil
+}
+
+// SitIn deals a player who sat out back in from the next hand.
+func (s *Server) SitIn(ctx context.Context, req *pokerrpc.SitInRequest) (*pokerrpc.SitInResponse, error) {
+	if req.PlayerId == "" || req.TableId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id and table_id are required")
+	}
+	s.mu.RLock()
+	table, ok := s.tables[req.TableId]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, status.Error(codes.NotFound, "table not found")
+	}
+
+	err := table.SitIn(req.PlayerId)
+	if errors.Is(err, poker.ErrNotSittingOut) {
+		return &pokerrpc.SitInResponse{Success: false, Message: "You are not sitting out"}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// A game that waited for enough players sitting in starts now.
+	if !table.IsGameStarted() && table.CheckAllPlayersReady() &&
+		!table.IsPaused() && !table.IsClosing() && !table.IsDraining() {
+		if err := s.startTableGame(req.TableId, table, req.PlayerId); err != nil && !errors.Is(err, poker.ErrNotEnoughSittingIn) {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to start game: %v", err))
+		}
+	}
+	return &pokerrpc.SitInResponse{Success: true, Message: "You are dealt in from the next hand"}, nil
+}
+
+// scheduleSitOutRemoval checks a table for players who sat out for too long
+// once the limit of a player who just sat out runs out.
+func (s *Server) scheduleSitOutRemoval(tableID, playerID string) {
+	s.mu.RLock()
+	table := s.tables[tableID]
+	s.mu.RUnlock()
+	if table == nil {
+		return
+	}
+	limit := table.GetConfig().SitOutLimit
+	if limit <= 0 {
+		return
+	}
+
+	s.sitOutMu.Lock()
+	defer s.sitOutMu.Unlock()
+	if s.sitOutTimers == nil {
+		// The server is stopping.
+		return
+	}
+	key := tableID + "/" + playerID
+	if t := s.sitOutTimers[key]; t != nil {
+		t.Stop()
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(limit, func() {
+		s.sitOutMu.Lock()
+		current := s.sitOutTimers[key] == timer
+		if current {
+			delete(s.sitOutTimers, key)
+		}
+		s.sitOutMu.Unlock()
+		if current {
+			s.removeExpiredSitOuts(tableID)
+		}
+	})
+	s.sitOutTimers[key] = timer
+}
+
+// stopSitOutRemovals stops the sit-out timers, for the server to stop.
+func (s *Server) stopSitOutRemovals() {
+	s.sitOutMu.Lock()
+	defer s.sitOutMu.Unlock()
+	for _, t := range s.sitOutTimers {
+		t.Stop()
+	}
+	s.sitOutTimers = nil
+}
+
+// cancelSitOutRemovals stops the sit-out timers of a removed table.
+func (s *Server) cancelSitOutRemovals(tableID string) {
+	s.sitOutMu.Lock()
+	defer s.sitOutMu.Unlock()
+	for key, t := range s.sitOutTimers {
+		if strings.HasPrefix(key, tableID+"/") {
+			t.Stop()
+			delete(s.sitOutTimers, key)
+		}
+	}
+}
+
+// removeExpiredSitOuts cashes out and removes the players of a table who sat
+// out for longer than its limit. Players cannot be removed during a hand;
+// they are removed once it ends.
+func (s *Server) removeExpiredSitOuts(tableID string) {
+	s.mu.RLock()
+	table := s.tables[tableID]
+	s.mu.RUnlock()
+	if table == nil || table.HandInProgress() {
+		return
+	}
+
+	for _, playerID := range table.ExpiredSitOuts() {
+		refund, err := s.kickPlayer(AuditActorServer, tableID, table, playerID)
+		if err != nil {
+			s.log.Errorf("Failed to remove %s, who sat out too long, from table %s: %v", playerID, tableID, err)
+			continue
+		}
+		s.publishModerationEvent(pokerrpc.NotificationType_PLAYER_KICKED, tableID,
+			PlayerKickedPayload{PlayerID: playerID, Reason: sitOutRemovalReason, Refund: refund, Auto: true}, playerID)
+	}
+}
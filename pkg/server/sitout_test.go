@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// notificationsOf returns the notifications of a type relayed to a player.
+func notificationsOf(relay *recordingRelay, playerID string, typ pokerrpc.NotificationType) []*pokerrpc.Notification {
+	relay.mu.Lock()
+	defer relay.mu.Unlock()
+	var found []*pokerrpc.Notification
+	for _, n := range relay.sent[playerID] {
+		if n.Type == typ {
+			found = append(found, n)
+		}
+	}
+	return found
+}
+
+func TestSitOutAndRemoval(t *testing.T) {
+	srv, database := newAccessTest(t)
+	ctx := context.Background()
+	relay := &recordingRelay{}
+	srv.SetNotificationRelay(relay)
+	created, err := srv.CreateTable(ctx, &pokerrpc.CreateTableRequest{
+		PlayerId:           "alice",
+		SmallBlind:         10,
+		BigBlind:           20,
+		MinPlayers:         2,
+		MaxPlayers:         6,
+		BuyIn:              100,
+		SitOutLimitSeconds: 1,
+	})
+	require.NoError(t, err)
+	tableID := created.TableId
+	for _, id := range []string{"bob", "carol"} {
+		require.True(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: id, TableId: tableID}).Success)
+	}
+
+	out, err := srv.SitOut(ctx, &pokerrpc.SitOutRequest{PlayerId: "carol", TableId: tableID})
+	require.NoError(t, err)
+	require.True(t, out.Success, out.Message)
+	out, err = srv.SitOut(ctx, &pokerrpc.SitOutRequest{PlayerId: "carol", TableId: tableID})
+	require.NoError(t, err)
+	assert.False(t, out.Success)
+	in, err := srv.SitIn(ctx, &pokerrpc.SitInRequest{PlayerId: "bob", TableId: tableID})
+	require.NoError(t, err)
+	assert.False(t, in.Success)
+	require.Eventually(t, func() bool {
+		return len(notificationsOf(relay, "bob", pokerrpc.NotificationType_PLAYER_SAT_OUT)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The game is dealt without carol, who keeps her seat.
+	for _, id := range []string{"alice", "bob", "carol"} {
+		_, err := srv.SetPlayerReady(ctx, &pokerrpc.SetPlayerReadyRequest{PlayerId: id, TableId: tableID})
+		require.NoError(t, err)
+	}
+	table := srv.tables[tableID]
+	require.True(t, table.IsGameStarted())
+	state, err := srv.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID, PlayerId: "alice"})
+	require.NoError(t, err)
+	sittingOut := make(map[string]bool)
+	for _, p := range state.GameState.Players {
+		sittingOut[p.Id] = p.SittingOut
+		assert.Equal(t, p.SittingOut, p.Folded, p.Id)
+	}
+	assert.Equal(t, map[string]bool{"alice": false, "bob": false, "carol": true}, sittingOut)
+
+	// Once the hand ends and her limit ran out, carol is cashed out.
+	current := table.GetCurrentPlayerID()
+	_, err = srv.FoldBet(ctx, &pokerrpc.FoldBetRequest{PlayerId: current, TableId: tableID})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return table.GetUser("carol") == nil }, 5*time.Second, 10*time.Millisecond)
+	requireBalance(t, database, "carol", 1000)
+	require.Eventually(t, func() bool {
+		return len(notificationsOf(relay, "carol", pokerrpc.NotificationType_PLAYER_KICKED)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	kicked := notificationsOf(relay, "carol", pokerrpc.NotificationType_PLAYER_KICKED)[0]
+	assert.True(t, kicked.Auto)
+	assert.Equal(t, int64(100), kicked.Amount)
+}
@@ -0,0 +1,338 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultSpectatorDelay is how far behind the game spectators watch a table
+// that does not set its own delay.
+const DefaultSpectatorDelay = 30 * time.Second
+
+// spectatorQueueSize bounds the updates of a table waiting out the delay.
+const spectatorQueueSize = 512
+
+// spectatorDelay returns the spectator delay asked for at table creation;
+// zero leaves the default.
+func spectatorDelay(seconds int32) time.Duration {
+	if seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// spectator is the game stream of a player watching a table.
+type spectator struct {
+	mu     sync.Mutex // Serializes sends on the stream
+	stream pokerrpc.PokerService_StartGameStreamServer
+	done   chan struct{} // Closed when the spectator is dropped
+}
+
+// send sends a game update to the spectator, ignoring errors as the client
+// might have disconnected.
+func (sp *spectator) send(update *pokerrpc.GameUpdate) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	sp.stream.Send(update)
+}
+
+// spectatorItem is a game update or notification waiting out the delay of a
+// feed, or the end of the feed.
+type spectatorItem struct {
+	at           time.Time
+	update       *pokerrpc.GameUpdate
+	notification *pokerrpc.Notification
+	end          bool
+}
+
+// spectatorFeed replays the public view of a table to its spectators,
+// delayed so that they cannot pass on live information to the players.
+type spectatorFeed struct {
+	tableID string
+	delay   time.Duration
+	queue   chan spectatorItem
+	stop    chan struct{}
+
+	// Protected by spectatorMu
+	spectators map[string]*spectator
+	last       *pokerrpc.GameUpdate // Latest update sent to the spectators
+}
+
+// spectateTable streams the delayed public view of a table, showing no hole
+// cards before the showdown, to a player who is not seated at it.
+func (s *Server) spectateTable(req *pokerrpc.StartGameStreamRequest, stream pokerrpc.PokerService_StartGameStreamServer) error {
+	if req.PlayerId == "" || req.TableId == "" {
+		return status.Error(codes.InvalidArgument, "player_id and table_id are required")
+	}
+	s.mu.RLock()
+	table, ok := s.tables[req.TableId]
+	s.mu.RUnlock()
+	if !ok {
+		return status.Error(codes.NotFound, "table not found")
+	}
+	if table.GetUser(req.PlayerId) != nil {
+		return status.Error(codes.FailedPrecondition, "players cannot watch a table they are seated at")
+	}
+	if err := s.checkCanWatch(table, req.PlayerId); err != nil {
+		return err
+	}
+
+	current := s.buildGameStateForPlayer(table, table.GetGame(), "")
+	sp, err := s.watchTable(table, req.PlayerId, stream, current)
+	if err != nil {
+		return err
+	}
+	defer s.unwatchTable(req.TableId, req.PlayerId, sp)
+
+	select {
+	case <-stream.Context().Done():
+	case <-sp.done:
+	}
+	return nil
+}
+
+// checkCanWatch returns an error unless a player who is not seated at a table
+// may see it: banned players cannot, and private tables are hidden from
+// players who were not invited.
+func (s *Server) checkCanWatch(table *poker.Table, playerID string) error {
+	tableID := table.GetConfig().ID
+	ban, err := s.db.GetTableBan(tableID, playerID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if ban != nil {
+		return status.Error(codes.PermissionDenied, "you are banned from this table")
+	}
+	access, err := s.db.GetTableAccess(tableID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	visible, err := s.tableVisible(table, access, playerID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if !visible {
+		return status.Error(codes.NotFound, "table not found")
+	}
+	return nil
+}
+
+// watchTable adds a spectator to the feed of a table, starting the feed for
+// the first one. The spectator gets the latest update of the feed right away,
+// or the current state once it is as old as the delay.
+func (s *Server) watchTable(table *poker.Table, playerID string, stream pokerrpc.PokerService_StartGameStreamServer, current *pokerrpc.GameUpdate) (*spectator, error) {
+	config := table.GetConfig()
+
+	s.spectatorMu.Lock()
+	if s.spectatorFeeds == nil {
+		s.spectatorMu.Unlock()
+		return nil, status.Error(codes.Unavailable, "server is shutting down")
+	}
+	feed := s.spectatorFeeds[config.ID]
+	if feed != nil && feed.spectators[playerID] == nil &&
+		config.MaxSpectators > 0 && len(feed.spectators) >= config.MaxSpectators {
+		s.spectatorMu.Unlock()
+		return nil, status.Errorf(codes.ResourceExhausted, "table allows at most %d spectators", config.MaxSpectators)
+	}
+	if feed == nil {
+		delay := config.SpectatorDelay
+		if delay <= 0 {
+			delay = DefaultSpectatorDelay
+		}
+		feed = &spectatorFeed{
+			tableID:    config.ID,
+			delay:      delay,
+			queue:      make(chan spectatorItem, spectatorQueueSize),
+			stop:       make(chan struct{}),
+			spectators: make(map[string]*spectator),
+		}
+		s.spectatorFeeds[config.ID] = feed
+		go s.runSpectatorFeed(feed)
+	}
+
+	// A new stream of the same player replaces the old one.
+	if old := feed.spectators[playerID]; old != nil {
+		close(old.done)
+	}
+	sp := &spectator{stream: stream, done: make(chan struct{})}
+	feed.spectators[playerID] = sp
+	last := feed.last
+	if last == nil {
+		s.enqueueSpectatorItem(feed, spectatorItem{update: current})
+	}
+
+	// Let the client know it is watching before the first, delayed, update,
+	// and send the latest update before the feed can send a newer one.
+	sp.mu.Lock()
+	s.spectatorMu.Unlock()
+	sp.stream.SendHeader(metadata.MD{})
+	if last != nil {
+		sp.stream.Send(last)
+	}
+	sp.mu.Unlock()
+
+	s.log.Debugf("%s is watching table %s", playerID, config.ID)
+	return sp, nil
+}
+
+// unwatchTable removes a spectator from the feed of a table, stopping the
+// feed after the last one.
+func (s *Server) unwatchTable(tableID, playerID string, sp *spectator) {
+	s.spectatorMu.Lock()
+	defer s.spectatorMu.Unlock()
+	feed := s.spectatorFeeds[tableID]
+	if feed == nil || feed.spectators[playerID] != sp {
+		return
+	}
+	delete(feed.spectators, playerID)
+	if len(feed.spectators) == 0 {
+		delete(s.spectatorFeeds, tableID)
+		close(feed.stop)
+	}
+}
+
+// spectatorCount returns the number of spectators watching a table.
+func (s *Server) spectatorCount(tableID string) int {
+	s.spectatorMu.Lock()
+	defer s.spectatorMu.Unlock()
+	if feed := s.spectatorFeeds[tableID]; feed != nil {
+		return len(feed.spectators)
+	}
+	return 0
+}
+
+// feedSpectators queues a public game update or notification of a table for
+// its spectators, if it has any.
+func (s *Server) feedSpectators(tableID string, item spectatorItem) {
+	s.spectatorMu.Lock()
+	defer s.spectatorMu.Unlock()
+	if feed := s.spectatorFeeds[tableID]; feed != nil {
+		s.enqueueSpectatorItem(feed, item)
+	}
+}
+
+// endSpectatorFeed drops the spectators of a removed table once they saw
+// what happened before its removal.
+func (s *Server) endSpectatorFeed(tableID string) {
+	s.feedSpectators(tableID, spectatorItem{end: true})
+}
+
+// enqueueSpectatorItem queues an item to be sent once it is as old as the
+// delay of the feed, dropping it when the queue is full. Assumes spectatorMu
+// is held.
+func (s *Server) enqueueSpectatorItem(feed *spectatorFeed, item spectatorItem) {
+	item.at = time.Now().Add(feed.delay)
+	select {
+	case feed.queue <- item:
+	default:
+		s.log.Warnf("Spectator feed of table %s is full; dropping update", feed.tableID)
+	}
+}
+
+// runSpectatorFeed sends the queued items of a feed to its spectators as
+// they come out of the delay, until the feed stops.
+func (s *Server) runSpectatorFeed(feed *spectatorFeed) {
+	for {
+		var item spectatorItem
+		select {
+		case <-feed.stop:
+			return
+		case item = <-feed.queue:
+		}
+
+		if wait := time.Until(item.at); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-feed.stop:
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+
+		if item.end || (item.notification != nil && item.notification.Type == pokerrpc.NotificationType_TABLE_CLOSED) {
+			s.sendToSpectators(feed, item)
+			s.dropSpectatorFeed(feed)
+			return
+		}
+		s.sendToSpectators(feed, item)
+	}
+}
+
+// sendToSpectators sends a game update to the game streams of the
+// spectators of a feed, or a notification to their notification streams.
+func (s *Server) sendToSpectators(feed *spectatorFeed, item spectatorItem) {
+	s.spectatorMu.Lock()
+	if item.update != nil {
+		feed.last = item.update
+	}
+	spectators := make(map[string]*spectator, len(feed.spectators))
+	for id, sp := range feed.spectators {
+		spectators[id] = sp
+	}
+	s.spectatorMu.Unlock()
+
+	for playerID, sp := range spectators {
+		if item.update != nil {
+			sp.send(item.update)
+		}
+		if item.notification != nil {
+			s.notifySpectator(playerID, item.notification)
+		}
+	}
+}
+
+// notifySpectator sends a notification to the notification stream of a
+// spectator. Spectators without one do not get table notifications.
+func (s *Server) notifySpectator(playerID string, notification *pokerrpc.Notification) {
+	s.notificationMu.RLock()
+	notifStream, exists := s.notificationStreams[playerID]
+	s.notificationMu.RUnlock()
+	if !exists {
+		return
+	}
+	select {
+	case <-notifStream.done:
+	default:
+		notifStream.stream.Send(notification)
+	}
+}
+
+// dropSpectatorFeed removes a feed and ends the streams of its spectators.
+func (s *Server) dropSpectatorFeed(feed *spectatorFeed) {
+	s.spectatorMu.Lock()
+	defer s.spectatorMu.Unlock()
+	if s.spectatorFeeds[feed.tableID] == feed {
+		delete(s.spectatorFeeds, feed.tableID)
+		close(feed.stop)
+	}
+	for id, sp := range feed.spectators {
+		close(sp.done)
+		delete(feed.spectators, id)
+	}
+}
+
+// stopSpectatorFeeds stops all feeds and ends the streams of their
+// spectators, for the server to stop.
+func (s *Server) stopSpectatorFeeds() {
+	s.spectatorMu.Lock()
+	feeds := make([]*spectatorFeed, 0, len(s.spectatorFeeds))
+	for _, feed := range s.spectatorFeeds {
+		feeds = append(feeds, feed)
+	}
+	s.spectatorMu.Unlock()
+
+	for _, feed := range feeds {
+		s.dropSpectatorFeed(feed)
+	}
+
+	s.spectatorMu.Lock()
+	s.spectatorFeeds = nil
+	s.spectatorMu.Unlock()
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// spectatorStream is a game stream that ends with its context.
+type spectatorStream struct {
+	mockGameStream
+	ctx context.Context
+}
+
+func (m *spectatorStream) Context() context.Context { return m.ctx }
+
+func (m *spectatorStream) received() []*pokerrpc.GameUpdate {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*pokerrpc.GameUpdate(nil), m.updates...)
+}
+
+func TestSpectateTable(t *testing.T) {
+	srv, _ := newAccessTest(t)
+	ctx := context.Background()
+	created, err := srv.CreateTable(ctx, &pokerrpc.CreateTableRequest{
+		PlayerId:              "alice",
+		SmallBlind:            10,
+		BigBlind:              20,
+		MinPlayers:            2,
+		MaxPlayers:            2,
+		BuyIn:                 100,
+		MaxSpectators:         1,
+		SpectatorDelaySeconds: 1,
+	})
+	require.NoError(t, err)
+	tableID := created.TableId
+	require.True(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID}).Success)
+
+	// Seated players cannot watch their own table.
+	err = srv.StartGameStream(&pokerrpc.StartGameStreamRequest{PlayerId: "alice", TableId: tableID, Spectate: true},
+		&spectatorStream{ctx: ctx})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	watchCtx, stopWatching := context.WithCancel(ctx)
+	defer stopWatching()
+	stream := &spectatorStream{ctx: watchCtx}
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.StartGameStream(&pokerrpc.StartGameStreamRequest{PlayerId: "carol", TableId: tableID, Spectate: true}, stream)
+	}()
+	require.Eventually(t, func() bool { return srv.spectatorCount(tableID) == 1 }, time.Second, 10*time.Millisecond)
+	tables, err := srv.GetTables(ctx, &pokerrpc.GetTablesRequest{})
+	require.NoError(t, err)
+	require.Len(t, tables.Tables, 1)
+	assert.Equal(t, int32(1), tables.Tables[0].Spectators)
+
+	// The host limits the table to one spectator.
+	err = srv.StartGameStream(&pokerrpc.StartGameStreamRequest{PlayerId: "dave", TableId: tableID, Spectate: true},
+		&spectatorStream{ctx: ctx})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Even the initial state reaches the spectator only after the delay.
+	assert.Empty(t, stream.received())
+	require.Eventually(t, func() bool { return len(stream.received()) > 0 }, 3*time.Second, 10*time.Millisecond)
+
+	for _, id := range []string{"alice", "bob"} {
+		_, err := srv.SetPlayerReady(ctx, &pokerrpc.SetPlayerReadyRequest{PlayerId: id, TableId: tableID})
+		require.NoError(t, err)
+	}
+	started := time.Now()
+	require.Eventually(t, func() bool {
+		for _, u := range stream.received() {
+			if u.GameStarted {
+				return true
+			}
+		}
+		return false
+	}, 3*time.Second, 10*time.Millisecond)
+	assert.GreaterOrEqual(t, time.Since(started), 900*time.Millisecond)
+
+	// Spectators see no hole cards before the showdown.
+	for _, u := range stream.received() {
+		if u.Phase == pokerrpc.GamePhase_SHOWDOWN {
+			continue
+		}
+		for _, p := range u.Players {
+			assert.Empty(t, p.Hand, p.Id)
+		}
+	}
+
+	stopWatching()
+	require.NoError(t, <-done)
+	assert.Zero(t, srv.spectatorCount(tableID))
+}
+
+func TestGameStateRequiresSeat(t *testing.T) {
+	srv, _ := newAccessTest(t)
+	ctx := context.Background()
+	tableID := createAccessTable(t, srv, false, "")
+	require.True(t, joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: tableID}).Success)
+	for _, id := range []string{"alice", "bob"} {
+		_, err := srv.SetPlayerReady(ctx, &pokerrpc.SetPlayerReadyRequest{PlayerId: id, TableId: tableID})
+		require.NoError(t, err)
+	}
+
+	state, err := srv.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID, PlayerId: "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, pokerrpc.GamePhase_PRE_FLOP, state.GameState.Phase)
+
+	// Players not seated at the table only get its delayed spectator view.
+	_, err = srv.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID, PlayerId: "carol"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.BanPlayer(ctx, &pokerrpc.BanPlayerRequest{PlayerId: "alice", TableId: tableID, TargetId: "dave"})
+	require.NoError(t, err)
+	_, err = srv.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: tableID, PlayerId: "dave"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	privateID := createAccessTable(t, srv, true, "")
+	_, err = srv.GetGameState(ctx, &pokerrpc.GetGameStateRequest{TableId: privateID, PlayerId: "carol"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,317 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultSeatOfferWindow is how long a player offered a seat from the
+// waitlist has to take it before it is offered to the next player waiting.
+const DefaultSeatOfferWindow = time.Minute
+
+// fullTableMessage is the join reply when no seat is free.
+const fullTableMessage = "Table is full; join its waitlist to be offered the next free seat"
+
+// seatOffer is a seat held for a waitlisted player until they take it or
+// the offer expires.
+type seatOffer struct {
+	seat  int
+	timer *time.Timer
+}
+
+// tableWaitlist is the players waiting for a seat at a table, first in line
+// first, and the seats offered to them.
+type tableWaitlist struct {
+	players []string
+	offers  map[string]*seatOffer
+}
+
+// SetSeatOfferWindow sets how long waitlisted players have to take a seat
+// offered to them. Zero restores DefaultSeatOfferWindow.
+func (s *Server) SetSeatOfferWindow(d time.Duration) {
+	if d <= 0 {
+		d = DefaultSeatOfferWindow
+	}
+	s.waitMu.Lock()
+	s.offerWindow = d
+	s.waitMu.Unlock()
+}
+
+// loadWaitlists restores the waitlists of the loaded tables. Entries of
+// tables that no longer exist are deleted.
+func (s *Server) loadWaitlists() error {
+	entries, err := s.db.GetWaitlistEntries()
+	if err != nil {
+		return err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.waitMu.Lock()
+	defer s.waitMu.Unlock()
+	for _, e := range entries {
+		if _, ok := s.tables[e.TableID]; !ok {
+			if err := s.db.DeleteWaitlistEntry(e.TableID, e.PlayerID); err != nil {
+				s.log.Errorf("Failed to delete waitlist entry of %s: %v", e.PlayerID, err)
+			}
+			continue
+		}
+		wl := s.waitlists[e.TableID]
+		if wl == nil {
+			wl = &tableWaitlist{offers: make(map[string]*seatOffer)}
+			s.waitlists[e.TableID] = wl
+		}
+		wl.players = append(wl.players, e.PlayerID)
+	}
+	return nil
+}
+
+// waitlist returns the players waiting for a seat at a table, first in line
+// first.
+func (s *Server) waitlist(tableID string) []string {
+	s.waitMu.Lock()
+	defer s.waitMu.Unlock()
+	wl := s.waitlists[tableID]
+	if wl == nil {
+		return nil
+	}
+	return append([]string(nil), wl.players...)
+}
+
+// JoinWaitlist puts a player in line for a seat at a full table. When a seat
+// opens it is held for the first player in line, who is notified with
+// SEAT_OFFERED and joins the table to take it.
+func (s *Server) JoinWaitlist(ctx context.Context, req *pokerrpc.JoinWaitlistRequest) (*pokerrpc.JoinWaitlistResponse, error) {
+	if req.PlayerId == "" || req.TableId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id and table_id are required")
+	}
+	if isAIPlayerID(req.PlayerId) {
+		return &pokerrpc.JoinWaitlistResponse{Success: false, Message: reservedIDMessage}, nil
+	}
+
+	s.mu.RLock()
+	table, ok := s.tables[req.TableId]
+	draining := s.draining
+	s.mu.RUnlock()
+	if !ok {
+		return &pokerrpc.JoinWaitlistResponse{Success: false, Message: "Table not found"}, nil
+	}
+	if draining {
+		return &pokerrpc.JoinWaitlistResponse{Success: false, Message: drainingMessage}, nil
+	}
+	if table.GetUser(req.PlayerId) != nil {
+		return &pokerrpc.JoinWaitlistResponse{Success: false, Message: "You are already at this table"}, nil
+	}
+
+	denied, err := s.joinDenied(table, &pokerrpc.JoinTableRequest{
+		PlayerId:   req.PlayerId,
+		TableId:    req.TableId,
+		Password:   req.Password,
+		InviteCode: req.InviteCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if denied != "" {
+		return &pokerrpc.JoinWaitlistResponse{Success: false, Message: denied}, nil
+	}
+
+	s.waitMu.Lock()
+	defer s.waitMu.Unlock()
+	wl := s.waitlists[req.TableId]
+	if wl == nil {
+		wl = &tableWaitlist{offers: make(map[string]*seatOffer)}
+	}
+	for i, id := range wl.players {
+		if id == req.PlayerId {
+			return &pokerrpc.JoinWaitlistResponse{
+				Success:  true,
+				Message:  fmt.Sprintf("You are already number %d on the waitlist", i+1),
+				Position: int32(i + 1),
+			}, nil
+		}
+	}
+	if _, err := table.FreeSeat(req.PlayerId); err == nil {
+		return &pokerrpc.JoinWaitlistResponse{Success: false, Message: "A seat is free; join the table directly"}, nil
+	}
+
+	entry := WaitlistEntry{TableID: req.TableId, PlayerID: req.PlayerId, JoinedAt: time.Now().UnixNano()}
+	if err := s.db.AddWaitlistEntry(entry); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save waitlist entry: %v", err)
+	}
+	wl.players = append(wl.players, req.PlayerId)
+	s.waitlists[req.TableId] = wl
+
+	s.log.Debugf("Player %s is number %d on the waitlist of table %s", req.PlayerId, len(wl.players), req.TableId)
+	return &pokerrpc.JoinWaitlistResponse{
+		Success:  true,
+		Message:  fmt.Sprintf("You are number %d on the waitlist", len(wl.players)),
+		Position: int32(len(wl.players)),
+	}, nil
+}
+
+// LeaveWaitlist takes a player out of a table's waitlist, giving up a seat
+// offered to them.
+func (s *Server) LeaveWaitlist(ctx context.Context, req *pokerrpc.LeaveWaitlistRequest) (*pokerrpc.LeaveWaitlistResponse, error) {
+	if req.PlayerId == "" || req.TableId == "" {
+		return nil, status.Error(codes.InvalidArgument, "player_id and table_id are required")
+	}
+	s.mu.RLock()
+	table := s.tables[req.TableId]
+	s.mu.RUnlock()
+
+	if !s.leaveWaitlist(req.TableId, table, req.PlayerId) {
+		return &pokerrpc.LeaveWaitlistResponse{Success: false, Message: "You are not on the waitlist of this table"}, nil
+	}
+	return &pokerrpc.LeaveWaitlistResponse{Success: true, Message: "Left the waitlist"}, nil
+}
+
+// leaveWaitlist removes a player from the waitlist of table and offers a
+// seat they gave up to the next player waiting. It reports whether they were
+// waiting.
+func (s *Server) leaveWaitlist(tableID string, table *poker.Table, playerID string) bool {
+	waiting, offered := s.removeFromWaitlist(tableID, playerID)
+	if offered && table != nil {
+		table.ReleaseSeat(playerID)
+		s.offerSeats(tableID, table)
+	}
+	return waiting
+}
+
+// removeFromWaitlist removes a player from the waitlist of a table,
+// withdrawing any seat offered to them; the seat itself stays held. It
+// reports whether the player was waiting and whether a seat was offered.
+func (s *Server) removeFromWaitlist(tableID, playerID string) (waiting, offered bool) {
+	s.waitMu.Lock()
+	defer s.waitMu.Unlock()
+	wl := s.waitlists[tableID]
+	if wl == nil {
+		return false, false
+	}
+	for i, id := range wl.players {
+		if id == playerID {
+			wl.players = append(wl.players[:i], wl.players[i+1:]...)
+			waiting = true
+			break
+		}
+	}
+	if !waiting {
+		return false, false
+	}
+	if o := wl.offers[playerID]; o != nil {
+		o.timer.Stop()
+		delete(wl.offers, playerID)
+		offered = true
+	}
+	if len(wl.players) == 0 {
+		delete(s.waitlists, tableID)
+	}
+	if err := s.db.DeleteWaitlistEntry(tableID, playerID); err != nil {
+		s.log.Errorf("Failed to delete waitlist entry of %s: %v", playerID, err)
+	}
+	return waiting, offered
+}
+
+// dropWaitlist forgets the waitlist of a removed table. Its entries are
+// deleted from the database along with the table's access.
+func (s *Server) dropWaitlist(tableID string) {
+	s.waitMu.Lock()
+	defer s.waitMu.Unlock()
+	if wl := s.waitlists[tableID]; wl != nil {
+		for _, o := range wl.offers {
+			o.timer.Stop()
+		}
+		delete(s.waitlists, tableID)
+	}
+}
+
+// stopSeatOffers stops the timers of the seat offers, for the server to stop.
+// The players stay on the waitlists.
+func (s *Server) stopSeatOffers() {
+	s.waitMu.Lock()
+	defer s.waitMu.Unlock()
+	for _, wl := range s.waitlists {
+		for playerID, o := range wl.offers {
+			o.timer.Stop()
+			delete(wl.offers, playerID)
+		}
+	}
+}
+
+// offerSeats holds each free seat of table for the next waitlisted player
+// without an offer and notifies them. A player who does not take the seat
+// within the offer window loses their place and the seat goes to the next
+// player. It does not take s.mu.
+func (s *Server) offerSeats(tableID string, table *poker.Table) {
+	if table.IsDraining() || table.IsClosing() {
+		return
+	}
+
+	s.waitMu.Lock()
+	wl := s.waitlists[tableID]
+	if wl == nil {
+		s.waitMu.Unlock()
+		return
+	}
+	window := s.offerWindow
+	var offered []string
+	for _, playerID := range wl.players {
+		if wl.offers[playerID] != nil {
+			continue
+		}
+		seat, err := table.FreeSeat(playerID)
+		if errors.Is(err, poker.ErrNoFreeSeat) {
+			break
+		}
+		if err != nil {
+			s.log.Errorf("Failed to find a seat for %s at table %s: %v", playerID, tableID, err)
+			break
+		}
+		if _, err := table.ReserveSeat(playerID, seat, window); err != nil {
+			s.log.Errorf("Failed to hold seat %d for %s at table %s: %v", seat+1, playerID, tableID, err)
+			break
+		}
+		o := &seatOffer{seat: seat}
+		o.timer = time.AfterFunc(window, func() { s.expireOffer(tableID, table, playerID, o) })
+		wl.offers[playerID] = o
+		offered = append(offered, playerID)
+	}
+	seats := make([]int, len(offered))
+	for i, playerID := range offered {
+		seats[i] = wl.offers[playerID].seat
+	}
+	s.waitMu.Unlock()
+
+	for i, playerID := range offered {
+		s.log.Debugf("Offered seat %d at table %s to %s", seats[i]+1, tableID, playerID)
+		s.notifyPlayer(playerID, &pokerrpc.Notification{
+			Type:      pokerrpc.NotificationType_SEAT_OFFERED,
+			Message:   fmt.Sprintf("Seat %d is free; join the table within %v to take it", seats[i]+1, window),
+			TableId:   tableID,
+			PlayerId:  playerID,
+			Seat:      int32(seats[i] + 1),
+			Countdown: int32(window / time.Second),
+		})
+	}
+}
+
+// expireOffer drops a player who did not take the seat offered to them from
+// the waitlist and offers the seat to the next player waiting.
+func (s *Server) expireOffer(tableID string, table *poker.Table, playerID string, o *seatOffer) {
+	s.waitMu.Lock()
+	wl := s.waitlists[tableID]
+	current := wl != nil && wl.offers[playerID] == o
+	s.waitMu.Unlock()
+	if !current {
+		// Taken, withdrawn or replaced meanwhile.
+		return
+	}
+	s.log.Debugf("Seat offer to %s at table %s expired", playerID, tableID)
+	s.leaveWaitlist(tableID, table, playerID)
+}
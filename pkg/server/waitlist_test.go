@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// createFullTable creates a heads-up table hosted by alice at which bob sits.
+func createFullTable(t *testing.T, srv *Server) string {
+	t.Helper()
+	resp, err := srv.CreateTable(context.Background(), &pokerrpc.CreateTableRequest{
+		PlayerId:   "alice",
+		SmallBlind: 10,
+		BigBlind:   20,
+		MinPlayers: 2,
+		MaxPlayers: 2,
+		BuyIn:      100,
+	})
+	require.NoError(t, err)
+	joined := joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "bob", TableId: resp.TableId})
+	require.True(t, joined.Success, joined.Message)
+	return resp.TableId
+}
+
+// seatOffers returns the SEAT_OFFERED notifications relayed to a player.
+func seatOffers(relay *recordingRelay, playerID string) []*pokerrpc.Notification {
+	relay.mu.Lock()
+	defer relay.mu.Unlock()
+	var offers []*pokerrpc.Notification
+	for _, n := range relay.sent[playerID] {
+		if n.Type == pokerrpc.NotificationType_SEAT_OFFERED {
+			offers = append(offers, n)
+		}
+	}
+	return offers
+}
+
+func TestWaitlistOffersFreedSeats(t *testing.T) {
+	srv, _ := newAccessTest(t)
+	ctx := context.Background()
+	relay := &recordingRelay{}
+	srv.SetNotificationRelay(relay)
+	srv.SetSeatOfferWindow(200 * time.Millisecond)
+	tableID := createFullTable(t, srv)
+
+	resp := joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "carol", TableId: tableID})
+	require.False(t, resp.Success)
+	assert.Equal(t, fullTableMessage, resp.Message)
+
+	for i, id := range []string{"carol", "dave"} {
+		waiting, err := srv.JoinWaitlist(ctx, &pokerrpc.JoinWaitlistRequest{PlayerId: id, TableId: tableID})
+		require.NoError(t, err)
+		require.True(t, waiting.Success, waiting.Message)
+		assert.EqualValues(t, i+1, waiting.Position)
+	}
+	again, err := srv.JoinWaitlist(ctx, &pokerrpc.JoinWaitlistRequest{PlayerId: "carol", TableId: tableID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, again.Position)
+	seated, err := srv.JoinWaitlist(ctx, &pokerrpc.JoinWaitlistRequest{PlayerId: "bob", TableId: tableID})
+	require.NoError(t, err)
+	assert.False(t, seated.Success)
+	assert.Equal(t, []string{"carol", "dave"}, listedTables(t, srv, "carol")[tableID].Waitlist)
+
+	// The seat bob gives up is held for carol, first in line.
+	left, err := srv.LeaveTable(ctx, &pokerrpc.LeaveTableRequest{PlayerId: "bob", TableId: tableID})
+	require.NoError(t, err)
+	require.True(t, left.Success, left.Message)
+	offers := seatOffers(relay, "carol")
+	require.Len(t, offers, 1)
+	assert.Equal(t, tableID, offers[0].TableId)
+	assert.EqualValues(t, 2, offers[0].Seat)
+	assert.Empty(t, seatOffers(relay, "dave"))
+	resp = joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "dave", TableId: tableID})
+	assert.False(t, resp.Success)
+
+	// Carol lets the offer lapse, so the seat goes to dave.
+	require.Eventually(t, func() bool { return len(seatOffers(relay, "dave")) == 1 },
+		5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"dave"}, listedTables(t, srv, "dave")[tableID].Waitlist)
+	resp = joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "carol", TableId: tableID})
+	assert.False(t, resp.Success)
+
+	resp = joinTable(t, srv, &pokerrpc.JoinTableRequest{PlayerId: "dave", TableId: tableID})
+	require.True(t, resp.Success, resp.Message)
+	assert.EqualValues(t, 2, resp.Seat)
+	assert.Empty(t, listedTables(t, srv, "dave")[tableID].Waitlist)
+}
+
+func TestLeaveWaitlist(t *testing.T) {
+	srv, _ := newAccessTest(t)
+	ctx := context.Background()
+	relay := &recordingRelay{}
+	srv.SetNotificationRelay(relay)
+	tableID := createFullTable(t, srv)
+
+	for _, id := range []string{"carol", "dave"} {
+		waiting, err := srv.JoinWaitlist(ctx, &pokerrpc.JoinWaitlistRequest{PlayerId: id, TableId: tableID})
+		require.NoError(t, err)
+		require.True(t, waiting.Success, waiting.Message)
+	}
+	left, err := srv.LeaveTable(ctx, &pokerrpc.LeaveTableRequest{PlayerId: "bob", TableId: tableID})
+	require.NoError(t, err)
+	require.True(t, left.Success, left.Message)
+	require.Len(t, seatOffers(relay, "carol"), 1)
+
+	// Declining the offer passes the seat on at once.
+	declined, err := srv.LeaveWaitlist(ctx, &pokerrpc.LeaveWaitlistRequest{PlayerId: "carol", TableId: tableID})
+	require.NoError(t, err)
+	require.True(t, declined.Success, declined.Message)
+	require.Len(t, seatOffers(relay, "dave"), 1)
+	assert.Equal(t, []string{"dave"}, listedTables(t, srv, "dave")[tableID].Waitlist)
+
+	declined, err = srv.LeaveWaitlist(ctx, &pokerrpc.LeaveWaitlistRequest{PlayerId: "carol", TableId: tableID})
+	require.NoError(t, err)
+	assert.False(t, declined.Success)
+}
+
+func TestWaitlistSurvivesRestart(t *testing.T) {
+	srv, database := newAccessTest(t)
+	ctx := context.Background()
+	tableID := createFullTable(t, srv)
+
+	for _, id := range []string{"dave", "carol"} {
+		waiting, err := srv.JoinWaitlist(ctx, &pokerrpc.JoinWaitlistRequest{PlayerId: id, TableId: tableID})
+		require.NoError(t, err)
+		require.True(t, waiting.Success, waiting.Message)
+	}
+	require.NoError(t, srv.saveTableState(tableID))
+	srv.Stop()
+
+	logBackend := createTestLogBackend()
+	t.Cleanup(func() { logBackend.Close() })
+	restarted := NewServer(database, logBackend)
+	t.Cleanup(restarted.Stop)
+	table := listedTables(t, restarted, "carol")[tableID]
+	require.NotNil(t, table)
+	assert.Equal(t, []string{"dave", "carol"}, table.Waitlist)
+}
@@ -0,0 +1,215 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/decred/slog"
+	"github.com/vctt94/pokerbisonrelay/pkg/server/internal/db"
+)
+
+// Withdrawal is a request to pay part of a player's balance out.
+type Withdrawal = db.Withdrawal
+
+// WithdrawalEvent is an entry of a withdrawal's audit trail.
+type WithdrawalEvent = db.WithdrawalEvent
+
+// Withdrawal statuses.
+const (
+	WithdrawalPending   = db.WithdrawalPending
+	WithdrawalSent      = db.WithdrawalSent
+	WithdrawalConfirmed = db.WithdrawalConfirmed
+	WithdrawalReversed  = db.WithdrawalReversed
+)
+
+// Withdrawal payout methods.
+const (
+	WithdrawalMethodTip     = "tip"     // Outgoing Bison Relay tip to the player
+	WithdrawalMethodAddress = "address" // Payment to the player's payout address
+)
+
+// withdrawalWindow is the period the daily withdrawal limit applies to.
+const withdrawalWindow = 24 * time.Hour
+
+var (
+	// ErrInsufficientBalance is returned when a withdrawal exceeds the
+	// player's balance.
+	ErrInsufficientBalance = db.ErrInsufficientBalance
+	// ErrWithdrawalLimit is returned when a withdrawal would exceed the
+	// daily withdrawal limit.
+	ErrWithdrawalLimit = db.ErrWithdrawalLimit
+	// ErrInvalidWithdrawalAmount is returned for non-positive amounts.
+	ErrInvalidWithdrawalAmount = errors.New("withdrawal amount must be positive")
+)
+
+// PayoutSender pays withdrawals out. Implementations wrap the Bison Relay
+// client or a wallet; tests use a fake.
+type PayoutSender interface {
+	// SendTip pays amount atoms to the player as a Bison Relay tip. A nil
+	// error means the tip was queued; its outcome is reported later through
+	// Withdrawals.HandleTipProgress.
+	SendTip(ctx context.Context, playerID string, amount int64) error
+	// SendToAddress pays amount atoms to address and returns a reference to
+	// the payment, such as a transaction hash. The payment is final once it
+	// returns without error.
+	SendToAddress(ctx context.Context, address string, amount int64) (string, error)
+}
+
+// Withdrawals runs the withdrawal pipeline: the requested amount is debited
+// into a pending withdrawal, handed to the PayoutSender and then confirmed or
+// reversed. Every step is recorded in the withdrawal's audit trail.
+type Withdrawals struct {
+	db         Database
+	sender     PayoutSender
+	dailyLimit int64 // Atoms per player per 24h; 0 for no limit
+	log        slog.Logger
+	audit      *auditLog // Set by Server.SetWithdrawals
+
+	// mu serializes tip progress handling so that concurrent events are
+	// matched against distinct withdrawals.
+	mu sync.Mutex
+}
+
+// NewWithdrawals creates a withdrawal pipeline paying out through sender.
+// dailyLimit bounds the atoms a player may withdraw in any 24 hours; 0
+// disables the limit.
+func NewWithdrawals(database Database, sender PayoutSender, dailyLimit int64, log slog.Logger) *Withdrawals {
+	return &Withdrawals{
+		db:         database,
+		sender:     sender,
+		dailyLimit: dailyLimit,
+		log:        log,
+	}
+}
+
+// DailyLimit returns the per-player daily withdrawal limit in atoms, 0 when
+// unlimited.
+func (w *Withdrawals) DailyLimit() int64 {
+	return w.dailyLimit
+}
+
+// Request withdraws amount atoms from the player's balance. The payout goes
+// to payoutAddress when set and as a Bison Relay tip otherwise. The returned
+// withdrawal reflects its status after the payout was attempted; when the
+// payout could not be made it is reversed and an error is returned along
+// with it.
+func (w *Withdrawals) Request(ctx context.Context, playerID string, amount int64, payoutAddress string) (*Withdrawal, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidWithdrawalAmount
+	}
+
+	req := &Withdrawal{
+		PlayerID:    playerID,
+		Amount:      amount,
+		Method:      WithdrawalMethodTip,
+		Destination: payoutAddress,
+		Detail:      "requested",
+	}
+	if payoutAddress != "" {
+		req.Method = WithdrawalMethodAddress
+	}
+
+	var id int64
+	err := w.audit.balanceChange(AuditRecord{Actor: playerID}, func(audit db.AuditFunc) error {
+		var err error
+		id, err = w.db.CreateWithdrawal(req, w.dailyLimit, time.Now().Add(-withdrawalWindow), audit)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	w.log.Infof("Withdrawal %d: %d atoms for %s via %s", id, amount, playerID, req.Method)
+
+	var payErr error
+	switch req.Method {
+	case WithdrawalMethodAddress:
+		var ref string
+		ref, payErr = w.sender.SendToAddress(ctx, payoutAddress, amount)
+		if payErr == nil {
+			err = w.updateWithdrawal(playerID, id, WithdrawalConfirmed, "paid to "+payoutAddress+": "+ref)
+		}
+	default:
+		// Mark the withdrawal sent first: tip progress may be reported
+		// before SendTip returns and only sent withdrawals are matched.
+		if err = w.updateWithdrawal(playerID, id, WithdrawalSent, "sending tip"); err == nil {
+			payErr = w.sender.SendTip(ctx, playerID, amount)
+		}
+	}
+	if payErr != nil {
+		w.log.Warnf("Withdrawal %d: payout failed: %v", id, payErr)
+		if err := w.updateWithdrawal(playerID, id, WithdrawalReversed, "payout failed: "+payErr.Error()); err != nil {
+			return nil, fmt.Errorf("failed to reverse withdrawal %d after payout error %v: %w", id, payErr, err)
+		}
+		payErr = fmt.Errorf("payout failed: %w", payErr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update withdrawal %d: %w", id, err)
+	}
+
+	withdrawal, err := w.db.GetWithdrawal(id)
+	if err != nil {
+		return nil, err
+	}
+	return withdrawal, payErr
+}
+
+// HandleTipProgress settles the oldest sent tip withdrawal of the player for
+// amount atoms from a Bison Relay tip progress event. Completed tips confirm
+// the withdrawal and tips that will not be retried reverse it; other attempts
+// are only recorded. It returns the matched withdrawal, or nil when the event
+// does not belong to any withdrawal.
+func (w *Withdrawals) HandleTipProgress(playerID string, amount int64, completed, willRetry bool, attemptErr string) (*Withdrawal, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	sent, err := w.db.GetWithdrawals(playerID, WithdrawalSent, 0)
+	if err != nil {
+		return nil, err
+	}
+	var match *Withdrawal
+	for _, wd := range sent { // Newest first; keep the oldest match.
+		if wd.Method == WithdrawalMethodTip && wd.Amount == amount {
+			match = wd
+		}
+	}
+	if match == nil {
+		return nil, nil
+	}
+
+	status, detail := WithdrawalSent, "tip attempt failed, retrying: "+attemptErr
+	switch {
+	case completed:
+		status, detail = WithdrawalConfirmed, "tip completed"
+	case !willRetry:
+		status, detail = WithdrawalReversed, "tip failed: "+attemptErr
+	}
+	if err := w.updateWithdrawal(playerID, match.ID, status, detail); err != nil {
+		return nil, err
+	}
+	w.log.Infof("Withdrawal %d: %s (%s)", match.ID, status, detail)
+	return w.db.GetWithdrawal(match.ID)
+}
+
+// updateWithdrawal moves withdrawal id of playerID to status. The credit of a
+// reversal is recorded in the audit log in the same transaction.
+func (w *Withdrawals) updateWithdrawal(playerID string, id int64, status, detail string) error {
+	if status != WithdrawalReversed {
+		return w.db.UpdateWithdrawal(id, status, detail, nil)
+	}
+	return w.audit.balanceChange(AuditRecord{Actor: playerID}, func(audit db.AuditFunc) error {
+		return w.db.UpdateWithdrawal(id, status, detail, audit)
+	})
+}
+
+// List returns the player's most recent withdrawals, newest first.
+func (w *Withdrawals) List(playerID string, limit int) ([]*Withdrawal, error) {
+	return w.db.GetWithdrawals(playerID, "", limit)
+}
+
+// Events returns the audit trail of a withdrawal, oldest first.
+func (w *Withdrawals) Events(id int64) ([]WithdrawalEvent, error) {
+	return w.db.GetWithdrawalEvents(id)
+}
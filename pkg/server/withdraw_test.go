@@ -0,0 +1,196 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/decred/slog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakePayoutSender records payouts instead of making them.
+type fakePayoutSender struct {
+	mu        sync.Mutex
+	tips      map[string][]int64 // playerID -> amounts
+	addresses map[string][]int64 // address -> amounts
+	err       error              // Returned by every payout when set
+}
+
+func newFakePayoutSender() *fakePayoutSender {
+	return &fakePayoutSender{
+		tips:      make(map[string][]int64),
+		addresses: make(map[string][]int64),
+	}
+}
+
+func (f *fakePayoutSender) SendTip(ctx context.Context, playerID string, amount int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.tips[playerID] = append(f.tips[playerID], amount)
+	return nil
+}
+
+func (f *fakePayoutSender) SendToAddress(ctx context.Context, address string, amount int64) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return "", f.err
+	}
+	f.addresses[address] = append(f.addresses[address], amount)
+	return "txhash", nil
+}
+
+// newWithdrawalsTest returns a withdrawal pipeline over an in-memory database
+// where alice holds 1000 atoms.
+func newWithdrawalsTest(t *testing.T, dailyLimit int64) (*Withdrawals, *fakePayoutSender, Database) {
+	t.Helper()
+	database := newTestDatabase(t)
+	require.NoError(t, database.UpdatePlayerBalance("alice", 1000, TransactionDeposit, "seed"))
+	sender := newFakePayoutSender()
+	return NewWithdrawals(database, sender, dailyLimit, slog.Disabled), sender, database
+}
+
+func requireBalance(t *testing.T, database Database, playerID string, want int64) {
+	t.Helper()
+	balance, err := database.GetPlayerBalance(playerID)
+	require.NoError(t, err)
+	require.Equal(t, want, balance)
+}
+
+func TestWithdrawalTipConfirmed(t *testing.T) {
+	w, sender, database := newWithdrawalsTest(t, 0)
+
+	wd, err := w.Request(context.Background(), "alice", 300, "")
+	require.NoError(t, err)
+	assert.Equal(t, WithdrawalSent, wd.Status)
+	assert.Equal(t, WithdrawalMethodTip, wd.Method)
+	assert.Equal(t, []int64{300}, sender.tips["alice"])
+	requireBalance(t, database, "alice", 700)
+
+	// Events for other players or amounts are not ours.
+	other, err := w.HandleTipProgress("alice", 299, true, false, "")
+	require.NoError(t, err)
+	assert.Nil(t, other)
+
+	retried, err := w.HandleTipProgress("alice", 300, false, true, "no route")
+	require.NoError(t, err)
+	assert.Equal(t, WithdrawalSent, retried.Status)
+
+	confirmed, err := w.HandleTipProgress("alice", 300, true, false, "")
+	require.NoError(t, err)
+	assert.Equal(t, wd.ID, confirmed.ID)
+	assert.Equal(t, WithdrawalConfirmed, confirmed.Status)
+	requireBalance(t, database, "alice", 700)
+
+	events, err := w.Events(wd.ID)
+	require.NoError(t, err)
+	var statuses []string
+	for _, e := range events {
+		statuses = append(statuses, e.Status)
+	}
+	assert.Equal(t, []string{WithdrawalPending, WithdrawalSent, WithdrawalSent, WithdrawalConfirmed}, statuses)
+}
+
+func TestWithdrawalTipReversed(t *testing.T) {
+	w, _, database := newWithdrawalsTest(t, 0)
+
+	wd, err := w.Request(context.Background(), "alice", 300, "")
+	require.NoError(t, err)
+	requireBalance(t, database, "alice", 700)
+
+	reversed, err := w.HandleTipProgress("alice", 300, false, false, "no route")
+	require.NoError(t, err)
+	assert.Equal(t, wd.ID, reversed.ID)
+	assert.Equal(t, WithdrawalReversed, reversed.Status)
+	assert.Contains(t, reversed.Detail, "no route")
+	requireBalance(t, database, "alice", 1000)
+
+	// A settled withdrawal is not matched again.
+	again, err := w.HandleTipProgress("alice", 300, true, false, "")
+	require.NoError(t, err)
+	assert.Nil(t, again)
+}
+
+func TestWithdrawalPayoutErrorReverses(t *testing.T) {
+	w, sender, database := newWithdrawalsTest(t, 0)
+	sender.err = errors.New("client offline")
+
+	wd, err := w.Request(context.Background(), "alice", 300, "")
+	require.ErrorContains(t, err, "client offline")
+	require.NotNil(t, wd)
+	assert.Equal(t, WithdrawalReversed, wd.Status)
+	requireBalance(t, database, "alice", 1000)
+
+	wd, err = w.Request(context.Background(), "alice", 300, "Dsaddr")
+	require.ErrorContains(t, err, "client offline")
+	assert.Equal(t, WithdrawalReversed, wd.Status)
+	requireBalance(t, database, "alice", 1000)
+}
+
+func TestWithdrawalToAddress(t *testing.T) {
+	w, sender, database := newWithdrawalsTest(t, 0)
+
+	wd, err := w.Request(context.Background(), "alice", 250, "Dsaddr")
+	require.NoError(t, err)
+	assert.Equal(t, WithdrawalMethodAddress, wd.Method)
+	assert.Equal(t, "Dsaddr", wd.Destination)
+	assert.Equal(t, WithdrawalConfirmed, wd.Status)
+	assert.Contains(t, wd.Detail, "txhash")
+	assert.Equal(t, []int64{250}, sender.addresses["Dsaddr"])
+	requireBalance(t, database, "alice", 750)
+}
+
+func TestWithdrawalRPC(t *testing.T) {
+	database := newTestDatabase(t)
+	logBackend := createTestLogBackend()
+	defer logBackend.Close()
+	srv := NewServer(database, logBackend)
+	defer srv.Stop()
+	ctx := context.Background()
+
+	_, err := srv.RequestWithdrawal(ctx, &pokerrpc.RequestWithdrawalRequest{PlayerId: "alice", Amount: 1})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	srv.SetWithdrawals(NewWithdrawals(database, newFakePayoutSender(), 500, slog.Disabled))
+	require.NoError(t, database.UpdatePlayerBalance("alice", 1000, TransactionDeposit, "seed"))
+
+	cases := []struct {
+		amount int64
+		code   codes.Code
+	}{
+		{0, codes.InvalidArgument},
+		{2000, codes.FailedPrecondition},
+		{501, codes.ResourceExhausted},
+	}
+	for _, c := range cases {
+		_, err := srv.RequestWithdrawal(ctx, &pokerrpc.RequestWithdrawalRequest{PlayerId: "alice", Amount: c.amount})
+		assert.Equal(t, c.code, status.Code(err), "amount %d", c.amount)
+	}
+	_, err = srv.RequestWithdrawal(ctx, &pokerrpc.RequestWithdrawalRequest{PlayerId: "bob", Amount: 1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	resp, err := srv.RequestWithdrawal(ctx, &pokerrpc.RequestWithdrawalRequest{PlayerId: "alice", Amount: 400})
+	require.NoError(t, err)
+	assert.Equal(t, int64(600), resp.NewBalance)
+	assert.Equal(t, WithdrawalSent, resp.Withdrawal.Status)
+	assert.NotZero(t, resp.Withdrawal.CreatedAt)
+
+	// Only 100 atoms of the daily limit remain.
+	_, err = srv.RequestWithdrawal(ctx, &pokerrpc.RequestWithdrawalRequest{PlayerId: "alice", Amount: 101})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	list, err := srv.GetWithdrawals(ctx, &pokerrpc.GetWithdrawalsRequest{PlayerId: "alice"})
+	require.NoError(t, err)
+	require.Len(t, list.Withdrawals, 1)
+	assert.Equal(t, int64(500), list.DailyLimit)
+	assert.Equal(t, resp.Withdrawal.Id, list.Withdrawals[0].Id)
+}
@@ -0,0 +1,83 @@
+package strategy
+
+import (
+	chp "github.com/chehsunliu/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+)
+
+// Hand classes of chp.RankClass; lower is better.
+const (
+	classStraightFlush = 1
+	classTwoPair       = 7
+	classPair          = 8
+	classHighCard      = 9
+)
+
+// evalValues maps card values to the rank letters of the evaluator.
+var evalValues = map[string]string{
+	"A": "A", "K": "K", "Q": "Q", "J": "J", "10": "T", "9": "9", "8": "8",
+	"7": "7", "6": "6", "5": "5", "4": "4", "3": "3", "2": "2",
+}
+
+// evalSuits maps card suits to the suit letters of the evaluator.
+var evalSuits = map[string]string{
+	string(poker.Spades): "s", string(poker.Hearts): "h",
+	string(poker.Diamonds): "d", string(poker.Clubs): "c",
+}
+
+// rankOf returns the rank of a card value, 2 through 14 for an ace, or 0
+// for an unknown value.
+func rankOf(c poker.Card) int {
+	switch v := c.GetValue(); v {
+	case "A":
+		return 14
+	case "K":
+		return 13
+	case "Q":
+		return 12
+	case "J":
+		return 11
+	case "10":
+		return 10
+	default:
+		if len(v) == 1 && v[0] >= '2' && v[0] <= '9' {
+			return int(v[0] - '0')
+		}
+		return 0
+	}
+}
+
+// toEval converts cards for the evaluator, dropping unknown ones.
+func toEval(cards []poker.Card) []chp.Card {
+	out := make([]chp.Card, 0, len(cards))
+	for _, c := range cards {
+		v, okV := evalValues[c.GetValue()]
+		s, okS := evalSuits[c.GetSuit()]
+		if okV && okS {
+			out = append(out, chp.NewCard(v+s))
+		}
+	}
+	return out
+}
+
+// fullDeck returns the 52 cards in evaluator form, always in the same order
+// so that seeded draws repeat.
+func fullDeck() []chp.Card {
+	deck := make([]chp.Card, 0, 52)
+	for _, v := range "AKQJT98765432" {
+		for _, s := range "shdc" {
+			deck = append(deck, chp.NewCard(string(v)+string(s)))
+		}
+	}
+	return deck
+}
+
+// handClass returns the class of the best hand made of hole and board, or
+// classHighCard when there are fewer than five cards.
+func handClass(hole, board []poker.Card) int32 {
+	cards := toEval(append(append([]poker.Card{}, hole...), board...))
+	if len(cards) < 5 {
+		return classHighCard
+	}
+	return chp.RankClass(chp.Evaluate(cards))
+}
@@ -0,0 +1,116 @@
+package strategy
+
+import (
+	"math/rand"
+
+	chp "github.com/chehsunliu/poker"
+)
+
+// defaultEquitySamples is how many deals the equity strategy of New samples.
+const defaultEquitySamples = 300
+
+// Equity estimates its share of the pot by dealing out the rest of the hand
+// many times against random holdings of the opponents still in. It raises
+// with a large edge and otherwise calls whenever its equity beats the price
+// the pot offers.
+type Equity struct {
+	rng     *rand.Rand
+	samples int
+}
+
+// NewEquity returns an Equity strategy sampling that many deals per decision
+// and drawing from rng.
+func NewEquity(rng *rand.Rand, samples int) *Equity {
+	if samples <= 0 {
+		samples = defaultEquitySamples
+	}
+	return &Equity{rng: rng, samples: samples}
+}
+
+// Decide implements Strategy.
+func (e *Equity) Decide(v View) Action {
+	equity := e.Estimate(v)
+	pot := v.Update.GetPot()
+	toCall := v.ToCall()
+
+	// Scale the edge needed to raise with the number of opponents, who
+	// together hold the best hand more often than any one of them.
+	opponents := v.Opponents()
+	if opponents < 1 {
+		opponents = 1
+	}
+	raiseAt := 1 - 0.35/float64(opponents)
+	if raiseAt < 0.55 {
+		raiseAt = 0.55
+	}
+	if equity >= raiseAt {
+		size := pot * 3 / 4
+		if toCall > 0 {
+			size = v.Update.GetCurrentBet() * 3
+		}
+		return v.Legal(Action{Kind: Bet, Amount: size})
+	}
+	if toCall == 0 {
+		return Action{Kind: Check}
+	}
+	if equity*float64(pot+toCall) >= float64(toCall) {
+		return Action{Kind: Call}
+	}
+	return Action{Kind: Fold}
+}
+
+// Estimate returns the player's expected share of the pot, from 0 to 1, if
+// every remaining player stayed to the showdown.
+func (e *Equity) Estimate(v View) float64 {
+	hole := toEval(v.Hole())
+	board := toEval(v.Board())
+	opponents := v.Opponents()
+	if len(hole) != 2 || opponents == 0 {
+		return 1
+	}
+
+	known := make(map[chp.Card]bool, 7)
+	for _, c := range append(append([]chp.Card{}, hole...), board...) {
+		known[c] = true
+	}
+	stub := make([]chp.Card, 0, 52)
+	for _, c := range fullDeck() {
+		if !known[c] {
+			stub = append(stub, c)
+		}
+	}
+
+	need := 5 - len(board) + 2*opponents
+	if need > len(stub) {
+		return 1 / float64(opponents+1)
+	}
+	mine := make([]chp.Card, 7)
+	theirs := make([]chp.Card, 7)
+	var share float64
+	for i := 0; i < e.samples; i++ {
+		// Partially shuffle just the cards this deal uses.
+		for j := 0; j < need; j++ {
+			k := j + e.rng.Intn(len(stub)-j)
+			stub[j], stub[k] = stub[k], stub[j]
+		}
+		full := append(append(mine[:0], board...), stub[:5-len(board)]...)
+		next := 5 - len(board)
+		best := chp.Evaluate(append(full, hole...))
+
+		ties, lost := 1, false
+		for o := 0; o < opponents && !lost; o++ {
+			opp := append(append(theirs[:0], full[:5]...), stub[next], stub[next+1])
+			next += 2
+			switch r := chp.Evaluate(opp); {
+			case r < best:
+				lost = true
+			case r == best:
+				ties++
+			}
+		}
+		if !lost {
+			share += 1 / float64(ties)
+		}
+	}
+	return share / float64(e.samples)
+}
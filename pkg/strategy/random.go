@@ -0,0 +1,27 @@
+package strategy
+
+import "math/rand"
+
+// Random picks uniformly among folding, checking or calling, and raising up
+// to three times the minimum raise. It never folds when it could check.
+type Random struct {
+	rng *rand.Rand
+}
+
+// NewRandom returns a Random strategy drawing from rng.
+func NewRandom(rng *rand.Rand) *Random {
+	return &Random{rng: rng}
+}
+
+// Decide implements Strategy.
+func (r *Random) Decide(v View) Action {
+	switch r.rng.Intn(3) {
+	case 0:
+		return v.Legal(Action{Kind: Fold})
+	case 1:
+		return v.Legal(Action{Kind: Call})
+	default:
+		min := v.MinRaiseTo()
+		return v.Legal(Action{Kind: Bet, Amount: min + r.rng.Int63n(2*min+1)})
+	}
+}
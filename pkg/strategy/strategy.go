@@ -0,0 +1,194 @@
+// Package strategy decides poker actions from the game state a player sees.
+// Strategies drive the server's AI opponents, client autopilots and the
+// simulation harness alike.
+package strategy
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/vctt94/pokerbisonrelay/pkg/poker"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// Kind is the kind of an action.
+type Kind int
+
+const (
+	Fold Kind = iota
+	Check
+	Call
+	Bet // Bet or raise
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Fold:
+		return "fold"
+	case Check:
+		return "check"
+	case Call:
+		return "call"
+	case Bet:
+		return "bet"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Action is a decision of a strategy. Amount is what a Bet brings the
+// player's bet for the betting round to; it is unused by other kinds.
+type Action struct {
+	Kind   Kind
+	Amount int64
+}
+
+func (a Action) String() string {
+	if a.Kind == Bet {
+		return fmt.Sprintf("bet %d", a.Amount)
+	}
+	return a.Kind.String()
+}
+
+// View is the game as the deciding player sees it.
+type View struct {
+	Update   *pokerrpc.GameUpdate
+	PlayerID string
+	BigBlind int64
+}
+
+// Self returns the deciding player, or nil when they are not in the update.
+func (v View) Self() *pokerrpc.Player {
+	for _, p := range v.Update.GetPlayers() {
+		if p.GetId() == v.PlayerID {
+			return p
+		}
+	}
+	return nil
+}
+
+// ToCall returns the chips the player must add to stay in the hand.
+func (v View) ToCall() int64 {
+	self := v.Self()
+	owed := v.Update.GetCurrentBet() - self.GetCurrentBet()
+	if owed < 0 {
+		return 0
+	}
+	if owed > self.GetBalance() {
+		return self.GetBalance()
+	}
+	return owed
+}
+
+// MinRaiseTo returns the smallest bet the player may raise to.
+func (v View) MinRaiseTo() int64 {
+	step := v.BigBlind
+	if step <= 0 {
+		step = 1
+	}
+	return v.Update.GetCurrentBet() + step
+}
+
+// MaxBet returns the bet that puts all of the player's chips in.
+func (v View) MaxBet() int64 {
+	self := v.Self()
+	return self.GetCurrentBet() + self.GetBalance()
+}
+
+// Opponents returns how many other players are still in the hand.
+func (v View) Opponents() int {
+	n := 0
+	for _, p := range v.Update.GetPlayers() {
+		if p.GetId() != v.PlayerID && !p.GetFolded() {
+			n++
+		}
+	}
+	return n
+}
+
+// Hole returns the player's cards.
+func (v View) Hole() []poker.Card {
+	return cards(v.Self().GetHand())
+}
+
+// Board returns the community cards.
+func (v View) Board() []poker.Card {
+	return cards(v.Update.GetCommunityCards())
+}
+
+func cards(in []*pokerrpc.Card) []poker.Card {
+	out := make([]poker.Card, 0, len(in))
+	for _, c := range in {
+		out = append(out, poker.NewCardFromSuitValue(poker.Suit(c.GetSuit()), poker.Value(c.GetValue())))
+	}
+	return out
+}
+
+// Legal turns a into an action the player may take: checks that owe chips
+// become folds, calls that owe nothing become checks and bets are brought
+// within the minimum raise and the player's chips. A bet the player cannot
+// raise with becomes a call.
+func (v View) Legal(a Action) Action {
+	toCall := v.ToCall()
+	switch a.Kind {
+	case Check:
+		if toCall > 0 {
+			return Action{Kind: Fold}
+		}
+	case Call:
+		if toCall == 0 {
+			return Action{Kind: Check}
+		}
+	case Bet:
+		max := v.MaxBet()
+		if max <= v.Update.GetCurrentBet() {
+			return v.Legal(Action{Kind: Call})
+		}
+		if a.Amount < v.MinRaiseTo() {
+			a.Amount = v.MinRaiseTo()
+		}
+		if a.Amount > max {
+			a.Amount = max
+		}
+	case Fold:
+		if toCall == 0 {
+			// Folding for free gives up the pot for nothing.
+			return Action{Kind: Check}
+		}
+	}
+	return a
+}
+
+// Strategy decides the action of a player whose turn it is.
+type Strategy interface {
+	Decide(v View) Action
+}
+
+// constructors builds the strategies known by name.
+var constructors = map[string]func(rng *rand.Rand) Strategy{
+	"random": func(rng *rand.Rand) Strategy { return NewRandom(rng) },
+	"tag":    func(rng *rand.Rand) Strategy { return NewTightAggressive() },
+	"equity": func(rng *rand.Rand) Strategy { return NewEquity(rng, defaultEquitySamples) },
+}
+
+// New returns a new instance of the strategy called name, drawing random
+// numbers from a source seeded with seed. Strategies are not safe for
+// concurrent use; give each player its own.
+func New(name string, seed int64) (Strategy, error) {
+	newFn, ok := constructors[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown strategy %q (known: %v)", name, Names())
+	}
+	return newFn(rand.New(rand.NewSource(seed))), nil
+}
+
+// Names returns the names New accepts, sorted.
+func Names() []string {
+	names := make([]string, 0, len(constructors))
+	for name := range constructors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
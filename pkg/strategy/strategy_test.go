@@ -0,0 +1,100 @@
+package strategy
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vctt94/pokerbisonrelay/pkg/rpc/grpc/pokerrpc"
+)
+
+// parseCards turns "As Kd 10h" into cards as the server sends them.
+func parseCards(s string) []*pokerrpc.Card {
+	suits := map[byte]string{'s': "♠", 'h': "♥", 'd': "♦", 'c': "♣"}
+	var out []*pokerrpc.Card
+	for _, f := range strings.Fields(s) {
+		out = append(out, &pokerrpc.Card{Value: f[:len(f)-1], Suit: suits[f[len(f)-1]]})
+	}
+	return out
+}
+
+// view builds a heads-up view for "me" holding hole with the given board,
+// facing a bet of currentBet with nothing put in yet.
+func view(hole, board string, pot, currentBet int64) View {
+	return View{
+		Update: &pokerrpc.GameUpdate{
+			Players: []*pokerrpc.Player{
+				{Id: "me", Balance: 1000, Hand: parseCards(hole)},
+				{Id: "villain", Balance: 1000, CurrentBet: currentBet},
+			},
+			CommunityCards: parseCards(board),
+			Pot:            pot,
+			CurrentBet:     currentBet,
+			CurrentPlayer:  "me",
+		},
+		PlayerID: "me",
+		BigBlind: 20,
+	}
+}
+
+func TestLegal(t *testing.T) {
+	free := view("2c 7d", "", 30, 0)
+	assert.Equal(t, Action{Kind: Check}, free.Legal(Action{Kind: Fold}))
+	assert.Equal(t, Action{Kind: Check}, free.Legal(Action{Kind: Call}))
+	assert.Equal(t, Action{Kind: Bet, Amount: 20}, free.Legal(Action{Kind: Bet, Amount: 5}))
+
+	facing := view("2c 7d", "", 30, 40)
+	assert.Equal(t, Action{Kind: Fold}, facing.Legal(Action{Kind: Check}))
+	assert.Equal(t, int64(40), facing.ToCall())
+	assert.Equal(t, Action{Kind: Bet, Amount: 1000}, facing.Legal(Action{Kind: Bet, Amount: 5000}))
+
+	// A player who cannot cover more than the bet can only call.
+	short := view("2c 7d", "", 30, 40)
+	short.Update.Players[0].Balance = 30
+	assert.Equal(t, Action{Kind: Call}, short.Legal(Action{Kind: Bet, Amount: 100}))
+	assert.Equal(t, int64(30), short.ToCall())
+}
+
+func TestStrategiesActLegally(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, name := range Names() {
+		s, err := New(name, 1)
+		require.NoError(t, err)
+		for i := 0; i < 200; i++ {
+			v := view("Ah 7s", "Kd 7c 2h", int64(20+rng.Intn(200)), int64(rng.Intn(3))*20)
+			a := s.Decide(v)
+			assert.Equal(t, v.Legal(a), a, "%s decided %v", name, a)
+		}
+	}
+	_, err := New("oracle", 1)
+	assert.ErrorContains(t, err, "unknown strategy")
+}
+
+func TestTightAggressive(t *testing.T) {
+	tag := NewTightAggressive()
+	assert.Equal(t, Bet, tag.Decide(view("As Ah", "", 30, 20)).Kind)
+	assert.Equal(t, Fold, tag.Decide(view("2c 7d", "", 30, 20)).Kind)
+	assert.Equal(t, Check, tag.Decide(view("2c 7d", "", 40, 0)).Kind)
+	// Speculative hands see a cheap flop but not a big raise.
+	assert.Equal(t, Call, tag.Decide(view("6h 5h", "", 30, 20)).Kind)
+	assert.Equal(t, Fold, tag.Decide(view("6h 5h", "", 150, 120)).Kind)
+
+	// Top pair bets, a missed hand gives up.
+	assert.Equal(t, Bet, tag.Decide(view("Kh Qd", "Kd 7c 2h", 60, 0)).Kind)
+	assert.Equal(t, Fold, tag.Decide(view("4h 3d", "Kd Jc 9h", 60, 40)).Kind)
+	assert.Equal(t, Bet, tag.Decide(view("Kh 7d", "Kd 7c 2h", 60, 40)).Kind)
+}
+
+func TestEquity(t *testing.T) {
+	e := NewEquity(rand.New(rand.NewSource(1)), 2000)
+	assert.InDelta(t, 0.85, e.Estimate(view("As Ah", "", 30, 20)), 0.04)
+	assert.InDelta(t, 1.0, e.Estimate(view("As Ks", "Qs Js 10s", 30, 0)), 0.001)
+	assert.Less(t, e.Estimate(view("2c 7d", "Ah Kh Qh", 30, 0)), 0.25)
+
+	assert.Equal(t, Bet, e.Decide(view("As Ah", "", 30, 20)).Kind)
+	assert.Equal(t, Fold, e.Decide(view("2c 7d", "Ah Kh Qh", 100, 500)).Kind)
+	// Cheap calls need little equity.
+	assert.Equal(t, Call, e.Decide(view("9c 8c", "Ah 7h 2d", 400, 20)).Kind)
+}
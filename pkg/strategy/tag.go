@@ -0,0 +1,125 @@
+package strategy
+
+import "github.com/vctt94/pokerbisonrelay/pkg/poker"
+
+// Preflop hand groups of TightAggressive.
+const (
+	groupTrash = iota
+	groupPlayable
+	groupStrong
+	groupPremium
+)
+
+// TightAggressive is a rule-based strategy that plays few starting hands and
+// bets them hard. Preflop it raises premium hands, raises strong ones when
+// nobody has and calls cheaply with speculative ones. After the flop it bets
+// two pair or better, bets or calls with top pair and gives up otherwise.
+type TightAggressive struct{}
+
+// NewTightAggressive returns a TightAggressive strategy.
+func NewTightAggressive() *TightAggressive {
+	return &TightAggressive{}
+}
+
+// Decide implements Strategy.
+func (t *TightAggressive) Decide(v View) Action {
+	hole := v.Hole()
+	if len(hole) != 2 {
+		return v.Legal(Action{Kind: Check})
+	}
+	board := v.Board()
+	if len(board) == 0 {
+		return t.preflop(v, hole)
+	}
+	return t.postflop(v, hole, board)
+}
+
+func (t *TightAggressive) preflop(v View, hole []poker.Card) Action {
+	bb := v.BigBlind
+	toCall := v.ToCall()
+	raised := v.Update.GetCurrentBet() > bb
+	raise := 3 * bb
+	if raised {
+		raise = 3 * v.Update.GetCurrentBet()
+	}
+
+	switch preflopGroup(hole) {
+	case groupPremium:
+		return v.Legal(Action{Kind: Bet, Amount: raise})
+	case groupStrong:
+		if !raised {
+			return v.Legal(Action{Kind: Bet, Amount: raise})
+		}
+		if toCall <= 4*bb {
+			return v.Legal(Action{Kind: Call})
+		}
+	case groupPlayable:
+		if toCall <= 2*bb {
+			return v.Legal(Action{Kind: Call})
+		}
+	}
+	return v.Legal(Action{Kind: Check})
+}
+
+func (t *TightAggressive) postflop(v View, hole, board []poker.Card) Action {
+	pot := v.Update.GetPot()
+	toCall := v.ToCall()
+	class := handClass(hole, board)
+
+	switch {
+	case class <= classTwoPair:
+		if toCall > 0 {
+			return v.Legal(Action{Kind: Bet, Amount: v.Update.GetCurrentBet() * 5 / 2})
+		}
+		return v.Legal(Action{Kind: Bet, Amount: pot * 3 / 4})
+	case class == classPair && topPair(hole, board):
+		if toCall == 0 {
+			return v.Legal(Action{Kind: Bet, Amount: pot / 2})
+		}
+		if toCall <= pot/2 {
+			return v.Legal(Action{Kind: Call})
+		}
+	}
+	return v.Legal(Action{Kind: Check})
+}
+
+// preflopGroup sorts two hole cards into a preflop hand group.
+func preflopGroup(hole []poker.Card) int {
+	hi, lo := rankOf(hole[0]), rankOf(hole[1])
+	if lo > hi {
+		hi, lo = lo, hi
+	}
+	suited := hole[0].GetSuit() == hole[1].GetSuit()
+	pair := hi == lo
+
+	switch {
+	case pair && hi >= 10, hi == 14 && lo == 13, hi == 14 && lo == 12 && suited:
+		return groupPremium
+	case pair && hi >= 7, hi == 14 && lo >= 11, hi == 13 && lo == 12 && suited:
+		return groupStrong
+	case pair, hi == 14 && suited, hi >= 11 && lo >= 10,
+		suited && hi-lo == 1 && lo >= 5:
+		return groupPlayable
+	}
+	return groupTrash
+}
+
+// topPair reports whether a pair made with the hole cards is at least as
+// high as every board card.
+func topPair(hole, board []poker.Card) bool {
+	top := 0
+	for _, c := range board {
+		if r := rankOf(c); r > top {
+			top = r
+		}
+	}
+	if rankOf(hole[0]) == rankOf(hole[1]) {
+		return rankOf(hole[0]) >= top
+	}
+	for _, h := range hole {
+		if rankOf(h) == top {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package ui
 import (
 	"context"
 	"fmt"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/vctt94/pokerbisonrelay/pkg/client"
@@ -17,6 +18,12 @@ type errorMsg error
 // UI data message types
 type tablesMsg []*pokerrpc.Table
 
+// clockTickMsg redraws the countdown of the player to act.
+type clockTickMsg time.Time
+
+// watchingMsg reports that the player started watching a table.
+type watchingMsg string
+
 // CommandDispatcher handles UI commands and interactions with the poker client
 type CommandDispatcher struct {
 	ctx      context.Context
@@ -74,6 +81,15 @@ func (d *CommandDispatcher) joinTableCmd(tableID string) tea.Cmd {
 	}
 }
 
+func (d *CommandDispatcher) watchTableCmd(tableID string) tea.Cmd {
+	return func() tea.Msg {
+		if err := d.pc.WatchTable(d.ctx, tableID); err != nil {
+			return errorMsg(err)
+		}
+		return watchingMsg(tableID)
+	}
+}
+
 func (d *CommandDispatcher) leaveTableCmd() tea.Cmd {
 	return func() tea.Msg {
 		currentTableID := d.pc.GetCurrentTableID()
@@ -284,6 +300,160 @@ func (d *CommandDispatcher) hideCardsCmd() tea.Cmd {
 	}
 }
 
+// sendChatCmd sends a message to the chat of the current table. The server
+// echoes it back with the other players' messages.
+func (d *CommandDispatcher) sendChatCmd(text string) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := d.pc.SendChatMessage(d.ctx, d.pc.GetCurrentTableID(), text)
+		if err != nil {
+			return errorMsg(err)
+		}
+		if !resp.Success {
+			return errorMsg(fmt.Errorf("%s", resp.Message))
+		}
+		return nil
+	}
+}
+
+// Host moderation commands
+
+func (d *CommandDispatcher) kickPlayerCmd(targetID string) tea.Cmd {
+	return func() tea.Msg {
+		tableID := d.pc.GetCurrentTableID()
+		resp, err := d.pc.KickPlayer(d.ctx, tableID, targetID, "")
+		if err != nil {
+			return errorMsg(err)
+		}
+		return notificationMsg(&pokerrpc.Notification{
+			Type:     pokerrpc.NotificationType_PLAYER_KICKED,
+			PlayerId: targetID,
+			TableId:  tableID,
+			Message:  resp.Message,
+		})
+	}
+}
+
+func (d *CommandDispatcher) banPlayerCmd(targetID string) tea.Cmd {
+	return func() tea.Msg {
+		tableID := d.pc.GetCurrentTableID()
+		resp, err := d.pc.BanPlayer(d.ctx, tableID, targetID, "")
+		if err != nil {
+			return errorMsg(err)
+		}
+		return notificationMsg(&pokerrpc.Notification{
+			Type:     pokerrpc.NotificationType_PLAYER_BANNED,
+			PlayerId: targetID,
+			TableId:  tableID,
+			Message:  resp.Message,
+		})
+	}
+}
+
+func (d *CommandDispatcher) sitOutCmd() tea.Cmd {
+	return func() tea.Msg {
+		tableID := d.pc.GetCurrentTableID()
+		resp, err := d.pc.SitOut(d.ctx, tableID)
+		if err != nil {
+			return errorMsg(err)
+		}
+		if !resp.Success {
+			return errorMsg(fmt.Errorf("%s", resp.Message))
+		}
+		return notificationMsg(&pokerrpc.Notification{
+			Type:     pokerrpc.NotificationType_PLAYER_SAT_OUT,
+			PlayerId: d.clientID,
+			TableId:  tableID,
+			Message:  resp.Message,
+		})
+	}
+}
+
+func (d *CommandDispatcher) sitInCmd() tea.Cmd {
+	return func() tea.Msg {
+		tableID := d.pc.GetCurrentTableID()
+		resp, err := d.pc.SitIn(d.ctx, tableID)
+		if err != nil {
+			return errorMsg(err)
+		}
+		if !resp.Success {
+			return errorMsg(fmt.Errorf("%s", resp.Message))
+		}
+		return notificationMsg(&pokerrpc.Notification{
+			Type:     pokerrpc.NotificationType_PLAYER_SAT_IN,
+			PlayerId: d.clientID,
+			TableId:  tableID,
+			Message:  resp.Message,
+		})
+	}
+}
+
+func (d *CommandDispatcher) useTimeBankCmd() tea.Cmd {
+	return func() tea.Msg {
+		tableID := d.pc.GetCurrentTableID()
+		resp, err := d.pc.UseTimeBank(d.ctx, tableID)
+		if err != nil {
+			return errorMsg(err)
+		}
+		if !resp.Success {
+			return errorMsg(fmt.Errorf("%s", resp.Message))
+		}
+		left := time.Until(time.UnixMilli(resp.ActionDeadlineUnixMs))
+		return notificationMsg(&pokerrpc.Notification{
+			Type:      pokerrpc.NotificationType_TIME_BANK_USED,
+			PlayerId:  d.clientID,
+			TableId:   tableID,
+			Message:   resp.Message,
+			Countdown: int32(left.Round(time.Second) / time.Second),
+		})
+	}
+}
+
+func (d *CommandDispatcher) pauseTableCmd() tea.Cmd {
+	return func() tea.Msg {
+		tableID := d.pc.GetCurrentTableID()
+		resp, err := d.pc.PauseTable(d.ctx, tableID)
+		if err != nil {
+			return errorMsg(err)
+		}
+		return notificationMsg(&pokerrpc.Notification{
+			Type:     pokerrpc.NotificationType_GAME_PAUSED,
+			PlayerId: d.clientID,
+			TableId:  tableID,
+			Message:  resp.Message,
+		})
+	}
+}
+
+func (d *CommandDispatcher) resumeTableCmd() tea.Cmd {
+	return func() tea.Msg {
+		tableID := d.pc.GetCurrentTableID()
+		resp, err := d.pc.ResumeTable(d.ctx, tableID)
+		if err != nil {
+			return errorMsg(err)
+		}
+		return notificationMsg(&pokerrpc.Notification{
+			Type:     pokerrpc.NotificationType_GAME_RESUMED,
+			PlayerId: d.clientID,
+			TableId:  tableID,
+			Message:  resp.Message,
+		})
+	}
+}
+
+func (d *CommandDispatcher) closeTableCmd() tea.Cmd {
+	return func() tea.Msg {
+		resp, err := d.pc.CloseTable(d.ctx, d.pc.GetCurrentTableID(), "")
+		if err != nil {
+			return errorMsg(err)
+		}
+		// The table is gone once TABLE_CLOSED arrives from the server
+		return notificationMsg(&pokerrpc.Notification{
+			Type:    pokerrpc.NotificationType_UNKNOWN,
+			Message: resp.Message,
+		})
+	}
+}
+
 // Utility functions
 func min(a, b int) int {
 	if a < b {
@@ -85,6 +85,12 @@ func (r *Renderer) RenderTableList() string {
 				table.MaxPlayers,
 				table.SmallBlind,
 				table.BigBlind)
+			if n := len(table.Waitlist); n > 0 {
+				tableInfo += fmt.Sprintf(" | Waiting: %d", n)
+			}
+			if table.Spectators > 0 {
+				tableInfo += fmt.Sprintf(" | Watching: %d", table.Spectators)
+			}
 
 			// Add selection indicator and styling
 			if isSelected {
@@ -100,7 +106,7 @@ func (r *Renderer) RenderTableList() string {
 		}
 	}
 
-	s += "\n" + HelpStyle.Render("Press Enter to join selected table, 'r' to refresh, or 'q' to go back")
+	s += "\n" + HelpStyle.Render("Press Enter to join selected table, 'w' to Watch Table, 'r' to refresh, or 'q' to go back")
 	return s
 }
 
@@ -206,6 +212,27 @@ func (r *Renderer) RenderGameLobby() string {
 		}
 	}
 
+	s += "\n" + r.renderChatPane()
+	return s
+}
+
+// RenderPlayerSelect renders the list of players the host can kick or ban
+func (r *Renderer) RenderPlayerSelect() string {
+	var s string
+	s += TitleStyle.Render(r.ui.moderationAction) + "\n"
+
+	targets := r.ui.moderationTargets()
+	if len(targets) == 0 {
+		s += "No other players at the table\n"
+	}
+	for i, id := range targets {
+		if i == r.ui.selectedPlayer {
+			s += FocusedStyle.Render(fmt.Sprintf("> %s", id)) + "\n"
+		} else {
+			s += BlurredStyle.Render(fmt.Sprintf("  %s", id)) + "\n"
+		}
+	}
+	s += "\n" + HelpStyle.Render("Use arrow keys to choose a player, Enter to confirm")
 	return s
 }
 
@@ -257,6 +284,9 @@ func (r *Renderer) RenderActiveGame() string {
 
 	// Show client ID
 	s += fmt.Sprintf("Client ID: %s\n", r.ui.clientID)
+	if r.ui.spectators > 0 {
+		s += fmt.Sprintf("Spectators: %d\n", r.ui.spectators)
+	}
 
 	// COMMUNITY CARDS
 	s += r.renderCommunityCardsSection() + "\n"
@@ -288,14 +318,79 @@ func (r *Renderer) RenderActiveGame() string {
 			if len(currentPlayerName) > 8 {
 				currentPlayerName = currentPlayerName[:8] + "..."
 			}
-			s += HelpStyle.Render(fmt.Sprintf("⏰ Waiting for %s", currentPlayerName)) + " | "
+			waiting := fmt.Sprintf("⏰ Waiting for %s", currentPlayerName)
+			if left := r.ui.secondsToAct(); left >= 0 {
+				waiting += fmt.Sprintf(" (%ds)", left)
+			}
+			s += HelpStyle.Render(waiting) + " | "
 		}
 
 		// Always show leave table option when not actively playing
 		s += BlurredStyle.Render("🚪 Leave Table")
 	}
 
-	s += "\n" + HelpStyle.Render("Arrow keys to navigate, Enter to select, 'q' to go back")
+	s += "\n\n" + r.renderChatPane()
+	s += "\n" + HelpStyle.Render("Arrow keys to navigate, Enter to select, 't' to chat, 'q' to go back")
+	return s
+}
+
+// RenderWatchTable renders the delayed public view of a table watched without
+// a seat
+func (r *Renderer) RenderWatchTable() string {
+	var s string
+	s += TitleStyle.Render(fmt.Sprintf("👀 Watching Table %s", r.ui.watchingTableID)) + "\n"
+	s += fmt.Sprintf("Spectators: %d\n", r.ui.spectators)
+	s += BlurredStyle.Render("The view runs behind the game; hole cards show at the showdown") + "\n\n"
+
+	if len(r.ui.players) == 0 {
+		s += "Loading table information...\n"
+	} else {
+		s += r.renderCommunityCardsSection() + "\n"
+		if r.ui.gamePhase == pokerrpc.GamePhase_SHOWDOWN {
+			s += r.renderShowdownResults() + "\n"
+		}
+		s += r.renderPlayersCompact() + "\n"
+		if r.ui.currentPlayerID != "" {
+			s += HelpStyle.Render(fmt.Sprintf("⏰ Waiting for %s", r.ui.currentPlayerID)) + "\n"
+		}
+	}
+
+	if len(r.ui.chatLog) > 0 {
+		s += "\n" + r.renderChatPane()
+	}
+	s += "\n" + HelpStyle.Render("Press 'q' to stop watching")
+	return s
+}
+
+// chatPaneLines is the number of chat messages shown under the table.
+const chatPaneLines = 6
+
+// renderChatPane renders the latest messages of the table chat and, in chat
+// mode, the message being typed.
+func (r *Renderer) renderChatPane() string {
+	s := TitleStyle.Render("💬 Chat") + "\n"
+	log := r.ui.chatLog
+	if len(log) > chatPaneLines {
+		log = log[len(log)-chatPaneLines:]
+	}
+	if len(log) == 0 {
+		s += BlurredStyle.Render("  No messages yet") + "\n"
+	}
+	for _, msg := range log {
+		who := msg.PlayerId
+		if who == r.ui.clientID {
+			who = "You"
+		} else if len(who) > 8 {
+			who = who[:8]
+		}
+		s += fmt.Sprintf("  %s: %s\n", who, msg.Text)
+	}
+	if r.ui.chatting {
+		s += FocusedStyle.Render("> "+r.ui.chatInput+"_") + "\n"
+		s += HelpStyle.Render("Enter to send, Esc to cancel") + "\n"
+	} else if r.ui.watchingTableID == "" {
+		s += HelpStyle.Render("Press 't' to chat") + "\n"
+	}
 	return s
 }
 
@@ -489,7 +584,14 @@ func (r *Renderer) renderPlayersAroundTable() string {
 		}
 
 		// Add position indicator
-		position := fmt.Sprintf("Seat %d", i+1)
+		seat := int(player.Seat)
+		if seat == 0 {
+			seat = i + 1
+		}
+		position := fmt.Sprintf("Seat %d", seat)
+		if player.IsDealer && r.ui.gamePhase != pokerrpc.GamePhase_WAITING {
+			position += " (D)"
+		}
 		fullPlayerInfo := fmt.Sprintf("%s\n%s", position, playerInfo)
 
 		result += style.Render(fullPlayerInfo) + "\n"
@@ -531,7 +633,9 @@ func (r *Renderer) formatPlayerInfo(player *pokerrpc.Player) string {
 	}
 
 	// Only show folded if player has actually folded
-	if player.Folded {
+	if player.SittingOut {
+		status = append(status, "SITTING OUT")
+	} else if player.Folded {
 		status = append(status, "FOLDED")
 	} else {
 		// Player is active in the game
@@ -612,10 +716,14 @@ func (r *Renderer) renderActionButtons() string {
 	var result string
 
 	// Very clear turn indicator with icon
+	turn := "⚡ YOUR TURN - CHOOSE ACTION"
+	if left := r.ui.secondsToAct(); left >= 0 {
+		turn += fmt.Sprintf(" (%ds left)", left)
+	}
 	result += YourTurnStyle.
 		MarginTop(1).
 		MarginBottom(1).
-		Render("⚡ YOUR TURN - CHOOSE ACTION") + "\n"
+		Render(turn) + "\n"
 
 	// Clean action buttons with minimal icons
 	options := r.ui.getActiveGameOptions()
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -14,6 +15,9 @@ import (
 )
 
 // stateFn represents a state function that processes input and returns the next state
+// chatLogSize bounds the table chat messages kept for display.
+const chatLogSize = 50
+
 type stateFn func(*PokerUI, tea.Msg) (stateFn, tea.Cmd)
 
 // PokerUI contains all the state for our poker client UI
@@ -55,6 +59,12 @@ type PokerUI struct {
 	currentPlayerID string
 	playersRequired int32
 	playersJoined   int32
+	actionDeadline  time.Time // When the table acts for the current player
+	clockTicking    bool      // A countdown redraw is scheduled
+	spectators      int32
+
+	// Table watched without a seat
+	watchingTableID string
 
 	// Showdown results
 	winners []*pokerrpc.Winner
@@ -65,6 +75,17 @@ type PokerUI struct {
 	// For betting input
 	betAmount string
 
+	// Host moderation
+	hostTableID      string // Table this client created, and so hosts
+	paused           bool   // The host paused the current table
+	moderationAction string // "Kick Player" or "Ban Player" while picking a player
+	selectedPlayer   int
+
+	// Table chat
+	chatLog   []*pokerrpc.ChatMessage // Latest messages, oldest first
+	chatInput string
+	chatting  bool // Keys go to the chat input
+
 	// Card visibility toggle
 	showMyCards bool
 
@@ -146,7 +167,9 @@ func (m *PokerUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case notificationMsg:
 		notif := (*pokerrpc.Notification)(msg)
-		m.message = notif.Message
+		if notif.Type != pokerrpc.NotificationType_CHAT_MESSAGE {
+			m.message = notif.Message
+		}
 		m.err = nil
 		if notif.Type == pokerrpc.NotificationType_BALANCE_UPDATED {
 			m.balance = notif.NewBalance
@@ -156,7 +179,9 @@ func (m *PokerUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case *pokerrpc.Notification:
 		notif := msg
-		m.message = notif.Message
+		if notif.Type != pokerrpc.NotificationType_CHAT_MESSAGE {
+			m.message = notif.Message
+		}
 		m.err = nil
 		if notif.Type == pokerrpc.NotificationType_BALANCE_UPDATED {
 			m.balance = notif.NewBalance
@@ -167,6 +192,19 @@ func (m *PokerUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case client.GameUpdateMsg:
 		gameUpdate := (*pokerrpc.GameUpdate)(msg)
 		m.updateGameState(gameUpdate)
+		return m, m.clockCmd()
+
+	case clockTickMsg:
+		m.clockTicking = false
+		return m, m.clockCmd()
+
+	case watchingMsg:
+		m.resetGameState()
+		m.watchingTableID = string(msg)
+		m.message = fmt.Sprintf("Watching table %s; the view runs behind the game", m.watchingTableID)
+		m.err = nil
+		m.currentState = m.stateWatchTable
+		m.currentView = "watchTable"
 		return m, nil
 
 	case errorMsg:
@@ -175,12 +213,52 @@ func (m *PokerUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	if key, ok := msg.(tea.KeyMsg); ok && m.chatting {
+		return m, m.handleChatKey(key)
+	}
+
 	// Delegate to current state function
 	nextState, cmd := m.currentState(m, msg)
 	m.currentState = nextState
 	return m, cmd
 }
 
+// handleChatKey edits the chat input, sending the message on enter and
+// leaving chat mode on esc.
+func (m *PokerUI) handleChatKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return tea.Quit
+	case tea.KeyEsc:
+		m.chatting = false
+		m.chatInput = ""
+	case tea.KeyEnter:
+		text := strings.TrimSpace(m.chatInput)
+		m.chatting = false
+		m.chatInput = ""
+		if text != "" {
+			return m.dispatcher.sendChatCmd(text)
+		}
+	case tea.KeyBackspace:
+		if r := []rune(m.chatInput); len(r) > 0 {
+			m.chatInput = string(r[:len(r)-1])
+		}
+	case tea.KeySpace:
+		m.chatInput += " "
+	case tea.KeyRunes:
+		m.chatInput += string(msg.Runes)
+	}
+	return nil
+}
+
+// addChat appends chat messages to the log, keeping the latest chatLogSize.
+func (m *PokerUI) addChat(msgs []*pokerrpc.ChatMessage) {
+	m.chatLog = append(m.chatLog, msgs...)
+	if len(m.chatLog) > chatLogSize {
+		m.chatLog = append([]*pokerrpc.ChatMessage(nil), m.chatLog[len(m.chatLog)-chatLogSize:]...)
+	}
+}
+
 // State functions
 
 func (m *PokerUI) stateMainMenu(ui *PokerUI, msg tea.Msg) (stateFn, tea.Cmd) {
@@ -227,6 +305,11 @@ func (m *PokerUI) stateTableList(ui *PokerUI, msg tea.Msg) (stateFn, tea.Cmd) {
 				table := m.tables[m.selectedTable]
 				return m.stateTableList, m.dispatcher.joinTableCmd(table.Id)
 			}
+		case "w":
+			if len(m.tables) > 0 && m.selectedTable < len(m.tables) {
+				table := m.tables[m.selectedTable]
+				return m.stateTableList, m.dispatcher.watchTableCmd(table.Id)
+			}
 		case "r":
 			return m.stateTableList, m.dispatcher.getTablesCmd()
 		case "q":
@@ -240,6 +323,30 @@ func (m *PokerUI) stateTableList(ui *PokerUI, msg tea.Msg) (stateFn, tea.Cmd) {
 	return m.stateTableList, nil
 }
 
+// stateWatchTable shows the delayed public view of a watched table, which
+// takes no actions.
+func (m *PokerUI) stateWatchTable(ui *PokerUI, msg tea.Msg) (stateFn, tea.Cmd) {
+	m.currentView = "watchTable"
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "q":
+			m.stopWatching()
+			m.message = ""
+			return m.stateTableList, m.dispatcher.getTablesCmd()
+		case "ctrl+c":
+			return m.stateWatchTable, tea.Quit
+		}
+	}
+	return m.stateWatchTable, nil
+}
+
+// stopWatching stops the stream of the watched table.
+func (m *PokerUI) stopWatching() {
+	m.pc.StopWatching()
+	m.watchingTableID = ""
+	m.resetGameState()
+}
+
 func (m *PokerUI) stateCreateTable(ui *PokerUI, msg tea.Msg) (stateFn, tea.Cmd) {
 	m.currentView = "createTable"
 	switch msg := msg.(type) {
@@ -333,6 +440,8 @@ func (m *PokerUI) stateGameLobby(ui *PokerUI, msg tea.Msg) (stateFn, tea.Cmd) {
 			if m.selectedItem < len(options) {
 				return m.handleGameLobbySelection(options[m.selectedItem])
 			}
+		case "t":
+			m.chatting = true
 		case "q":
 			return m.stateGameLobby, m.dispatcher.leaveTableCmd()
 		case "ctrl+c":
@@ -361,6 +470,8 @@ func (m *PokerUI) stateActiveGame(ui *PokerUI, msg tea.Msg) (stateFn, tea.Cmd) {
 			if m.selectedItem < len(options) {
 				return m.handleActiveGameSelection(options[m.selectedItem])
 			}
+		case "t":
+			m.chatting = true
 		case "q":
 			return m.stateActiveGame, m.dispatcher.leaveTableCmd()
 		case "ctrl+c":
@@ -370,6 +481,42 @@ func (m *PokerUI) stateActiveGame(ui *PokerUI, msg tea.Msg) (stateFn, tea.Cmd) {
 	return m.stateActiveGame, nil
 }
 
+func (m *PokerUI) statePlayerSelect(ui *PokerUI, msg tea.Msg) (stateFn, tea.Cmd) {
+	m.currentView = "playerSelect"
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		targets := m.moderationTargets()
+		switch msg.String() {
+		case "up", "k":
+			if m.selectedPlayer > 0 {
+				m.selectedPlayer--
+			}
+		case "down", "j":
+			if m.selectedPlayer < len(targets)-1 {
+				m.selectedPlayer++
+			}
+		case "enter", " ":
+			if m.selectedPlayer >= len(targets) {
+				return m.statePlayerSelect, nil
+			}
+			target := targets[m.selectedPlayer]
+			m.selectedItem = 0
+			m.currentView = "gameLobby"
+			if m.moderationAction == "Ban Player" {
+				return m.stateGameLobby, m.dispatcher.banPlayerCmd(target)
+			}
+			return m.stateGameLobby, m.dispatcher.kickPlayerCmd(target)
+		case "q":
+			m.selectedItem = 0
+			m.currentView = "gameLobby"
+			return m.stateGameLobby, nil
+		case "ctrl+c":
+			return m.statePlayerSelect, tea.Quit
+		}
+	}
+	return m.statePlayerSelect, nil
+}
+
 func (m *PokerUI) stateBetInput(ui *PokerUI, msg tea.Msg) (stateFn, tea.Cmd) {
 	m.currentView = "betInput"
 	switch msg := msg.(type) {
@@ -419,16 +566,120 @@ func (m *PokerUI) getMainMenuOptions() []string {
 }
 
 func (m *PokerUI) getGameLobbyOptions() []string {
-	return []string{
+	options := []string{
 		"Set Ready",
 		"Set Unready",
+		m.sitOutOption(),
 		"Leave Table",
 		"Check Balance",
-		"Quit",
 	}
+	if m.isHost() {
+		options = append(options, "Kick Player", "Ban Player", m.pauseOption(), "Close Table")
+	}
+	return append(options, "Quit")
+}
+
+// getHostGameOptions returns the moderation options shown to the host during
+// a hand. Players can only be removed between hands.
+func (m *PokerUI) getHostGameOptions() []string {
+	if !m.isHost() {
+		return nil
+	}
+	return []string{m.pauseOption(), "Close Table"}
+}
+
+// pauseOption returns the menu entry toggling the pause of the table.
+func (m *PokerUI) pauseOption() string {
+	if m.paused {
+		return "Resume Game"
+	}
+	return "Pause Game"
+}
+
+// isHost returns whether this client hosts its current table.
+func (m *PokerUI) isHost() bool {
+	tableID := m.pc.GetCurrentTableID()
+	if tableID == "" {
+		return false
+	}
+	if tableID == m.hostTableID {
+		return true
+	}
+	for _, table := range m.tables {
+		if table.Id == tableID {
+			return table.HostId == m.clientID
+		}
+	}
+	return false
+}
+
+// moderationTargets returns the players the host can kick or ban.
+func (m *PokerUI) moderationTargets() []string {
+	var targets []string
+	for _, player := range m.players {
+		if player.Id != m.clientID {
+			targets = append(targets, player.Id)
+		}
+	}
+	return targets
+}
+
+// sitOutOption returns the menu entry toggling whether this client sits out.
+func (m *PokerUI) sitOutOption() string {
+	for _, player := range m.players {
+		if player.Id == m.clientID && player.SittingOut {
+			return "Sit In"
+		}
+	}
+	return "Sit Out"
 }
 
 func (m *PokerUI) getActiveGameOptions() []string {
+	options := append(m.getPlayerGameOptions(), m.sitOutOption())
+	if m.canUseTimeBank() {
+		options = append(options, "Use Time Bank")
+	}
+	return append(options, m.getHostGameOptions()...)
+}
+
+// canUseTimeBank returns whether this client is on the clock with time left
+// in their time bank.
+func (m *PokerUI) canUseTimeBank() bool {
+	if !isPlayerTurn(m.currentPlayerID, m.clientID) || m.actionDeadline.IsZero() {
+		return false
+	}
+	for _, player := range m.players {
+		if player.Id == m.clientID {
+			return player.TimeBankSeconds > 0
+		}
+	}
+	return false
+}
+
+// secondsToAct returns the seconds the current player has left to act, or
+// -1 when they are not on the clock.
+func (m *PokerUI) secondsToAct() int {
+	if m.actionDeadline.IsZero() {
+		return -1
+	}
+	left := time.Until(m.actionDeadline)
+	if left < 0 {
+		return 0
+	}
+	return int(left.Round(time.Second) / time.Second)
+}
+
+// clockCmd schedules a redraw of the countdown while a player is on the
+// clock.
+func (m *PokerUI) clockCmd() tea.Cmd {
+	if m.clockTicking || m.secondsToAct() <= 0 {
+		return nil
+	}
+	m.clockTicking = true
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg { return clockTickMsg(t) })
+}
+
+func (m *PokerUI) getPlayerGameOptions() []string {
 	// During showdown, show card visibility toggle and leave table option
 	if m.gamePhase == pokerrpc.GamePhase_SHOWDOWN {
 		cardToggleText := "Hide My Cards"
@@ -509,10 +760,25 @@ func (m *PokerUI) handleGameLobbySelection(option string) (stateFn, tea.Cmd) {
 		return m.stateGameLobby, m.dispatcher.setPlayerReadyCmd()
 	case "Set Unready":
 		return m.stateGameLobby, m.dispatcher.setPlayerUnreadyCmd()
+	case "Sit Out":
+		return m.stateGameLobby, m.dispatcher.sitOutCmd()
+	case "Sit In":
+		return m.stateGameLobby, m.dispatcher.sitInCmd()
 	case "Leave Table":
 		return m.stateGameLobby, m.dispatcher.leaveTableCmd()
 	case "Check Balance":
 		return m.stateGameLobby, m.dispatcher.getBalanceCmd()
+	case "Kick Player", "Ban Player":
+		m.moderationAction = option
+		m.selectedPlayer = 0
+		m.currentView = "playerSelect"
+		return m.statePlayerSelect, nil
+	case "Pause Game":
+		return m.stateGameLobby, m.dispatcher.pauseTableCmd()
+	case "Resume Game":
+		return m.stateGameLobby, m.dispatcher.resumeTableCmd()
+	case "Close Table":
+		return m.stateGameLobby, m.dispatcher.closeTableCmd()
 	case "Quit":
 		return m.stateGameLobby, tea.Quit
 	}
@@ -539,8 +805,20 @@ func (m *PokerUI) handleActiveGameSelection(option string) (stateFn, tea.Cmd) {
 		// Toggle card visibility and send notification
 		m.showMyCards = false
 		return m.stateActiveGame, m.dispatcher.hideCardsCmd()
+	case "Sit Out":
+		return m.stateActiveGame, m.dispatcher.sitOutCmd()
+	case "Sit In":
+		return m.stateActiveGame, m.dispatcher.sitInCmd()
+	case "Use Time Bank":
+		return m.stateActiveGame, m.dispatcher.useTimeBankCmd()
 	case "Leave Table":
 		return m.stateActiveGame, m.dispatcher.leaveTableCmd()
+	case "Pause Game":
+		return m.stateActiveGame, m.dispatcher.pauseTableCmd()
+	case "Resume Game":
+		return m.stateActiveGame, m.dispatcher.resumeTableCmd()
+	case "Close Table":
+		return m.stateActiveGame, m.dispatcher.closeTableCmd()
 	}
 	return m.stateActiveGame, nil
 }
@@ -603,12 +881,25 @@ func (m *PokerUI) updateGameState(gameUpdate *pokerrpc.GameUpdate) {
 	m.communityCards = gameUpdate.CommunityCards
 	m.pot = gameUpdate.Pot
 	m.currentBet = gameUpdate.CurrentBet
+	m.actionDeadline = time.Time{}
+	if gameUpdate.ActionDeadlineUnixMs > 0 {
+		m.actionDeadline = time.UnixMilli(gameUpdate.ActionDeadlineUnixMs)
+	}
+
+	m.spectators = gameUpdate.Spectators
 
 	// Count joined players
 	m.playersJoined = int32(len(m.players))
 	// Set required players from game update
 	m.playersRequired = gameUpdate.PlayersRequired
 
+	// Spectators stay on the watch view whatever the phase
+	if m.watchingTableID != "" {
+		m.actionDeadline = time.Time{} // The delayed deadline has passed
+		m.err = nil
+		return
+	}
+
 	// Determine current UI state based on game phase
 	switch gameUpdate.Phase {
 	case pokerrpc.GamePhase_WAITING:
@@ -628,6 +919,10 @@ func (m *PokerUI) updateGameState(gameUpdate *pokerrpc.GameUpdate) {
 
 // handleNotification processes notifications from the backend
 func (m *PokerUI) handleNotification(notification *pokerrpc.Notification) tea.Cmd {
+	if m.watchingTableID != "" && notification.TableId == m.watchingTableID {
+		return m.handleWatchedNotification(notification)
+	}
+
 	switch notification.Type {
 	case pokerrpc.NotificationType_BALANCE_UPDATED:
 		m.message = fmt.Sprintf("Balance: %d", notification.NewBalance)
@@ -654,6 +949,8 @@ func (m *PokerUI) handleNotification(notification *pokerrpc.Notification) tea.Cm
 
 	case pokerrpc.NotificationType_TABLE_CREATED:
 		if notification.PlayerId == m.clientID {
+			m.hostTableID = notification.TableId
+			m.paused = false
 			m.currentState = m.stateGameLobby
 			m.currentView = "gameLobby"
 			m.message = fmt.Sprintf("Created table %s", notification.TableId)
@@ -671,6 +968,40 @@ func (m *PokerUI) handleNotification(notification *pokerrpc.Notification) tea.Cm
 			return nil
 		}
 
+	case pokerrpc.NotificationType_PLAYER_KICKED, pokerrpc.NotificationType_PLAYER_BANNED:
+		if notification.PlayerId == m.clientID {
+			m.resetToMainMenu()
+			m.message = fmt.Sprintf("You were removed from table %s by the host", notification.TableId)
+			if notification.Message != "" {
+				m.message += ": " + notification.Message
+			}
+			return m.dispatcher.getBalanceCmd()
+		}
+		if notification.Type == pokerrpc.NotificationType_PLAYER_BANNED {
+			m.message = fmt.Sprintf("%s was banned from the table", notification.PlayerId)
+		} else {
+			m.message = fmt.Sprintf("%s was removed from the table", notification.PlayerId)
+		}
+		return nil
+
+	case pokerrpc.NotificationType_GAME_PAUSED:
+		m.paused = true
+		m.message = "Game paused by the host"
+		return nil
+
+	case pokerrpc.NotificationType_GAME_RESUMED:
+		m.paused = false
+		m.message = "Game resumed"
+		return nil
+
+	case pokerrpc.NotificationType_TABLE_CLOSED:
+		m.resetToMainMenu()
+		m.message = "Table closed by the host"
+		if notification.Message != "" {
+			m.message += ": " + notification.Message
+		}
+		return m.dispatcher.getBalanceCmd()
+
 	case pokerrpc.NotificationType_GAME_STARTED:
 		m.currentState = m.stateActiveGame
 		m.currentView = "activeGame"
@@ -723,6 +1054,23 @@ func (m *PokerUI) handleNotification(notification *pokerrpc.Notification) tea.Cm
 		}
 		return nil
 
+	case pokerrpc.NotificationType_TIME_BANK_USED:
+		if notification.PlayerId == m.clientID {
+			m.message = fmt.Sprintf("Time bank used: %ds left to act", notification.Countdown)
+		} else {
+			m.message = fmt.Sprintf("%s used their time bank", notification.PlayerId)
+		}
+		return nil
+
+	case pokerrpc.NotificationType_SERVER_MESSAGE,
+		pokerrpc.NotificationType_SERVER_DRAINING:
+		m.message = "Server: " + notification.Message
+		return nil
+
+	case pokerrpc.NotificationType_CHAT_MESSAGE:
+		m.addChat(notification.Chat)
+		return nil
+
 	default:
 		m.message = notification.Message
 		return nil
@@ -730,6 +1078,35 @@ func (m *PokerUI) handleNotification(notification *pokerrpc.Notification) tea.Cm
 	return nil
 }
 
+// handleWatchedNotification processes the notifications of a watched table,
+// which never take the spectator off the watch view unless the table closes.
+func (m *PokerUI) handleWatchedNotification(notification *pokerrpc.Notification) tea.Cmd {
+	switch notification.Type {
+	case pokerrpc.NotificationType_TABLE_CLOSED:
+		m.stopWatching()
+		m.message = "The table you were watching closed"
+		return m.dispatcher.getTablesCmd()
+
+	case pokerrpc.NotificationType_SHOWDOWN_RESULT:
+		m.winners = notification.Winners
+		m.message = fmt.Sprintf("Showdown complete! Winners: %d players", len(notification.Winners))
+
+	case pokerrpc.NotificationType_NEW_HAND_STARTED:
+		m.winners = nil
+		m.message = "New hand started!"
+		return tea.ClearScreen
+
+	case pokerrpc.NotificationType_CHAT_MESSAGE:
+		m.addChat(notification.Chat)
+
+	default:
+		if notification.Message != "" {
+			m.message = notification.Message
+		}
+	}
+	return nil
+}
+
 // View renders the current state of the UI
 func (m *PokerUI) View() string {
 	var s string
@@ -758,8 +1135,12 @@ func (m *PokerUI) View() string {
 		s += m.renderer.RenderGameLobby()
 	case "activeGame":
 		s += m.renderer.RenderActiveGame()
+	case "watchTable":
+		s += m.renderer.RenderWatchTable()
 	case "betInput":
 		s += m.renderer.RenderBetInput()
+	case "playerSelect":
+		s += m.renderer.RenderPlayerSelect()
 	}
 
 	s += "\n" + HelpStyle.Render("Press 'q' to go back/quit, Ctrl+C to force quit")
@@ -809,9 +1190,15 @@ func (m *PokerUI) resetGameState() {
 	m.pot = 0
 	m.currentBet = 0
 	m.currentPlayerID = ""
+	m.actionDeadline = time.Time{}
+	m.spectators = 0
 	m.playersRequired = 0
 	m.playersJoined = 0
 	m.winners = nil
+	m.paused = false
 	m.showMyCards = true                          // Reset to show cards by default for new games
 	m.playersShowingCards = make(map[string]bool) // Reset card visibility tracking
+	m.chatLog = nil
+	m.chatting = false
+	m.chatInput = ""
 }